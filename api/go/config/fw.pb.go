@@ -239,7 +239,7 @@ type ACE struct {
 	// for future work.
 	Actions []*ACEAction `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
 	Name    string       `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`                                        // User visible name of the ACL
-	Id      int32        `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`                                           // identifier
+	Id      int32        `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`                                           // identifier, 1 to 8388606 (524286 on a load balanced network instance)
 	Dir     ACEDirection `protobuf:"varint,5,opt,name=dir,proto3,enum=org.lfedge.eve.config.ACEDirection" json:"dir,omitempty"` // direction
 }
 
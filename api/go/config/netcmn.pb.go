@@ -723,6 +723,85 @@ func (x *Ipspec) GetDhcpRange() *IpRange {
 	return nil
 }
 
+// Bandwidth shaping with tc HTB classes and fq_codel queues.
+// Egress is the traffic sent by the application, or by the applications of
+// the network instance towards its ports, and ingress the traffic they receive.
+// Rates are in kbit/s, the rate is the guarantee and the ceil the cap.
+// A missing ceil is the rate and a missing rate is the ceil; with both
+// zero the direction is not shaped.
+// The guarantees of the applications are only enforced when the network
+// instance has a ceil for the same direction, they share it.
+type BandwidthShaping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EgressRate  uint64 `protobuf:"varint,1,opt,name=egressRate,proto3" json:"egressRate,omitempty"`
+	EgressCeil  uint64 `protobuf:"varint,2,opt,name=egressCeil,proto3" json:"egressCeil,omitempty"`
+	IngressRate uint64 `protobuf:"varint,3,opt,name=ingressRate,proto3" json:"ingressRate,omitempty"`
+	IngressCeil uint64 `protobuf:"varint,4,opt,name=ingressCeil,proto3" json:"ingressCeil,omitempty"`
+}
+
+func (x *BandwidthShaping) Reset() {
+	*x = BandwidthShaping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_netcmn_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BandwidthShaping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BandwidthShaping) ProtoMessage() {}
+
+func (x *BandwidthShaping) ProtoReflect() protoreflect.Message {
+	mi := &file_config_netcmn_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BandwidthShaping.ProtoReflect.Descriptor instead.
+func (*BandwidthShaping) Descriptor() ([]byte, []int) {
+	return file_config_netcmn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BandwidthShaping) GetEgressRate() uint64 {
+	if x != nil {
+		return x.EgressRate
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetEgressCeil() uint64 {
+	if x != nil {
+		return x.EgressCeil
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetIngressRate() uint64 {
+	if x != nil {
+		return x.IngressRate
+	}
+	return 0
+}
+
+func (x *BandwidthShaping) GetIngressCeil() uint64 {
+	if x != nil {
+		return x.IngressCeil
+	}
+	return 0
+}
+
 var File_config_netcmn_proto protoreflect.FileDescriptor
 
 var file_config_netcmn_proto_rawDesc = []byte{
@@ -778,37 +857,47 @@ var file_config_netcmn_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65,
 	0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x69, 0x70, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2a, 0x5f, 0x0a, 0x0a,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52,
-	0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52,
-	0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
-	0x52, 0x4f, 0x58, 0x59, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09,
-	0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x46, 0x54, 0x50, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x50,
-	0x52, 0x4f, 0x58, 0x59, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x3e, 0x0a,
-	0x08, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43,
-	0x50, 0x4e, 0x6f, 0x6f, 0x70, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69,
-	0x63, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6e, 0x65, 0x10,
-	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x2a, 0x83, 0x01,
-	0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a,
-	0x0f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x54, 0x59, 0x50, 0x45, 0x4e, 0x4f, 0x4f, 0x50,
-	0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x34, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x36,
-	0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x34, 0x10, 0x18,
-	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x36, 0x10, 0x1a, 0x12, 0x0d,
-	0x0a, 0x09, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x45, 0x49, 0x44, 0x10, 0x0e, 0x12, 0x0a, 0x0a,
-	0x06, 0x56, 0x34, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x36, 0x4f,
-	0x6e, 0x6c, 0x79, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x75, 0x61, 0x6c, 0x56, 0x34, 0x56,
-	0x36, 0x10, 0x09, 0x2a, 0x34, 0x0a, 0x0c, 0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10,
-	0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x69, 0x46, 0x69, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43,
-	0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72, 0x10, 0x02, 0x2a, 0x37, 0x0a, 0x0d, 0x57, 0x69, 0x46,
-	0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50,
-	0x41, 0x50, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50, 0x41, 0x45, 0x41, 0x50,
-	0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f, 0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65,
-	0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f,
-	0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x52, 0x09, 0x64, 0x68, 0x63, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x96, 0x01, 0x0a,
+	0x10, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x53, 0x68, 0x61, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65, 0x69,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x43, 0x65,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x43, 0x65, 0x69, 0x6c, 0x2a, 0x5f, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54,
+	0x50, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x53, 0x4f,
+	0x43, 0x4b, 0x53, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x46,
+	0x54, 0x50, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0b, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0xff, 0x01, 0x2a, 0x3e, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6f, 0x70, 0x10, 0x00,
+	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x69, 0x63, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x44, 0x48, 0x43, 0x50, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x2a, 0x83, 0x01, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52,
+	0x4b, 0x54, 0x59, 0x50, 0x45, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x06, 0x0a, 0x02, 0x56,
+	0x34, 0x10, 0x04, 0x12, 0x06, 0x0a, 0x02, 0x56, 0x36, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x56, 0x34, 0x10, 0x18, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x56, 0x36, 0x10, 0x1a, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x45, 0x49, 0x44, 0x10, 0x0e, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x34, 0x4f, 0x6e, 0x6c, 0x79,
+	0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x36, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x08, 0x12, 0x0c,
+	0x0a, 0x08, 0x44, 0x75, 0x61, 0x6c, 0x56, 0x34, 0x56, 0x36, 0x10, 0x09, 0x2a, 0x34, 0x0a, 0x0c,
+	0x57, 0x69, 0x72, 0x65, 0x6c, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08,
+	0x54, 0x79, 0x70, 0x65, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x69,
+	0x46, 0x69, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x65, 0x6c, 0x6c, 0x75, 0x6c, 0x61, 0x72,
+	0x10, 0x02, 0x2a, 0x37, 0x0a, 0x0d, 0x57, 0x69, 0x46, 0x69, 0x4b, 0x65, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x4e, 0x4f, 0x4f,
+	0x50, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x57, 0x50, 0x41, 0x50, 0x53, 0x4b, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x57, 0x50, 0x41, 0x45, 0x41, 0x50, 0x10, 0x02, 0x42, 0x3d, 0x0a, 0x15, 0x6f,
+	0x72, 0x67, 0x2e, 0x6c, 0x66, 0x65, 0x64, 0x67, 0x65, 0x2e, 0x65, 0x76, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5a, 0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x66, 0x2d, 0x65, 0x64, 0x67, 0x65, 0x2f, 0x65, 0x76, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x67, 0x6f, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -824,7 +913,7 @@ func file_config_netcmn_proto_rawDescGZIP() []byte {
 }
 
 var file_config_netcmn_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_config_netcmn_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_config_netcmn_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_config_netcmn_proto_goTypes = []interface{}{
 	(ProxyProto)(0),            // 0: org.lfedge.eve.config.proxyProto
 	(DHCPType)(0),              // 1: org.lfedge.eve.config.DHCPType
@@ -837,6 +926,7 @@ var file_config_netcmn_proto_goTypes = []interface{}{
 	(*ZedServer)(nil),          // 8: org.lfedge.eve.config.ZedServer
 	(*ZnetStaticDNSEntry)(nil), // 9: org.lfedge.eve.config.ZnetStaticDNSEntry
 	(*Ipspec)(nil),             // 10: org.lfedge.eve.config.ipspec
+	(*BandwidthShaping)(nil),   // 11: org.lfedge.eve.config.BandwidthShaping
 }
 var file_config_netcmn_proto_depIdxs = []int32{
 	0, // 0: org.lfedge.eve.config.ProxyServer.proto:type_name -> org.lfedge.eve.config.proxyProto
@@ -928,6 +1018,18 @@ func file_config_netcmn_proto_init() {
 				return nil
 			}
 		}
+		file_config_netcmn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BandwidthShaping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -935,7 +1037,7 @@ func file_config_netcmn_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_netcmn_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -21,7 +21,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x18\x63onfig/acipherinfo.proto\x12\x15org.lfedge.eve.config\x1a\x19\x65vecommon/evecommon.proto\"\x98\x02\n\rCipherContext\x12\x11\n\tcontextId\x18\x01 \x01(\t\x12\x38\n\nhashScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.common.HashAlgorithm\x12\x43\n\x11keyExchangeScheme\x18\x03 \x01(\x0e\x32(.org.lfedge.eve.config.KeyExchangeScheme\x12\x41\n\x10\x65ncryptionScheme\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.EncryptionScheme\x12\x16\n\x0e\x64\x65viceCertHash\x18\x05 \x01(\x0c\x12\x1a\n\x12\x63ontrollerCertHash\x18\x06 \x01(\x0c\"i\n\x0b\x43ipherBlock\x12\x17\n\x0f\x63ipherContextId\x18\x01 \x01(\t\x12\x14\n\x0cinitialValue\x18\x02 \x01(\x0c\x12\x12\n\ncipherData\x18\x03 \x01(\x0c\x12\x17\n\x0f\x63learTextSha256\x18\x04 \x01(\x0c\"~\n\x0f\x45ncryptionBlock\x12\x10\n\x08\x64sAPIKey\x18\x01 \x01(\t\x12\x12\n\ndsPassword\x18\x02 \x01(\t\x12\x14\n\x0cwifiUserName\x18\x03 \x01(\t\x12\x14\n\x0cwifiPassword\x18\x04 \x01(\t\x12\x19\n\x11protectedUserData\x18\x05 \x01(\t*/\n\x11KeyExchangeScheme\x12\x0c\n\x08KEA_NONE\x10\x00\x12\x0c\n\x08KEA_ECDH\x10\x01*3\n\x10\x45ncryptionScheme\x12\x0b\n\x07SA_NONE\x10\x00\x12\x12\n\x0eSA_AES_256_CFB\x10\x01\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x18\x63onfig/acipherinfo.proto\x12\x15org.lfedge.eve.config\x1a\x19\x65vecommon/evecommon.proto\"\x98\x02\n\rCipherContext\x12\x11\n\tcontextId\x18\x01 \x01(\t\x12\x38\n\nhashScheme\x18\x02 \x01(\x0e\x32$.org.lfedge.eve.common.HashAlgorithm\x12\x43\n\x11keyExchangeScheme\x18\x03 \x01(\x0e\x32(.org.lfedge.eve.config.KeyExchangeScheme\x12\x41\n\x10\x65ncryptionScheme\x18\x04 \x01(\x0e\x32\'.org.lfedge.eve.config.EncryptionScheme\x12\x16\n\x0e\x64\x65viceCertHash\x18\x05 \x01(\x0c\x12\x1a\n\x12\x63ontrollerCertHash\x18\x06 \x01(\x0c\"i\n\x0b\x43ipherBlock\x12\x17\n\x0f\x63ipherContextId\x18\x01 \x01(\t\x12\x14\n\x0cinitialValue\x18\x02 \x01(\x0c\x12\x12\n\ncipherData\x18\x03 \x01(\x0c\x12\x17\n\x0f\x63learTextSha256\x18\x04 \x01(\x0c\"\xbe\x02\n\x0f\x45ncryptionBlock\x12\x10\n\x08\x64sAPIKey\x18\x01 \x01(\t\x12\x12\n\ndsPassword\x18\x02 \x01(\t\x12\x14\n\x0cwifiUserName\x18\x03 \x01(\t\x12\x14\n\x0cwifiPassword\x18\x04 \x01(\t\x12\x19\n\x11protectedUserData\x18\x05 \x01(\t\x12\x1b\n\x13wireguardPrivateKey\x18\x06 \x01(\t\x12\x62\n\x16wireguardPresharedKeys\x18\x07 \x03(\x0b\x32\x42.org.lfedge.eve.config.EncryptionBlock.WireguardPresharedKeysEntry\x1a=\n\x1bWireguardPresharedKeysEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01*/\n\x11KeyExchangeScheme\x12\x0c\n\x08KEA_NONE\x10\x00\x12\x0c\n\x08KEA_ECDH\x10\x01*3\n\x10\x45ncryptionScheme\x12\x0b\n\x07SA_NONE\x10\x00\x12\x12\n\x0eSA_AES_256_CFB\x10\x01\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[evecommon_dot_evecommon__pb2.DESCRIPTOR,])
 
@@ -45,8 +45,8 @@ _KEYEXCHANGESCHEME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=596,
-  serialized_end=643,
+  serialized_start=789,
+  serialized_end=836,
 )
 _sym_db.RegisterEnumDescriptor(_KEYEXCHANGESCHEME)
 
@@ -71,8 +71,8 @@ _ENCRYPTIONSCHEME = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=645,
-  serialized_end=696,
+  serialized_start=838,
+  serialized_end=889,
 )
 _sym_db.RegisterEnumDescriptor(_ENCRYPTIONSCHEME)
 
@@ -204,6 +204,44 @@ _CIPHERBLOCK = _descriptor.Descriptor(
 )
 
 
+_ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY = _descriptor.Descriptor(
+  name='WireguardPresharedKeysEntry',
+  full_name='org.lfedge.eve.config.EncryptionBlock.WireguardPresharedKeysEntry',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='key', full_name='org.lfedge.eve.config.EncryptionBlock.WireguardPresharedKeysEntry.key', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='value', full_name='org.lfedge.eve.config.EncryptionBlock.WireguardPresharedKeysEntry.value', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=b'8\001',
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=726,
+  serialized_end=787,
+)
+
 _ENCRYPTIONBLOCK = _descriptor.Descriptor(
   name='EncryptionBlock',
   full_name='org.lfedge.eve.config.EncryptionBlock',
@@ -247,10 +285,24 @@ _ENCRYPTIONBLOCK = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wireguardPrivateKey', full_name='org.lfedge.eve.config.EncryptionBlock.wireguardPrivateKey', index=5,
+      number=6, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='wireguardPresharedKeys', full_name='org.lfedge.eve.config.EncryptionBlock.wireguardPresharedKeys', index=6,
+      number=7, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
-  nested_types=[],
+  nested_types=[_ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY, ],
   enum_types=[
   ],
   serialized_options=None,
@@ -259,13 +311,15 @@ _ENCRYPTIONBLOCK = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=468,
-  serialized_end=594,
+  serialized_start=469,
+  serialized_end=787,
 )
 
 _CIPHERCONTEXT.fields_by_name['hashScheme'].enum_type = evecommon_dot_evecommon__pb2._HASHALGORITHM
 _CIPHERCONTEXT.fields_by_name['keyExchangeScheme'].enum_type = _KEYEXCHANGESCHEME
 _CIPHERCONTEXT.fields_by_name['encryptionScheme'].enum_type = _ENCRYPTIONSCHEME
+_ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY.containing_type = _ENCRYPTIONBLOCK
+_ENCRYPTIONBLOCK.fields_by_name['wireguardPresharedKeys'].message_type = _ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY
 DESCRIPTOR.message_types_by_name['CipherContext'] = _CIPHERCONTEXT
 DESCRIPTOR.message_types_by_name['CipherBlock'] = _CIPHERBLOCK
 DESCRIPTOR.message_types_by_name['EncryptionBlock'] = _ENCRYPTIONBLOCK
@@ -288,12 +342,21 @@ CipherBlock = _reflection.GeneratedProtocolMessageType('CipherBlock', (_message.
 _sym_db.RegisterMessage(CipherBlock)
 
 EncryptionBlock = _reflection.GeneratedProtocolMessageType('EncryptionBlock', (_message.Message,), {
+
+  'WireguardPresharedKeysEntry' : _reflection.GeneratedProtocolMessageType('WireguardPresharedKeysEntry', (_message.Message,), {
+    'DESCRIPTOR' : _ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY,
+    '__module__' : 'config.acipherinfo_pb2'
+    # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.EncryptionBlock.WireguardPresharedKeysEntry)
+    })
+  ,
   'DESCRIPTOR' : _ENCRYPTIONBLOCK,
   '__module__' : 'config.acipherinfo_pb2'
   # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.EncryptionBlock)
   })
 _sym_db.RegisterMessage(EncryptionBlock)
+_sym_db.RegisterMessage(EncryptionBlock.WireguardPresharedKeysEntry)
 
 
 DESCRIPTOR._options = None
+_ENCRYPTIONBLOCK_WIREGUARDPRESHAREDKEYSENTRY._options = None
 # @@protoc_insertion_point(module_scope)
@@ -25,7 +25,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"\xc2\x05\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*f\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x16\x63onfig/appconfig.proto\x12\x15org.lfedge.eve.config\x1a\x18\x63onfig/acipherinfo.proto\x1a\x16\x63onfig/devcommon.proto\x1a\x14\x63onfig/storage.proto\x1a\x0f\x63onfig/vm.proto\x1a\x16\x63onfig/netconfig.proto\"2\n\x0eInstanceOpsCmd\x12\x0f\n\x07\x63ounter\x18\x02 \x01(\r\x12\x0f\n\x07opsTime\x18\x04 \x01(\t\"4\n\x13InstanceSnapshotCmd\x12\x0f\n\x07\x63ounter\x18\x01 \x01(\r\x12\x0c\n\x04name\x18\x02 \x01(\t\"\xbb\x07\n\x11\x41ppInstanceConfig\x12=\n\x0euuidandversion\x18\x01 \x01(\x0b\x32%.org.lfedge.eve.config.UUIDandVersion\x12\x13\n\x0b\x64isplayname\x18\x02 \x01(\t\x12\x37\n\x0e\x66ixedresources\x18\x03 \x01(\x0b\x32\x1f.org.lfedge.eve.config.VmConfig\x12,\n\x06\x64rives\x18\x04 \x03(\x0b\x32\x1c.org.lfedge.eve.config.Drive\x12\x10\n\x08\x61\x63tivate\x18\x05 \x01(\x08\x12\x39\n\ninterfaces\x18\x06 \x03(\x0b\x32%.org.lfedge.eve.config.NetworkAdapter\x12\x30\n\x08\x61\x64\x61pters\x18\x07 \x03(\x0b\x32\x1e.org.lfedge.eve.config.Adapter\x12\x36\n\x07restart\x18\t \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x34\n\x05purge\x18\n \x01(\x0b\x32%.org.lfedge.eve.config.InstanceOpsCmd\x12\x10\n\x08userData\x18\x0b \x01(\t\x12\x15\n\rremoteConsole\x18\x0c \x01(\x08\x12\x36\n\ncipherData\x18\r \x01(\x0b\x32\".org.lfedge.eve.config.CipherBlock\x12\x1a\n\x12\x63ollectStatsIPAddr\x18\x0f \x01(\t\x12\x37\n\rvolumeRefList\x18\x10 \x03(\x0b\x32 .org.lfedge.eve.config.VolumeRef\x12\x39\n\x0cmetaDataType\x18\x11 \x01(\x0e\x32#.org.lfedge.eve.config.MetaDataType\x12\x14\n\x0cprofile_list\x18\x12 \x03(\t\x12;\n\x0chealth_check\x18\x13 \x01(\x0b\x32%.org.lfedge.eve.config.AppHealthCheck\x12?\n\x0erestart_policy\x18\x14 \x01(\x0e\x32\'.org.lfedge.eve.config.AppRestartPolicy\x12<\n\x08snapshot\x18\x15 \x01(\x0b\x32*.org.lfedge.eve.config.InstanceSnapshotCmd\x12;\n\x07restore\x18\x16 \x01(\x0b\x32*.org.lfedge.eve.config.InstanceSnapshotCmd\"\x80\x01\n\x0e\x41ppHealthCheck\x12\x0c\n\x04test\x18\x01 \x03(\t\x12\x18\n\x10interval_seconds\x18\x02 \x01(\r\x12\x17\n\x0ftimeout_seconds\x18\x03 \x01(\r\x12\x1c\n\x14start_period_seconds\x18\x04 \x01(\r\x12\x0f\n\x07retries\x18\x05 \x01(\r\"E\n\tVolumeRef\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x17\n\x0fgenerationCount\x18\x02 \x01(\x03\x12\x11\n\tmount_dir\x18\x03 \x01(\t*w\n\x0cMetaDataType\x12\x11\n\rMetaDataDrive\x10\x00\x12\x10\n\x0cMetaDataNone\x10\x01\x12\x15\n\x11MetaDataOpenStack\x10\x02\x12\x1a\n\x16MetaDataDriveMultipart\x10\x03\x12\x0f\n\x0bMetaDataEC2\x10\x04*\xbb\x01\n\x10\x41ppRestartPolicy\x12\"\n\x1e\x41PP_RESTART_POLICY_UNSPECIFIED\x10\x00\x12\x1c\n\x18\x41PP_RESTART_POLICY_NEVER\x10\x01\x12!\n\x1d\x41PP_RESTART_POLICY_ON_FAILURE\x10\x02\x12\x1d\n\x19\x41PP_RESTART_POLICY_ALWAYS\x10\x03\x12#\n\x1f\x41PP_RESTART_POLICY_ON_UNHEALTHY\x10\x04\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
   ,
   dependencies=[config_dot_acipherinfo__pb2.DESCRIPTOR,config_dot_devcommon__pb2.DESCRIPTOR,config_dot_storage__pb2.DESCRIPTOR,config_dot_vm__pb2.DESCRIPTOR,config_dot_netconfig__pb2.DESCRIPTOR,])
 
@@ -56,19 +56,71 @@ _METADATATYPE = _descriptor.EnumDescriptor(
       serialized_options=None,
       type=None,
       create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='MetaDataEC2', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=994,
-  serialized_end=1096,
+  serialized_start=1428,
+  serialized_end=1547,
 )
 _sym_db.RegisterEnumDescriptor(_METADATATYPE)
 
 MetaDataType = enum_type_wrapper.EnumTypeWrapper(_METADATATYPE)
+_APPRESTARTPOLICY = _descriptor.EnumDescriptor(
+  name='AppRestartPolicy',
+  full_name='org.lfedge.eve.config.AppRestartPolicy',
+  filename=None,
+  file=DESCRIPTOR,
+  create_key=_descriptor._internal_create_key,
+  values=[
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_UNSPECIFIED', index=0, number=0,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_NEVER', index=1, number=1,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ON_FAILURE', index=2, number=2,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ALWAYS', index=3, number=3,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+    _descriptor.EnumValueDescriptor(
+      name='APP_RESTART_POLICY_ON_UNHEALTHY', index=4, number=4,
+      serialized_options=None,
+      type=None,
+      create_key=_descriptor._internal_create_key),
+  ],
+  containing_type=None,
+  serialized_options=None,
+  serialized_start=1550,
+  serialized_end=1737,
+)
+_sym_db.RegisterEnumDescriptor(_APPRESTARTPOLICY)
+
+AppRestartPolicy = enum_type_wrapper.EnumTypeWrapper(_APPRESTARTPOLICY)
 MetaDataDrive = 0
 MetaDataNone = 1
 MetaDataOpenStack = 2
 MetaDataDriveMultipart = 3
+MetaDataEC2 = 4
+APP_RESTART_POLICY_UNSPECIFIED = 0
+APP_RESTART_POLICY_NEVER = 1
+APP_RESTART_POLICY_ON_FAILURE = 2
+APP_RESTART_POLICY_ALWAYS = 3
+APP_RESTART_POLICY_ON_UNHEALTHY = 4
 
 
 
@@ -111,6 +163,45 @@ _INSTANCEOPSCMD = _descriptor.Descriptor(
 )
 
 
+_INSTANCESNAPSHOTCMD = _descriptor.Descriptor(
+  name='InstanceSnapshotCmd',
+  full_name='org.lfedge.eve.config.InstanceSnapshotCmd',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='counter', full_name='org.lfedge.eve.config.InstanceSnapshotCmd.counter', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='name', full_name='org.lfedge.eve.config.InstanceSnapshotCmd.name', index=1,
+      number=2, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=214,
+  serialized_end=266,
+)
+
+
 _APPINSTANCECONFIG = _descriptor.Descriptor(
   name='AppInstanceConfig',
   full_name='org.lfedge.eve.config.AppInstanceConfig',
@@ -231,6 +322,94 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='health_check', full_name='org.lfedge.eve.config.AppInstanceConfig.health_check', index=16,
+      number=19, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restart_policy', full_name='org.lfedge.eve.config.AppInstanceConfig.restart_policy', index=17,
+      number=20, type=14, cpp_type=8, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='snapshot', full_name='org.lfedge.eve.config.AppInstanceConfig.snapshot', index=18,
+      number=21, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='restore', full_name='org.lfedge.eve.config.AppInstanceConfig.restore', index=19,
+      number=22, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=269,
+  serialized_end=1224,
+)
+
+
+_APPHEALTHCHECK = _descriptor.Descriptor(
+  name='AppHealthCheck',
+  full_name='org.lfedge.eve.config.AppHealthCheck',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='test', full_name='org.lfedge.eve.config.AppHealthCheck.test', index=0,
+      number=1, type=9, cpp_type=9, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='interval_seconds', full_name='org.lfedge.eve.config.AppHealthCheck.interval_seconds', index=1,
+      number=2, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='timeout_seconds', full_name='org.lfedge.eve.config.AppHealthCheck.timeout_seconds', index=2,
+      number=3, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='start_period_seconds', full_name='org.lfedge.eve.config.AppHealthCheck.start_period_seconds', index=3,
+      number=4, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='retries', full_name='org.lfedge.eve.config.AppHealthCheck.retries', index=4,
+      number=5, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -243,8 +422,8 @@ _APPINSTANCECONFIG = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=215,
-  serialized_end=921,
+  serialized_start=1227,
+  serialized_end=1355,
 )
 
 
@@ -289,8 +468,8 @@ _VOLUMEREF = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=923,
-  serialized_end=992,
+  serialized_start=1357,
+  serialized_end=1426,
 )
 
 _APPINSTANCECONFIG.fields_by_name['uuidandversion'].message_type = config_dot_devcommon__pb2._UUIDANDVERSION
@@ -303,10 +482,17 @@ _APPINSTANCECONFIG.fields_by_name['purge'].message_type = _INSTANCEOPSCMD
 _APPINSTANCECONFIG.fields_by_name['cipherData'].message_type = config_dot_acipherinfo__pb2._CIPHERBLOCK
 _APPINSTANCECONFIG.fields_by_name['volumeRefList'].message_type = _VOLUMEREF
 _APPINSTANCECONFIG.fields_by_name['metaDataType'].enum_type = _METADATATYPE
+_APPINSTANCECONFIG.fields_by_name['health_check'].message_type = _APPHEALTHCHECK
+_APPINSTANCECONFIG.fields_by_name['restart_policy'].enum_type = _APPRESTARTPOLICY
+_APPINSTANCECONFIG.fields_by_name['snapshot'].message_type = _INSTANCESNAPSHOTCMD
+_APPINSTANCECONFIG.fields_by_name['restore'].message_type = _INSTANCESNAPSHOTCMD
 DESCRIPTOR.message_types_by_name['InstanceOpsCmd'] = _INSTANCEOPSCMD
+DESCRIPTOR.message_types_by_name['InstanceSnapshotCmd'] = _INSTANCESNAPSHOTCMD
 DESCRIPTOR.message_types_by_name['AppInstanceConfig'] = _APPINSTANCECONFIG
+DESCRIPTOR.message_types_by_name['AppHealthCheck'] = _APPHEALTHCHECK
 DESCRIPTOR.message_types_by_name['VolumeRef'] = _VOLUMEREF
 DESCRIPTOR.enum_types_by_name['MetaDataType'] = _METADATATYPE
+DESCRIPTOR.enum_types_by_name['AppRestartPolicy'] = _APPRESTARTPOLICY
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
 InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_message.Message,), {
@@ -316,6 +502,13 @@ InstanceOpsCmd = _reflection.GeneratedProtocolMessageType('InstanceOpsCmd', (_me
   })
 _sym_db.RegisterMessage(InstanceOpsCmd)
 
+InstanceSnapshotCmd = _reflection.GeneratedProtocolMessageType('InstanceSnapshotCmd', (_message.Message,), {
+  'DESCRIPTOR' : _INSTANCESNAPSHOTCMD,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.InstanceSnapshotCmd)
+  })
+_sym_db.RegisterMessage(InstanceSnapshotCmd)
+
 AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig', (_message.Message,), {
   'DESCRIPTOR' : _APPINSTANCECONFIG,
   '__module__' : 'config.appconfig_pb2'
@@ -323,6 +516,13 @@ AppInstanceConfig = _reflection.GeneratedProtocolMessageType('AppInstanceConfig'
   })
 _sym_db.RegisterMessage(AppInstanceConfig)
 
+AppHealthCheck = _reflection.GeneratedProtocolMessageType('AppHealthCheck', (_message.Message,), {
+  'DESCRIPTOR' : _APPHEALTHCHECK,
+  '__module__' : 'config.appconfig_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.AppHealthCheck)
+  })
+_sym_db.RegisterMessage(AppHealthCheck)
+
 VolumeRef = _reflection.GeneratedProtocolMessageType('VolumeRef', (_message.Message,), {
   'DESCRIPTOR' : _VOLUMEREF,
   '__module__' : 'config.appconfig_pb2'
@@ -20,7 +20,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\025org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/config',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x0f\x63onfig/vm.proto\x12\x15org.lfedge.eve.config\"\xfb\x02\n\x08VmConfig\x12\x0e\n\x06kernel\x18\x01 \x01(\t\x12\x0f\n\x07ramdisk\x18\x02 \x01(\t\x12\x0e\n\x06memory\x18\x03 \x01(\r\x12\x0e\n\x06maxmem\x18\x04 \x01(\r\x12\r\n\x05vcpus\x18\x05 \x01(\r\x12\x0f\n\x07maxcpus\x18\x06 \x01(\r\x12\x0f\n\x07rootdev\x18\x07 \x01(\t\x12\x11\n\textraargs\x18\x08 \x01(\t\x12\x12\n\nbootloader\x18\t \x01(\t\x12\x0c\n\x04\x63pus\x18\n \x01(\t\x12\x12\n\ndevicetree\x18\x0b \x01(\t\x12\r\n\x05\x64tdev\x18\x0c \x03(\t\x12\x0c\n\x04irqs\x18\r \x03(\r\x12\r\n\x05iomem\x18\x0e \x03(\t\x12\x39\n\x12virtualizationMode\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.config.VmMode\x12\x11\n\tenableVnc\x18\x10 \x01(\x08\x12\x12\n\nvncDisplay\x18\x11 \x01(\r\x12\x11\n\tvncPasswd\x18\x12 \x01(\t\x12\x13\n\x0b\x64isableLogs\x18\x13 \x01(\x08*G\n\x06VmMode\x12\x06\n\x02PV\x10\x00\x12\x07\n\x03HVM\x10\x01\x12\n\n\x06\x46iller\x10\x02\x12\x07\n\x03\x46ML\x10\x03\x12\x0b\n\x07NOHYPER\x10\x04\x12\n\n\x06LEGACY\x10\x05\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
+  serialized_pb=b'\n\x0f\x63onfig/vm.proto\x12\x15org.lfedge.eve.config\"\xc2\x03\n\x08VmConfig\x12\x0e\n\x06kernel\x18\x01 \x01(\t\x12\x0f\n\x07ramdisk\x18\x02 \x01(\t\x12\x0e\n\x06memory\x18\x03 \x01(\r\x12\x0e\n\x06maxmem\x18\x04 \x01(\r\x12\r\n\x05vcpus\x18\x05 \x01(\r\x12\x0f\n\x07maxcpus\x18\x06 \x01(\r\x12\x0f\n\x07rootdev\x18\x07 \x01(\t\x12\x11\n\textraargs\x18\x08 \x01(\t\x12\x12\n\nbootloader\x18\t \x01(\t\x12\x0c\n\x04\x63pus\x18\n \x01(\t\x12\x12\n\ndevicetree\x18\x0b \x01(\t\x12\r\n\x05\x64tdev\x18\x0c \x03(\t\x12\x0c\n\x04irqs\x18\r \x03(\r\x12\r\n\x05iomem\x18\x0e \x03(\t\x12\x39\n\x12virtualizationMode\x18\x0f \x01(\x0e\x32\x1d.org.lfedge.eve.config.VmMode\x12\x11\n\tenableVnc\x18\x10 \x01(\x08\x12\x12\n\nvncDisplay\x18\x11 \x01(\r\x12\x11\n\tvncPasswd\x18\x12 \x01(\t\x12\x13\n\x0b\x64isableLogs\x18\x13 \x01(\x08\x12\x45\n\x12\x63ontainerResources\x18\x14 \x01(\x0b\x32).org.lfedge.eve.config.ContainerResources\"\xb8\x01\n\x12\x43ontainerResources\x12\x13\n\x0b\x62lkioWeight\x18\x01 \x01(\r\x12<\n\x0e\x62lkioThrottles\x18\x02 \x03(\x0b\x32$.org.lfedge.eve.config.BlkioThrottle\x12\x11\n\tpidsLimit\x18\x03 \x01(\x03\x12<\n\x0ehugepageLimits\x18\x04 \x03(\x0b\x32$.org.lfedge.eve.config.HugepageLimit\"g\n\rBlkioThrottle\x12\x0e\n\x06\x64\x65vice\x18\x01 \x01(\t\x12\x0f\n\x07readBps\x18\x02 \x01(\x04\x12\x10\n\x08writeBps\x18\x03 \x01(\x04\x12\x10\n\x08readIops\x18\x04 \x01(\x04\x12\x11\n\twriteIops\x18\x05 \x01(\x04\"0\n\rHugepageLimit\x12\x10\n\x08pageSize\x18\x01 \x01(\t\x12\r\n\x05limit\x18\x02 \x01(\x04*G\n\x06VmMode\x12\x06\n\x02PV\x10\x00\x12\x07\n\x03HVM\x10\x01\x12\n\n\x06\x46iller\x10\x02\x12\x07\n\x03\x46ML\x10\x03\x12\x0b\n\x07NOHYPER\x10\x04\x12\n\n\x06LEGACY\x10\x05\x42=\n\x15org.lfedge.eve.configZ$github.com/lf-edge/eve/api/go/configb\x06proto3'
 )
 
 _VMMODE = _descriptor.EnumDescriptor(
@@ -63,8 +63,8 @@ _VMMODE = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=424,
-  serialized_end=495,
+  serialized_start=837,
+  serialized_end=908,
 )
 _sym_db.RegisterEnumDescriptor(_VMMODE)
 
@@ -219,6 +219,13 @@ _VMCONFIG = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='containerResources', full_name='org.lfedge.eve.config.VmConfig.containerResources', index=19,
+      number=20, type=11, cpp_type=10, label=1,
+      has_default_value=False, default_value=None,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -232,11 +239,169 @@ _VMCONFIG = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=43,
-  serialized_end=422,
+  serialized_end=493,
+)
+
+
+_CONTAINERRESOURCES = _descriptor.Descriptor(
+  name='ContainerResources',
+  full_name='org.lfedge.eve.config.ContainerResources',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='blkioWeight', full_name='org.lfedge.eve.config.ContainerResources.blkioWeight', index=0,
+      number=1, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='blkioThrottles', full_name='org.lfedge.eve.config.ContainerResources.blkioThrottles', index=1,
+      number=2, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='pidsLimit', full_name='org.lfedge.eve.config.ContainerResources.pidsLimit', index=2,
+      number=3, type=3, cpp_type=2, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='hugepageLimits', full_name='org.lfedge.eve.config.ContainerResources.hugepageLimits', index=3,
+      number=4, type=11, cpp_type=10, label=3,
+      has_default_value=False, default_value=[],
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=496,
+  serialized_end=680,
+)
+
+
+_BLKIOTHROTTLE = _descriptor.Descriptor(
+  name='BlkioThrottle',
+  full_name='org.lfedge.eve.config.BlkioThrottle',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='device', full_name='org.lfedge.eve.config.BlkioThrottle.device', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readBps', full_name='org.lfedge.eve.config.BlkioThrottle.readBps', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='writeBps', full_name='org.lfedge.eve.config.BlkioThrottle.writeBps', index=2,
+      number=3, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='readIops', full_name='org.lfedge.eve.config.BlkioThrottle.readIops', index=3,
+      number=4, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='writeIops', full_name='org.lfedge.eve.config.BlkioThrottle.writeIops', index=4,
+      number=5, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=682,
+  serialized_end=785,
+)
+
+
+_HUGEPAGELIMIT = _descriptor.Descriptor(
+  name='HugepageLimit',
+  full_name='org.lfedge.eve.config.HugepageLimit',
+  filename=None,
+  file=DESCRIPTOR,
+  containing_type=None,
+  create_key=_descriptor._internal_create_key,
+  fields=[
+    _descriptor.FieldDescriptor(
+      name='pageSize', full_name='org.lfedge.eve.config.HugepageLimit.pageSize', index=0,
+      number=1, type=9, cpp_type=9, label=1,
+      has_default_value=False, default_value=b"".decode('utf-8'),
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='limit', full_name='org.lfedge.eve.config.HugepageLimit.limit', index=1,
+      number=2, type=4, cpp_type=4, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+  ],
+  extensions=[
+  ],
+  nested_types=[],
+  enum_types=[
+  ],
+  serialized_options=None,
+  is_extendable=False,
+  syntax='proto3',
+  extension_ranges=[],
+  oneofs=[
+  ],
+  serialized_start=787,
+  serialized_end=835,
 )
 
 _VMCONFIG.fields_by_name['virtualizationMode'].enum_type = _VMMODE
+_VMCONFIG.fields_by_name['containerResources'].message_type = _CONTAINERRESOURCES
+_CONTAINERRESOURCES.fields_by_name['blkioThrottles'].message_type = _BLKIOTHROTTLE
+_CONTAINERRESOURCES.fields_by_name['hugepageLimits'].message_type = _HUGEPAGELIMIT
 DESCRIPTOR.message_types_by_name['VmConfig'] = _VMCONFIG
+DESCRIPTOR.message_types_by_name['ContainerResources'] = _CONTAINERRESOURCES
+DESCRIPTOR.message_types_by_name['BlkioThrottle'] = _BLKIOTHROTTLE
+DESCRIPTOR.message_types_by_name['HugepageLimit'] = _HUGEPAGELIMIT
 DESCRIPTOR.enum_types_by_name['VmMode'] = _VMMODE
 _sym_db.RegisterFileDescriptor(DESCRIPTOR)
 
@@ -247,6 +412,27 @@ VmConfig = _reflection.GeneratedProtocolMessageType('VmConfig', (_message.Messag
   })
 _sym_db.RegisterMessage(VmConfig)
 
+ContainerResources = _reflection.GeneratedProtocolMessageType('ContainerResources', (_message.Message,), {
+  'DESCRIPTOR' : _CONTAINERRESOURCES,
+  '__module__' : 'config.vm_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.ContainerResources)
+  })
+_sym_db.RegisterMessage(ContainerResources)
+
+BlkioThrottle = _reflection.GeneratedProtocolMessageType('BlkioThrottle', (_message.Message,), {
+  'DESCRIPTOR' : _BLKIOTHROTTLE,
+  '__module__' : 'config.vm_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.BlkioThrottle)
+  })
+_sym_db.RegisterMessage(BlkioThrottle)
+
+HugepageLimit = _reflection.GeneratedProtocolMessageType('HugepageLimit', (_message.Message,), {
+  'DESCRIPTOR' : _HUGEPAGELIMIT,
+  '__module__' : 'config.vm_pb2'
+  # @@protoc_insertion_point(class_scope:org.lfedge.eve.config.HugepageLimit)
+  })
+_sym_db.RegisterMessage(HugepageLimit)
+
 
 DESCRIPTOR._options = None
 # @@protoc_insertion_point(module_scope)
@@ -21,7 +21,7 @@ DESCRIPTOR = _descriptor.FileDescriptor(
   syntax='proto3',
   serialized_options=b'\n\026org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlog',
   create_key=_descriptor._internal_create_key,
-  serialized_pb=b'\n\x15\x66lowlog/flowlog.proto\x12\x16org.lfedge.eve.flowlog\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n\x06IpFlow\x12\x0b\n\x03src\x18\x01 \x01(\t\x12\x0f\n\x07srcPort\x18\x02 \x01(\x05\x12\x0c\n\x04\x64\x65st\x18\x03 \x01(\t\x12\x10\n\x08\x64\x65stPort\x18\x04 \x01(\x05\x12\x10\n\x08protocol\x18\x05 \x01(\x05\"O\n\tScopeInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04intf\x18\x02 \x01(\t\x12\x11\n\tlocalIntf\x18\x03 \x01(\t\x12\x13\n\x0bnetInstUUID\x18\x04 \x01(\t\"\xbc\x02\n\nFlowRecord\x12,\n\x04\x66low\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.flowlog.IpFlow\x12\x0f\n\x07inbound\x18\x02 \x01(\x08\x12\r\n\x05\x61\x63lId\x18\x03 \x01(\x05\x12\x0f\n\x07\x61\x63lName\x18\x04 \x01(\t\x12-\n\tstartTime\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07\x65ndTime\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07txBytes\x18\x08 \x01(\x03\x12\x0e\n\x06txPkts\x18\t \x01(\x03\x12\x0f\n\x07rxBytes\x18\n \x01(\x03\x12\x0e\n\x06rxPkts\x18\x0b \x01(\x03\x12\x31\n\x06\x61\x63tion\x18\x0c \x01(\x0e\x32!.org.lfedge.eve.flowlog.ACLAction\"n\n\nDnsRequest\x12\x10\n\x08hostName\x18\x01 \x01(\t\x12\r\n\x05\x61\x64\x64rs\x18\x02 \x03(\t\x12/\n\x0brequestTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63lNum\x18\x04 \x01(\x05\"\xb6\x01\n\x0b\x46lowMessage\x12\r\n\x05\x64\x65vId\x18\x01 \x01(\t\x12\x30\n\x05scope\x18\x02 \x01(\x0b\x32!.org.lfedge.eve.flowlog.ScopeInfo\x12\x31\n\x05\x66lows\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.flowlog.FlowRecord\x12\x33\n\x07\x64nsReqs\x18\x04 \x03(\x0b\x32\".org.lfedge.eve.flowlog.DnsRequest*@\n\tACLAction\x12\x11\n\rActionUnknown\x10\x00\x12\x0e\n\nActionDrop\x10\x01\x12\x10\n\x0c\x41\x63tionAccept\x10\x02\x42?\n\x16org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlogb\x06proto3'
+  serialized_pb=b'\n\x15\x66lowlog/flowlog.proto\x12\x16org.lfedge.eve.flowlog\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n\x06IpFlow\x12\x0b\n\x03src\x18\x01 \x01(\t\x12\x0f\n\x07srcPort\x18\x02 \x01(\x05\x12\x0c\n\x04\x64\x65st\x18\x03 \x01(\t\x12\x10\n\x08\x64\x65stPort\x18\x04 \x01(\x05\x12\x10\n\x08protocol\x18\x05 \x01(\x05\"O\n\tScopeInfo\x12\x0c\n\x04uuid\x18\x01 \x01(\t\x12\x0c\n\x04intf\x18\x02 \x01(\t\x12\x11\n\tlocalIntf\x18\x03 \x01(\t\x12\x13\n\x0bnetInstUUID\x18\x04 \x01(\t\"\xcc\x02\n\nFlowRecord\x12,\n\x04\x66low\x18\x01 \x01(\x0b\x32\x1e.org.lfedge.eve.flowlog.IpFlow\x12\x0f\n\x07inbound\x18\x02 \x01(\x08\x12\r\n\x05\x61\x63lId\x18\x03 \x01(\x05\x12\x0f\n\x07\x61\x63lName\x18\x04 \x01(\t\x12-\n\tstartTime\x18\x06 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12+\n\x07\x65ndTime\x18\x07 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07txBytes\x18\x08 \x01(\x03\x12\x0e\n\x06txPkts\x18\t \x01(\x03\x12\x0f\n\x07rxBytes\x18\n \x01(\x03\x12\x0e\n\x06rxPkts\x18\x0b \x01(\x03\x12\x31\n\x06\x61\x63tion\x18\x0c \x01(\x0e\x32!.org.lfedge.eve.flowlog.ACLAction\x12\x0e\n\x06vlanId\x18\r \x01(\r\"n\n\nDnsRequest\x12\x10\n\x08hostName\x18\x01 \x01(\t\x12\r\n\x05\x61\x64\x64rs\x18\x02 \x03(\t\x12/\n\x0brequestTime\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x61\x63lNum\x18\x04 \x01(\x05\"\xb6\x01\n\x0b\x46lowMessage\x12\r\n\x05\x64\x65vId\x18\x01 \x01(\t\x12\x30\n\x05scope\x18\x02 \x01(\x0b\x32!.org.lfedge.eve.flowlog.ScopeInfo\x12\x31\n\x05\x66lows\x18\x03 \x03(\x0b\x32\".org.lfedge.eve.flowlog.FlowRecord\x12\x33\n\x07\x64nsReqs\x18\x04 \x03(\x0b\x32\".org.lfedge.eve.flowlog.DnsRequest*@\n\tACLAction\x12\x11\n\rActionUnknown\x10\x00\x12\x0e\n\nActionDrop\x10\x01\x12\x10\n\x0c\x41\x63tionAccept\x10\x02\x42?\n\x16org.lfedge.eve.flowlogZ%github.com/lf-edge/eve/api/go/flowlogb\x06proto3'
   ,
   dependencies=[google_dot_protobuf_dot_timestamp__pb2.DESCRIPTOR,])
 
@@ -50,8 +50,8 @@ _ACLACTION = _descriptor.EnumDescriptor(
   ],
   containing_type=None,
   serialized_options=None,
-  serialized_start=869,
-  serialized_end=933,
+  serialized_start=885,
+  serialized_end=949,
 )
 _sym_db.RegisterEnumDescriptor(_ACLACTION)
 
@@ -260,6 +260,13 @@ _FLOWRECORD = _descriptor.Descriptor(
       message_type=None, enum_type=None, containing_type=None,
       is_extension=False, extension_scope=None,
       serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
+    _descriptor.FieldDescriptor(
+      name='vlanId', full_name='org.lfedge.eve.flowlog.FlowRecord.vlanId', index=11,
+      number=13, type=13, cpp_type=3, label=1,
+      has_default_value=False, default_value=0,
+      message_type=None, enum_type=None, containing_type=None,
+      is_extension=False, extension_scope=None,
+      serialized_options=None, file=DESCRIPTOR,  create_key=_descriptor._internal_create_key),
   ],
   extensions=[
   ],
@@ -273,7 +280,7 @@ _FLOWRECORD = _descriptor.Descriptor(
   oneofs=[
   ],
   serialized_start=254,
-  serialized_end=570,
+  serialized_end=586,
 )
 
 
@@ -325,8 +332,8 @@ _DNSREQUEST = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=572,
-  serialized_end=682,
+  serialized_start=588,
+  serialized_end=698,
 )
 
 
@@ -378,8 +385,8 @@ _FLOWMESSAGE = _descriptor.Descriptor(
   extension_ranges=[],
   oneofs=[
   ],
-  serialized_start=685,
-  serialized_end=867,
+  serialized_start=701,
+  serialized_end=883,
 )
 
 _FLOWRECORD.fields_by_name['flow'].message_type = _IPFLOW
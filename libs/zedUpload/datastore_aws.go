@@ -198,7 +198,7 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 						return
 					}
 				case <-ticker.C:
-					req.doneParts = stats.DoneParts
+					req.setDoneParts(stats.DoneParts)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
@@ -213,7 +213,7 @@ func (ep *AwsTransportMethod) processS3Download(req *DronaRequest) (error, int)
 		sc = sc.WithContext(req.cancelContext)
 	}
 	doneParts, err := sc.DownloadFile(req.objloc, ep.bucket, req.name, req.sizelimit, req.doneParts, prgChan)
-	req.doneParts = doneParts
+	req.setDoneParts(doneParts)
 	if err != nil {
 		return err, 0
 	}
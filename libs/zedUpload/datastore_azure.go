@@ -140,14 +140,14 @@ func (ep *AzureTransportMethod) processAzureDownload(req *DronaRequest) error {
 						return
 					}
 				case <-ticker.C:
-					req.doneParts = stats.DoneParts
+					req.setDoneParts(stats.DoneParts)
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
 	doneParts, err := azure.DownloadAzureBlob(ep.acName, ep.acKey, ep.container, file, req.objloc, req.sizelimit, ep.hClient, req.doneParts, prgChan)
-	req.doneParts = doneParts
+	req.setDoneParts(doneParts)
 	if err != nil {
 		return err
 	}
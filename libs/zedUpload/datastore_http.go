@@ -156,12 +156,17 @@ func (ep *HttpTransportMethod) processHttpDownload(req *DronaRequest) (error, in
 						return
 					}
 				case <-ticker.C:
+					if len(stats.DoneParts.Parts) > 0 {
+						req.setDoneParts(stats.DoneParts)
+					}
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
-	resp := zedHttp.ExecCmd(req.cancelContext, "get", file, "", req.objloc, req.sizelimit, prgChan, ep.hClient)
+	resp := zedHttp.DownloadParts(req.cancelContext, file, req.objloc, req.sizelimit,
+		req.GetDoneParts(), req.concurrency, prgChan, ep.hClient)
+	req.setDoneParts(resp.DoneParts)
 	if resp.Error != nil {
 		return resp.Error, resp.BodyLength
 	}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	ociutil "github.com/lf-edge/eve/libs/zedUpload/ociutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 )
 
 // OCITransportMethod transport method to send images from OCI distribution
@@ -159,6 +160,9 @@ func (ep *OCITransportMethod) processDownload(req *DronaRequest) (int64, string,
 						return
 					}
 				case <-ticker.C:
+					if len(stats.DoneParts.Parts) > 0 {
+						req.setDoneParts(stats.DoneParts)
+					}
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
@@ -166,7 +170,9 @@ func (ep *OCITransportMethod) processDownload(req *DronaRequest) (int64, string,
 	}
 
 	// Pull down the blob as is and save it to a file named for the hash
-	size, contentType, err = ociutil.PullBlob(ep.registry, ep.path, req.ImageSha256, req.objloc, ep.uname, ep.apiKey, req.sizelimit, ep.hClient, prgChan)
+	var doneParts types.DownloadedParts
+	size, contentType, doneParts, err = ociutil.PullBlob(req.cancelContext, ep.registry, ep.path, req.ImageSha256, req.objloc, ep.uname, ep.apiKey, req.sizelimit, ep.hClient, req.GetDoneParts(), req.concurrency, prgChan)
+	req.setDoneParts(doneParts)
 	// zedUpload's job is to download a blob from an OCI registry. Done.
 	return size, contentType, err
 }
@@ -95,6 +95,9 @@ type DronaRequest struct {
 
 	//downloaded parts indexes
 	doneParts types.DownloadedParts
+
+	// number of parts downloaded in parallel
+	concurrency int
 }
 
 // Return object local name
@@ -353,5 +356,22 @@ func (req *DronaRequest) WithDoneParts(doneParts types.DownloadedParts) *DronaRe
 
 // GetDoneParts returns already downloaded parts indexes
 func (req *DronaRequest) GetDoneParts() types.DownloadedParts {
+	req.Lock()
+	defer req.Unlock()
 	return req.doneParts
 }
+
+// setDoneParts updates the downloaded parts
+func (req *DronaRequest) setDoneParts(doneParts types.DownloadedParts) {
+	req.Lock()
+	defer req.Unlock()
+	req.doneParts = doneParts
+}
+
+// WithConcurrency sets the number of parts which are downloaded in
+// parallel by the transports which support range downloads
+func (req *DronaRequest) WithConcurrency(concurrency int) *DronaRequest {
+
+	req.concurrency = concurrency
+	return req
+}
@@ -171,13 +171,18 @@ func (ep *SftpTransportMethod) processSftpDownload(req *DronaRequest) (error, in
 						return
 					}
 				case <-ticker.C:
+					if len(stats.DoneParts.Parts) > 0 {
+						req.setDoneParts(stats.DoneParts)
+					}
 					ep.ctx.postSize(req, stats.Size, stats.Asize)
 				}
 			}
 		}(req, prgChan)
 	}
 
-	resp := sftp.ExecCmd("fetch", ep.surl, ep.uname, ep.passwd, file, req.objloc, req.sizelimit, prgChan)
+	resp := sftp.DownloadParts(req.cancelContext, ep.surl, ep.uname, ep.passwd, file, req.objloc,
+		req.sizelimit, req.GetDoneParts(), req.concurrency, prgChan)
+	req.setDoneParts(resp.DoneParts)
 	return resp.Error, int(resp.Asize)
 }
 
@@ -18,6 +18,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/libs/zedUpload/rangeutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 	logutils "github.com/lf-edge/eve/pkg/pillar/utils/logging"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/html"
@@ -38,6 +40,7 @@ type UpdateStats struct {
 	Error         error
 	BodyLength    int   // Body legth in http response
 	ContentLength int64 // Content length in http response
+	DoneParts     types.DownloadedParts
 }
 
 type NotifChan chan UpdateStats
@@ -367,3 +370,114 @@ func ExecCmd(ctx context.Context, cmd, host, remoteFile, localFile string, objSi
 		return stats
 	}
 }
+
+// rangeInfo returns the size and the validator of the object, and
+// whether the server supports range requests for it. Only a failed
+// request is an error.
+func rangeInfo(ctx context.Context, host string, client *http.Client) (int64, string, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, host, nil)
+	if err != nil {
+		return 0, "", false, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, "", false, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		logrus.Infof("rangeInfo: bad response code for head %s: %d",
+			host, resp.StatusCode)
+		return 0, "", false, nil
+	}
+	// weak ETags cannot be used in If-Range
+	validator := resp.Header.Get("ETag")
+	if validator == "" || strings.HasPrefix(validator, "W/") {
+		validator = resp.Header.Get("Last-Modified")
+	}
+	ok := resp.Header.Get("Accept-Ranges") == "bytes" && resp.ContentLength > 0
+	return resp.ContentLength, validator, ok, nil
+}
+
+// rangeFetcher gets byte ranges of the object with Range requests.
+// With If-Range the server sends the whole object instead if it changed,
+// which is reported as an error.
+func rangeFetcher(host, validator string, client *http.Client) rangeutil.Fetcher {
+	return func(ctx context.Context, start, length int64, w io.Writer) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, host, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+		if validator != "" {
+			req.Header.Set("If-Range", validator)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusPartialContent {
+			return fmt.Errorf("bad response code for range request: %d",
+				resp.StatusCode)
+		}
+		_, err = io.CopyN(w, resp.Body, length)
+		return err
+	}
+}
+
+// DownloadParts downloads the object in parts which are fetched in
+// parallel and resumed from doneParts. If the server does not support
+// range requests it falls back to a sequential "get".
+func DownloadParts(ctx context.Context, host, localFile string, objMaxSize int64,
+	doneParts types.DownloadedParts, concurrency int, prgNotify NotifChan,
+	client *http.Client) UpdateStats {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client == nil {
+		client = getHttpClient()
+	}
+	stats := UpdateStats{DoneParts: doneParts}
+	size, validator, ok, err := rangeInfo(ctx, host, client)
+	if err != nil {
+		// keep the parts to resume on the next attempt
+		stats.Error = fmt.Errorf("head failed for %s: %s", host, err)
+		return stats
+	}
+	if !ok {
+		logrus.Infof("DownloadParts %s: no range support, using get", host)
+		return ExecCmd(ctx, "get", host, "", localFile, objMaxSize, prgNotify, client)
+	}
+	stats.Size = size
+	if objMaxSize != 0 && size > objMaxSize {
+		stats.Error = fmt.Errorf("configured image size (%d) is less than size of file (%d)",
+			objMaxSize, size)
+		return stats
+	}
+	stats.DoneParts, stats.Error = rangeutil.Download(ctx, rangeutil.Options{
+		LocalFile:   localFile,
+		Size:        size,
+		Concurrency: concurrency,
+		Validator:   validator,
+		DoneParts:   doneParts,
+		Fetch:       rangeFetcher(host, validator, client),
+		Progress: func(doneParts types.DownloadedParts, asize int64) {
+			if prgNotify != nil {
+				select {
+				case prgNotify <- UpdateStats{Size: size, Asize: asize, DoneParts: doneParts}:
+				default: //ignore we cannot write
+				}
+			}
+		},
+	})
+	if stats.Error != nil {
+		stats.Error = fmt.Errorf("%s: %v", host, stats.Error)
+		return stats
+	}
+	stats.Asize = size
+	stats.BodyLength = int(size)
+	return stats
+}
@@ -0,0 +1,136 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package ociutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/lf-edge/eve/libs/zedUpload/rangeutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/sirupsen/logrus"
+)
+
+// blobClient returns a client which is authenticated for pulling
+// from the repository of ref
+func blobClient(ctx context.Context, ref name.Digest, auth authn.Authenticator, client *http.Client) (*http.Client, error) {
+	t := http.DefaultTransport
+	if client != nil && client.Transport != nil {
+		t = client.Transport
+	}
+	repo := ref.Context()
+	rt, err := transport.NewWithContext(ctx, repo.Registry, auth, t,
+		[]string{repo.Scope(transport.PullScope)})
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: rt}, nil
+}
+
+// blobURL is the /v2/<repo>/blobs/<digest> endpoint of the registry
+func blobURL(ref name.Digest) string {
+	repo := ref.Context()
+	u := url.URL{
+		Scheme: repo.Registry.Scheme(),
+		Host:   repo.RegistryStr(),
+		Path:   fmt.Sprintf("/v2/%s/blobs/%s", repo.RepositoryStr(), ref.DigestStr()),
+	}
+	return u.String()
+}
+
+// blobRangeInfo returns the size of the blob and whether the registry
+// (or the storage it redirects to) supports range requests
+func blobRangeInfo(ctx context.Context, u string, client *http.Client) (int64, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, u, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, false, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, false, fmt.Errorf("bad response code for head %s: %d",
+			u, resp.StatusCode)
+	}
+	ok := resp.Header.Get("Accept-Ranges") == "bytes" && resp.ContentLength > 0
+	return resp.ContentLength, ok, nil
+}
+
+// blobRangeFetcher gets byte ranges of the blob. The blob is addressed
+// by its digest hence cannot change between requests.
+func blobRangeFetcher(u string, client *http.Client) rangeutil.Fetcher {
+	return func(ctx context.Context, start, length int64, w io.Writer) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", start, start+length-1))
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusPartialContent {
+			return fmt.Errorf("bad response code for range request: %d",
+				resp.StatusCode)
+		}
+		_, err = io.CopyN(w, resp.Body, length)
+		return err
+	}
+}
+
+// pullBlobParts downloads the blob to localFile in parts resumed from
+// doneParts. It returns false if the registry does not support range
+// requests, in which case the caller should stream the blob instead.
+func pullBlobParts(ctx context.Context, ref name.Digest, localFile string, maxsize int64,
+	auth authn.Authenticator, client *http.Client, doneParts types.DownloadedParts,
+	concurrency int, prgchan NotifChan) (int64, types.DownloadedParts, bool, error) {
+
+	bc, err := blobClient(ctx, ref, auth, client)
+	if err != nil {
+		logrus.Warnf("pullBlobParts(%s): cannot authenticate: %v", ref.String(), err)
+		return 0, doneParts, false, nil
+	}
+	u := blobURL(ref)
+	size, ok, err := blobRangeInfo(ctx, u, bc)
+	if err != nil {
+		logrus.Warnf("pullBlobParts(%s): %v", ref.String(), err)
+		return 0, doneParts, false, nil
+	}
+	if !ok {
+		logrus.Infof("pullBlobParts(%s): no range support", ref.String())
+		return 0, doneParts, false, nil
+	}
+	if maxsize != 0 && size > maxsize {
+		return 0, doneParts, true, fmt.Errorf("actual size of blob %s %d is more than provided %d",
+			ref.String(), size, maxsize)
+	}
+	stats := UpdateStats{Size: size, Asize: doneParts.TotalSize(), DoneParts: doneParts}
+	sendStats(prgchan, stats)
+	doneParts, err = rangeutil.Download(ctx, rangeutil.Options{
+		LocalFile:   localFile,
+		Size:        size,
+		Concurrency: concurrency,
+		Validator:   ref.DigestStr(),
+		DoneParts:   doneParts,
+		Fetch:       blobRangeFetcher(u, bc),
+		Progress: func(parts types.DownloadedParts, asize int64) {
+			sendStats(prgchan, UpdateStats{Size: size, Asize: asize, DoneParts: parts})
+		},
+	})
+	if err != nil {
+		return 0, doneParts, true, err
+	}
+	logrus.Infof("pullBlobParts(%s): download complete to %s size %d",
+		ref.String(), localFile, size)
+	return size, doneParts, true, nil
+}
@@ -2,6 +2,7 @@ package ociutil
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	v1 "github.com/google/go-containerregistry/pkg/v1"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 	"github.com/sirupsen/logrus"
 )
 
@@ -25,6 +27,8 @@ type UpdateStats struct {
 	Asize int64    // current size uploaded/downloaded
 	Tags  []string //list of tags for given image
 	Error error
+	// DoneParts of a ranged blob download
+	DoneParts types.DownloadedParts
 }
 
 // NotifChan channel for sending status updates
@@ -76,7 +80,10 @@ func Manifest(registry, repo, username, apiKey string, client *http.Client, prgc
 }
 
 // PullBlob downloads a blob from a registry and save it as a file as-is.
-func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize int64, client *http.Client, prgchan NotifChan) (int64, string, error) {
+// If the registry supports range requests a blob is downloaded in parts,
+// concurrency of them in parallel, and resumed from doneParts. The parts
+// downloaded so far are returned also on error.
+func PullBlob(ctx context.Context, registry, repo, hash, localFile, username, apiKey string, maxsize int64, client *http.Client, doneParts types.DownloadedParts, concurrency int, prgchan NotifChan) (int64, string, types.DownloadedParts, error) {
 	logrus.Infof("PullBlob(%s, %s, %s) to %s", registry, repo, hash, localFile)
 
 	var (
@@ -88,6 +95,9 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 		contentType string
 	)
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	opts := options(username, apiKey, client)
 
 	// The OCI distribution spec only uses /blobs/ endpoint for layers or config, not index or manifest.
@@ -95,7 +105,7 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 	image := fmt.Sprintf("%s/%s", registry, repo)
 	ref, err := name.ParseReference(image)
 	if err != nil {
-		return 0, "", fmt.Errorf("parsing reference %q: %v", image, err)
+		return 0, "", doneParts, fmt.Errorf("parsing reference %q: %v", image, err)
 	}
 
 	// If hash is not empty:
@@ -108,15 +118,15 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 			image = fmt.Sprintf("%s@%s", image, hash)
 			ref, err = name.ParseReference(image)
 			if err != nil {
-				return 0, "", fmt.Errorf("parsing reference %q: %v", image, err)
+				return 0, "", doneParts, fmt.Errorf("parsing reference %q: %v", image, err)
 			}
 		} else {
 			d, ok := ref.(name.Digest)
 			if !ok {
-				return 0, "", fmt.Errorf("ref %s wasn't a tag or digest", image)
+				return 0, "", doneParts, fmt.Errorf("ref %s wasn't a tag or digest", image)
 			}
 			if checkAndCorrectHash(d.DigestStr()) != hash {
-				return 0, "", fmt.Errorf("PullBlob: given hash %s is different from the hash in reference %s",
+				return 0, "", doneParts, fmt.Errorf("PullBlob: given hash %s is different from the hash in reference %s",
 					hash, checkAndCorrectHash(d.DigestStr()))
 			}
 		}
@@ -130,30 +140,38 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 		// if we have a hash try to get the actual layer
 		d, ok := ref.(name.Digest)
 		if !ok {
-			return 0, "", fmt.Errorf("ref %s wasn't a tag or digest", image)
+			return 0, "", doneParts, fmt.Errorf("ref %s wasn't a tag or digest", image)
 		}
 		logrus.Infof("PullBlob: had hash, so pulling blob for %s", image)
+		if localFile != "" {
+			var ranged bool
+			size, doneParts, ranged, err = pullBlobParts(ctx, d, localFile, maxsize,
+				authenticator(username, apiKey), client, doneParts, concurrency, prgchan)
+			if ranged {
+				return size, "", doneParts, err
+			}
+		}
 		layer, err := remote.Layer(d, opts...)
 		if err != nil {
-			return 0, "", fmt.Errorf("could not pull layer %s: %v", ref.String(), err)
+			return 0, "", doneParts, fmt.Errorf("could not pull layer %s: %v", ref.String(), err)
 		}
 		// write the layer out to the file
 		lr, err := layer.Compressed()
 		if err != nil {
-			return 0, "", fmt.Errorf("could not get layer reader %s: %v", ref.String(), err)
+			return 0, "", doneParts, fmt.Errorf("could not get layer reader %s: %v", ref.String(), err)
 		} else {
 			defer lr.Close()
 			r = lr
 		}
 		size, err = layer.Size()
 		if err != nil {
-			return 0, "", fmt.Errorf("could not get layer size %s: %v", ref.String(), err)
+			return 0, "", doneParts, fmt.Errorf("could not get layer size %s: %v", ref.String(), err)
 		}
 	}
 
 	// check size in case of provided maxsize
 	if maxsize != 0 && size > maxsize {
-		return 0, "", fmt.Errorf("actual size of blob (%s, %s, %s) %d is more than provided %d",
+		return 0, "", doneParts, fmt.Errorf("actual size of blob (%s, %s, %s) %d is more than provided %d",
 			registry, repo, hash, size, maxsize)
 	}
 
@@ -164,7 +182,7 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 	if localFile != "" {
 		f, err := os.Create(localFile)
 		if err != nil {
-			return 0, "", fmt.Errorf("could not open local file %s for writing from %s: %v", localFile, ref.String(), err)
+			return 0, "", doneParts, fmt.Errorf("could not open local file %s for writing from %s: %v", localFile, ref.String(), err)
 		}
 		defer f.Close()
 		w = f
@@ -219,7 +237,7 @@ func PullBlob(registry, repo, hash, localFile, username, apiKey string, maxsize
 		}
 	}
 	logrus.Infof("PullBlob(%s): Done. Size: %d, ContentType: %s FinalErr: %v", image, size, contentType, finalErr)
-	return size, contentType, finalErr
+	return size, contentType, doneParts, finalErr
 }
 
 // ociGetManifest get an OCI manifest
@@ -328,15 +346,19 @@ func sendStats(prgChan NotifChan, stats UpdateStats) {
 	}
 }
 
-func options(username, apiKey string, client *http.Client) []remote.Option {
+func authenticator(username, apiKey string) authn.Authenticator {
 	// default to anonymous, unless we have auth credentials
 	auth := authn.Anonymous
 	// do we have auth to use?
 	if username != "" || apiKey != "" {
 		auth = authn.FromConfig(authn.AuthConfig{Username: username, Password: apiKey})
 	}
+	return auth
+}
+
+func options(username, apiKey string, client *http.Client) []remote.Option {
 	return []remote.Option{
-		remote.WithAuth(auth),
+		remote.WithAuth(authenticator(username, apiKey)),
 		remote.WithTransport(client.Transport),
 	}
 }
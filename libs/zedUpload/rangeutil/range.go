@@ -0,0 +1,258 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package rangeutil downloads an object as a set of byte ranges (parts)
+// which are fetched in parallel and which can be resumed after a
+// connection drop or a reboot using the DownloadedParts of the previous
+// attempt. The transports provide a Fetcher for their range requests.
+package rangeutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultPartSize is the size of the ranges
+	DefaultPartSize int64 = 16 * 1024 * 1024
+	// DefaultConcurrency is the number of ranges fetched in parallel
+	DefaultConcurrency = 1
+	// MaxConcurrency limits the number of ranges fetched in parallel
+	MaxConcurrency = 16
+
+	maxPartRetries = 5
+	maxPartDelay   = time.Minute
+	// if no data is received for a part for inactivityTimeout we retry it
+	inactivityTimeout = 5 * time.Minute
+)
+
+// Fetcher writes the bytes [start, start+length) of the object to w
+type Fetcher func(ctx context.Context, start, length int64, w io.Writer) error
+
+// Progress is called with a copy of the parts after data was written
+type Progress func(doneParts types.DownloadedParts, asize int64)
+
+// Options describe a ranged download
+type Options struct {
+	LocalFile   string
+	Size        int64 // size of the object, must be known
+	PartSize    int64 // default DefaultPartSize
+	Concurrency int   // default DefaultConcurrency
+	// Validator of the remote object; the parts of a previous attempt
+	// are discarded if it changed
+	Validator string
+	DoneParts types.DownloadedParts
+	Fetch     Fetcher
+	Progress  Progress
+}
+
+// part is a range of the object which remains to be fetched
+type part struct {
+	ind         int64
+	start, size int64 // of the whole part
+	written     int64 // by previous attempts
+}
+
+type download struct {
+	sync.Mutex
+	fd        *os.File
+	doneParts types.DownloadedParts
+	asize     int64
+	progress  Progress
+}
+
+// partWriter writes a part at its offset in the file and records
+// the progress in doneParts
+type partWriter struct {
+	d       *download
+	p       *part
+	touched func()
+}
+
+func (w *partWriter) Write(b []byte) (int, error) {
+	if w.p.written+int64(len(b)) > w.p.size {
+		return 0, fmt.Errorf("part %d: received more than %d bytes",
+			w.p.ind, w.p.size)
+	}
+	n, err := w.d.fd.WriteAt(b, w.p.start+w.p.written)
+	if n > 0 {
+		w.touched()
+		w.d.Lock()
+		w.p.written += int64(n)
+		w.d.asize += int64(n)
+		w.d.doneParts.SetPartSize(w.p.ind, w.p.written)
+		doneParts := w.d.doneParts.Copy()
+		asize := w.d.asize
+		w.d.Unlock()
+		if w.d.progress != nil {
+			w.d.progress(doneParts, asize)
+		}
+	}
+	return n, err
+}
+
+// neededParts returns the parts which are not completely downloaded
+func neededParts(doneParts types.DownloadedParts, size int64) []*part {
+	partSize := doneParts.PartSize
+	var needed []*part
+	for start, ind := int64(0), int64(0); start < size; start, ind = start+partSize, ind+1 {
+		p := &part{ind: ind, start: start, size: partSize}
+		if start+partSize > size {
+			p.size = size - start
+		}
+		p.written = doneParts.PartSizeOf(ind)
+		if p.written < p.size {
+			needed = append(needed, p)
+		}
+	}
+	return needed
+}
+
+// Download fetches the missing parts of the object into LocalFile.
+// It returns the parts downloaded so far, also on error, which should
+// be passed back in Options.DoneParts to resume.
+func Download(ctx context.Context, opts Options) (types.DownloadedParts, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Size <= 0 {
+		return opts.DoneParts, fmt.Errorf("unknown size of %s", opts.LocalFile)
+	}
+	if opts.PartSize <= 0 {
+		opts.PartSize = DefaultPartSize
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = DefaultConcurrency
+	}
+	if opts.Concurrency > MaxConcurrency {
+		opts.Concurrency = MaxConcurrency
+	}
+	if err := os.MkdirAll(filepath.Dir(opts.LocalFile), 0755); err != nil {
+		return opts.DoneParts, err
+	}
+
+	doneParts := opts.DoneParts
+	flags := os.O_RDWR
+	_, err := os.Stat(opts.LocalFile)
+	if err != nil || doneParts.PartSize != opts.PartSize ||
+		doneParts.Validator != opts.Validator {
+		if len(doneParts.Parts) > 0 {
+			logrus.Infof("rangeutil: discarding downloaded parts of %s",
+				opts.LocalFile)
+		}
+		doneParts = types.DownloadedParts{
+			PartSize:  opts.PartSize,
+			Validator: opts.Validator,
+		}
+		flags |= os.O_CREATE | os.O_TRUNC
+	}
+	fd, err := os.OpenFile(opts.LocalFile, flags, 0666)
+	if err != nil {
+		return doneParts, err
+	}
+	defer fd.Close()
+
+	d := &download{
+		fd:        fd,
+		doneParts: doneParts.Copy(),
+		asize:     doneParts.TotalSize(),
+		progress:  opts.Progress,
+	}
+	needed := neededParts(doneParts, opts.Size)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	ch := make(chan *part)
+	workers := opts.Concurrency
+	if workers > len(needed) {
+		workers = len(needed)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range ch {
+				if err := fetchPart(ctx, d, p, opts.Fetch); err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for _, p := range needed {
+		if ctx.Err() != nil {
+			break
+		}
+		ch <- p
+	}
+	close(ch)
+	wg.Wait()
+
+	d.Lock()
+	doneParts = d.doneParts.Copy()
+	d.Unlock()
+	if firstErr == nil && ctx.Err() != nil {
+		firstErr = ctx.Err()
+	}
+	return doneParts, firstErr
+}
+
+// fetchPart fetches the rest of the part and retries with backoff
+// continuing from what was written
+func fetchPart(ctx context.Context, d *download, p *part, fetch Fetcher) error {
+	delay := time.Second
+	var lastErr error
+	for attempt := 0; attempt < maxPartRetries; attempt++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if attempt > 0 {
+			logrus.Warnf("rangeutil: part %d failed (attempt %d/%d): %v",
+				p.ind, attempt, maxPartRetries, lastErr)
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			if delay < maxPartDelay {
+				delay *= 2
+			}
+		}
+		partCtx, partCancel := context.WithCancel(ctx)
+		inactivityTimer := time.AfterFunc(inactivityTimeout, partCancel)
+		w := &partWriter{d: d, p: p, touched: func() {
+			inactivityTimer.Reset(inactivityTimeout)
+		}}
+		start := p.start + p.written
+		length := p.size - p.written
+		lastErr = fetch(partCtx, start, length, w)
+		inactivityTimer.Stop()
+		timedOut := partCtx.Err() != nil && ctx.Err() == nil
+		partCancel()
+		if lastErr == nil && p.written < p.size {
+			lastErr = fmt.Errorf("short read: %d of %d bytes", p.written-(start-p.start), length)
+		}
+		if lastErr == nil {
+			return nil
+		}
+		if timedOut {
+			lastErr = fmt.Errorf("inactivity for %s", inactivityTimeout)
+		}
+	}
+	return fmt.Errorf("part %d: %v", p.ind, lastErr)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rangeutil
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/lf-edge/eve/libs/zedUpload/types"
+)
+
+func testObject(size int) []byte {
+	b := make([]byte, size)
+	for i := range b {
+		b[i] = byte(i % 251)
+	}
+	return b
+}
+
+// fakeFetcher serves ranges of obj and records the requested starts
+type fakeFetcher struct {
+	sync.Mutex
+	obj    []byte
+	starts []int64
+	// failAfter truncates the first response of the part starting at
+	// the key after the given number of bytes
+	failAfter map[int64]int64
+}
+
+func (f *fakeFetcher) fetch(ctx context.Context, start, length int64, w io.Writer) error {
+	f.Lock()
+	f.starts = append(f.starts, start)
+	n, fail := f.failAfter[start]
+	delete(f.failAfter, start)
+	f.Unlock()
+	if fail {
+		if _, err := w.Write(f.obj[start : start+n]); err != nil {
+			return err
+		}
+		return fmt.Errorf("connection reset")
+	}
+	_, err := w.Write(f.obj[start : start+length])
+	return err
+}
+
+func TestNeededParts(t *testing.T) {
+	doneParts := types.DownloadedParts{
+		PartSize: 10,
+		Parts: []*types.PartDefinition{
+			{Ind: 0, Size: 10},
+			{Ind: 1, Size: 4},
+			{Ind: 3, Size: 5},
+		},
+	}
+	needed := neededParts(doneParts, 35)
+	if len(needed) != 2 {
+		t.Fatalf("expected 2 needed parts, got %d", len(needed))
+	}
+	if needed[0].ind != 1 || needed[0].written != 4 || needed[0].size != 10 {
+		t.Errorf("unexpected part %+v", *needed[0])
+	}
+	if needed[1].ind != 2 || needed[1].written != 0 || needed[1].size != 10 {
+		t.Errorf("unexpected part %+v", *needed[1])
+	}
+	// part 3 is the last and short one, complete with 5 bytes
+}
+
+func TestDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rangeutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	obj := testObject(1000)
+
+	testMatrix := map[string]struct {
+		concurrency int
+		failAfter   map[int64]int64
+	}{
+		"sequential": {concurrency: 1},
+		"parallel":   {concurrency: 4},
+		"retry":      {concurrency: 3, failAfter: map[int64]int64{300: 17}},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			localFile := filepath.Join(dir, testname)
+			f := &fakeFetcher{obj: obj, failAfter: test.failAfter}
+			doneParts, err := Download(context.Background(), Options{
+				LocalFile:   localFile,
+				Size:        int64(len(obj)),
+				PartSize:    100,
+				Concurrency: test.concurrency,
+				Validator:   "v1",
+				Fetch:       f.fetch,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doneParts.TotalSize() != int64(len(obj)) {
+				t.Errorf("parts cover %d bytes, expected %d",
+					doneParts.TotalSize(), len(obj))
+			}
+			b, err := ioutil.ReadFile(localFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(b, obj) {
+				t.Errorf("downloaded file differs from object")
+			}
+			if test.failAfter != nil {
+				// the retry continues after the 17 bytes written
+				found := false
+				for _, s := range f.starts {
+					if s == 317 {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("part was not resumed: %v", f.starts)
+				}
+			}
+		})
+	}
+}
+
+func TestDownloadResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rangeutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	obj := testObject(250)
+	localFile := filepath.Join(dir, "obj")
+
+	// a previous attempt downloaded part 0 and half of part 2
+	partial := make([]byte, len(obj))
+	copy(partial[0:100], obj[0:100])
+	copy(partial[200:225], obj[200:225])
+	if err := ioutil.WriteFile(localFile, partial, 0644); err != nil {
+		t.Fatal(err)
+	}
+	doneParts := types.DownloadedParts{
+		PartSize:  100,
+		Validator: "v1",
+		Parts: []*types.PartDefinition{
+			{Ind: 0, Size: 100},
+			{Ind: 2, Size: 25},
+		},
+	}
+
+	f := &fakeFetcher{obj: obj}
+	var lastAsize int64
+	doneParts, err = Download(context.Background(), Options{
+		LocalFile: localFile,
+		Size:      int64(len(obj)),
+		PartSize:  100,
+		Validator: "v1",
+		DoneParts: doneParts,
+		Fetch:     f.fetch,
+		Progress: func(_ types.DownloadedParts, asize int64) {
+			lastAsize = asize
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.starts) != 2 || f.starts[0] != 100 || f.starts[1] != 225 {
+		t.Errorf("unexpected fetches: %v", f.starts)
+	}
+	if lastAsize != int64(len(obj)) {
+		t.Errorf("progress reported %d bytes, expected %d", lastAsize, len(obj))
+	}
+	b, err := ioutil.ReadFile(localFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, obj) {
+		t.Errorf("downloaded file differs from object")
+	}
+
+	// the object changed hence everything is fetched again
+	f = &fakeFetcher{obj: obj}
+	doneParts, err = Download(context.Background(), Options{
+		LocalFile: localFile,
+		Size:      int64(len(obj)),
+		PartSize:  100,
+		Validator: "v2",
+		DoneParts: doneParts,
+		Fetch:     f.fetch,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.starts) != 3 {
+		t.Errorf("expected all 3 parts to be fetched, got %v", f.starts)
+	}
+	if doneParts.Validator != "v2" {
+		t.Errorf("unexpected validator %s", doneParts.Validator)
+	}
+}
+
+func TestDownloadError(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rangeutil")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	doneParts, err := Download(ctx, Options{
+		LocalFile: filepath.Join(dir, "obj"),
+		Size:      100,
+		PartSize:  10,
+		Fetch: func(ctx context.Context, start, length int64, w io.Writer) error {
+			return fmt.Errorf("not reached")
+		},
+	})
+	if err == nil {
+		t.Errorf("expected an error for a cancelled download")
+	}
+	if doneParts.TotalSize() != 0 {
+		t.Errorf("unexpected parts %+v", doneParts)
+	}
+}
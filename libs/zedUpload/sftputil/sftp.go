@@ -4,6 +4,7 @@
 package sftp
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
@@ -12,6 +13,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/lf-edge/eve/libs/zedUpload/rangeutil"
+	"github.com/lf-edge/eve/libs/zedUpload/types"
 	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 )
@@ -27,6 +30,7 @@ type UpdateStats struct {
 	List          []string //list of images at given path
 	Error         error
 	ContentLength int64
+	DoneParts     types.DownloadedParts
 }
 
 type NotifChan chan UpdateStats
@@ -216,3 +220,78 @@ func ExecCmd(cmd, host, user, pass, remoteFile, localFile string,
 		return stats
 	}
 }
+
+// rangeFetcher reads byte ranges of the remote file at an offset
+func rangeFetcher(client *sftp.Client, remoteFile string) rangeutil.Fetcher {
+	return func(ctx context.Context, start, length int64, w io.Writer) error {
+		fr, err := client.Open(remoteFile)
+		if err != nil {
+			return fmt.Errorf("open failed for %s: %s", remoteFile, err)
+		}
+		// closing the file makes a blocked read return
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				fr.Close()
+			case <-done:
+			}
+		}()
+		defer fr.Close()
+		if _, err := fr.Seek(start, io.SeekStart); err != nil {
+			return err
+		}
+		_, err = io.CopyN(w, fr, length)
+		return err
+	}
+}
+
+// DownloadParts downloads the remote file in parts which are read in
+// parallel and resumed from doneParts
+func DownloadParts(ctx context.Context, host, user, pass, remoteFile, localFile string,
+	objMaxSize int64, doneParts types.DownloadedParts, concurrency int,
+	prgNotify NotifChan) UpdateStats {
+
+	stats := UpdateStats{DoneParts: doneParts}
+	client, err := getSftpClient(host, user, pass)
+	if err != nil {
+		stats.Error = fmt.Errorf("sftpclient failed for %s: %s",
+			host, err)
+		return stats
+	}
+	defer client.Close()
+	fi, err := client.Stat(remoteFile)
+	if err != nil {
+		stats.Error = fmt.Errorf("stat failed for %s: %s",
+			remoteFile, err)
+		return stats
+	}
+	size := fi.Size()
+	stats.Size = size
+	if objMaxSize != 0 && size > objMaxSize {
+		stats.Error = fmt.Errorf("configured image size (%d) is less than size of file (%d)",
+			objMaxSize, size)
+		return stats
+	}
+	stats.DoneParts, stats.Error = rangeutil.Download(ctx, rangeutil.Options{
+		LocalFile:   localFile,
+		Size:        size,
+		Concurrency: concurrency,
+		Validator:   fmt.Sprintf("%d-%d", size, fi.ModTime().Unix()),
+		DoneParts:   doneParts,
+		Fetch:       rangeFetcher(client, remoteFile),
+		Progress: func(doneParts types.DownloadedParts, asize int64) {
+			if prgNotify != nil {
+				select {
+				case prgNotify <- UpdateStats{Size: size, Asize: asize, DoneParts: doneParts}:
+				default: //ignore we cannot write
+				}
+			}
+		},
+	})
+	if stats.Error == nil {
+		stats.Asize = size
+	}
+	return stats
+}
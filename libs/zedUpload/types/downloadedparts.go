@@ -19,6 +19,9 @@ type PartDefinition struct {
 type DownloadedParts struct {
 	PartSize int64             // the maximum partition size
 	Parts    []*PartDefinition // definition of downloaded parts
+	// Validator identifies the version of the remote object (e.g. ETag),
+	// the parts are discarded if it changes
+	Validator string `json:",omitempty"`
 }
 
 // Hash returns hash of DownloadedParts struct
@@ -45,3 +48,31 @@ func (dp *DownloadedParts) SetPartSize(ind, size int64) {
 	}
 	dp.Parts = append(dp.Parts, &PartDefinition{Ind: ind, Size: size})
 }
+
+//Copy returns a deep copy of DownloadedParts
+func (dp *DownloadedParts) Copy() DownloadedParts {
+	c := DownloadedParts{PartSize: dp.PartSize, Validator: dp.Validator}
+	for _, p := range dp.Parts {
+		c.Parts = append(c.Parts, &PartDefinition{Ind: p.Ind, Size: p.Size})
+	}
+	return c
+}
+
+//PartSizeOf returns the downloaded size of part ind
+func (dp *DownloadedParts) PartSizeOf(ind int64) int64 {
+	for _, p := range dp.Parts {
+		if p.Ind == ind {
+			return p.Size
+		}
+	}
+	return 0
+}
+
+//TotalSize returns the downloaded size of all parts
+func (dp *DownloadedParts) TotalSize() int64 {
+	var total int64
+	for _, p := range dp.Parts {
+		total += p.Size
+	}
+	return total
+}
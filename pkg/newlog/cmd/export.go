@@ -0,0 +1,508 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+)
+
+const (
+	defaultExportBuffer = 10000 // entries kept per target while the collector is unreachable
+	exportBatchSize     = 100
+	exportTimeout       = 30 * time.Second
+	exportMaxBackoff    = 60 * time.Second
+	otlpDefaultPath     = "/v1/logs"
+
+	syslogFacilityDaemon = 3 // device logs
+	syslogFacilityUser   = 1 // app logs
+)
+
+// syslog severities; logrus levels map to the closest one
+var syslogSeverity = map[string]int{
+	"emerg":   0,
+	"panic":   0,
+	"alert":   1,
+	"crit":    2,
+	"fatal":   2,
+	"err":     3,
+	"error":   3,
+	"warning": 4,
+	"warn":    4,
+	"notice":  5,
+	"info":    6,
+	"debug":   7,
+	"trace":   7,
+}
+
+// OTLP severity numbers of the syslog severities
+var otlpSeverity = [8]int{24, 23, 21, 17, 13, 10, 9, 5}
+
+func severityOf(level string) int {
+	if sev, ok := syslogSeverity[strings.ToLower(level)]; ok {
+		return sev
+	}
+	return syslogSeverity["info"]
+}
+
+// exportItem is a buffered entry; seq orders the entries of a target
+type exportItem struct {
+	seq  uint64
+	info agentlog.Loginfo
+}
+
+// exportTarget forwards the matching entries to one collector.
+// Configured as e.g.
+//
+//	syslog+tls://collector:6514?level=warning&source=zedagent,nim
+//	otlp+http://collector:4318/v1/logs?logs=apps&buffer=50000
+//
+// where logs is device, apps or all (the default).
+type exportTarget struct {
+	target  string
+	scheme  string
+	host    string // host:port for syslog
+	url     string // endpoint for OTLP
+	devLogs bool
+	appLogs bool
+	maxSev  int // -1 for all
+	sources map[string]bool
+	maxBuf  int
+
+	sync.Mutex
+	buf     []exportItem
+	nextSeq uint64
+	dropped uint64 // entries dropped because the buffer was full
+	wake    chan struct{}
+	done    chan struct{}
+
+	conn   net.Conn // syslog
+	client *http.Client
+}
+
+var (
+	exportTargets     []*exportTarget
+	exportTargetsLock sync.RWMutex
+	exportConfig      string
+)
+
+func newExportTarget(target string) (*exportTarget, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("no host in %s", target)
+	}
+	t := &exportTarget{
+		target:  target,
+		scheme:  u.Scheme,
+		devLogs: true,
+		appLogs: true,
+		maxSev:  -1,
+		maxBuf:  defaultExportBuffer,
+		wake:    make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+	switch u.Scheme {
+	case "syslog+tcp", "syslog+tls":
+		t.host = u.Host
+	case "otlp+http", "otlp+https":
+		path := u.Path
+		if path == "" {
+			path = otlpDefaultPath
+		}
+		t.url = strings.TrimPrefix(u.Scheme, "otlp+") + "://" + u.Host + path
+		t.client = &http.Client{Timeout: exportTimeout}
+	default:
+		return nil, fmt.Errorf("unknown scheme %s in %s", u.Scheme, target)
+	}
+	q := u.Query()
+	switch q.Get("logs") {
+	case "", "all":
+	case "device":
+		t.appLogs = false
+	case "apps":
+		t.devLogs = false
+	default:
+		return nil, fmt.Errorf("bad logs %s in %s", q.Get("logs"), target)
+	}
+	if level := q.Get("level"); level != "" {
+		sev, ok := syslogSeverity[strings.ToLower(level)]
+		if !ok {
+			return nil, fmt.Errorf("bad level %s in %s", level, target)
+		}
+		t.maxSev = sev
+	}
+	if sources := q.Get("source"); sources != "" {
+		t.sources = make(map[string]bool)
+		for _, s := range strings.Split(sources, ",") {
+			t.sources[s] = true
+		}
+	}
+	if buffer := q.Get("buffer"); buffer != "" {
+		n, err := strconv.Atoi(buffer)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("bad buffer %s in %s", buffer, target)
+		}
+		t.maxBuf = n
+	}
+	return t, nil
+}
+
+// updateExportTargets starts and stops the exports when the
+// newlog.export.targets setting changes
+func updateExportTargets(config string) {
+	if config == exportConfig {
+		return
+	}
+	exportConfig = config
+	old := make(map[string]*exportTarget)
+	exportTargetsLock.RLock()
+	for _, t := range exportTargets {
+		old[t.target] = t
+	}
+	exportTargetsLock.RUnlock()
+
+	var targets []*exportTarget
+	for _, target := range strings.Fields(config) {
+		if t, ok := old[target]; ok {
+			delete(old, target)
+			targets = append(targets, t)
+			continue
+		}
+		t, err := newExportTarget(target)
+		if err != nil {
+			log.Errorf("updateExportTargets: %v", err)
+			continue
+		}
+		log.Noticef("updateExportTargets: exporting logs to %s", target)
+		go t.run()
+		targets = append(targets, t)
+	}
+	exportTargetsLock.Lock()
+	exportTargets = targets
+	exportTargetsLock.Unlock()
+	for target, t := range old {
+		log.Noticef("updateExportTargets: stop exporting logs to %s", target)
+		close(t.done)
+	}
+}
+
+// exportEntry queues the entry for the targets it matches
+func exportEntry(entry inputEntry, appuuid string) {
+	exportTargetsLock.RLock()
+	defer exportTargetsLock.RUnlock()
+	if len(exportTargets) == 0 {
+		return
+	}
+	var pid int
+	if entry.pid != "" {
+		pid, _ = strconv.Atoi(entry.pid)
+	}
+	info := agentlog.Loginfo{
+		Level:         entry.severity,
+		Time:          entry.timestamp,
+		Msg:           entry.content,
+		Pid:           pid,
+		Function:      entry.function,
+		Filename:      entry.filename,
+		Source:        entry.source,
+		Appuuid:       appuuid,
+		Containername: entry.acName,
+		Eventtime:     entry.acLogTime,
+	}
+	for _, t := range exportTargets {
+		if t.match(info) {
+			t.queue(info)
+		}
+	}
+}
+
+func (t *exportTarget) match(info agentlog.Loginfo) bool {
+	if info.Appuuid == "" && !t.devLogs || info.Appuuid != "" && !t.appLogs {
+		return false
+	}
+	if t.maxSev >= 0 && severityOf(info.Level) > t.maxSev {
+		return false
+	}
+	if t.sources != nil && !t.sources[info.Source] {
+		return false
+	}
+	return true
+}
+
+// queue the entry, dropping the oldest one if the buffer is full
+func (t *exportTarget) queue(info agentlog.Loginfo) {
+	t.Lock()
+	if len(t.buf) >= t.maxBuf {
+		t.buf = t.buf[1:]
+		t.dropped++
+	}
+	t.buf = append(t.buf, exportItem{seq: t.nextSeq, info: info})
+	t.nextSeq++
+	t.Unlock()
+	select {
+	case t.wake <- struct{}{}:
+	default:
+	}
+}
+
+// peek returns the oldest entries without removing them
+func (t *exportTarget) peek() []exportItem {
+	t.Lock()
+	defer t.Unlock()
+	n := len(t.buf)
+	if n > exportBatchSize {
+		n = exportBatchSize
+	}
+	return append([]exportItem(nil), t.buf[:n]...)
+}
+
+// remove the entries up to and including seq once they were sent; some
+// of them might have been dropped from the buffer in the meantime
+func (t *exportTarget) remove(seq uint64) {
+	t.Lock()
+	defer t.Unlock()
+	i := 0
+	for i < len(t.buf) && t.buf[i].seq <= seq {
+		i++
+	}
+	t.buf = t.buf[i:]
+}
+
+func (t *exportTarget) run() {
+	backoff := time.Second
+	var lastDropped uint64
+	for {
+		select {
+		case <-t.done:
+			if t.conn != nil {
+				t.conn.Close()
+			}
+			return
+		case <-t.wake:
+		}
+		for {
+			batch := t.peek()
+			if len(batch) == 0 {
+				break
+			}
+			if err := t.send(batch); err != nil {
+				log.Warnf("export to %s failed: %v", t.target, err)
+				select {
+				case <-t.done:
+					if t.conn != nil {
+						t.conn.Close()
+					}
+					return
+				case <-time.After(backoff):
+				}
+				backoff *= 2
+				if backoff > exportMaxBackoff {
+					backoff = exportMaxBackoff
+				}
+				continue
+			}
+			backoff = time.Second
+			t.remove(batch[len(batch)-1].seq)
+		}
+		t.Lock()
+		dropped := t.dropped
+		t.Unlock()
+		if dropped != lastDropped {
+			log.Warnf("export to %s dropped %d entries in total since the collector was unreachable",
+				t.target, dropped)
+			lastDropped = dropped
+		}
+	}
+}
+
+func (t *exportTarget) send(batch []exportItem) error {
+	if t.client != nil {
+		return t.sendOTLP(batch)
+	}
+	return t.sendSyslog(batch)
+}
+
+// sendSyslog writes RFC5424 messages with octet counting framing (RFC6587)
+func (t *exportTarget) sendSyslog(batch []exportItem) error {
+	if t.conn == nil {
+		dialer := &net.Dialer{Timeout: exportTimeout}
+		var conn net.Conn
+		var err error
+		if t.scheme == "syslog+tls" {
+			host, _, _ := net.SplitHostPort(t.host)
+			conn, err = tls.DialWithDialer(dialer, "tcp", t.host,
+				&tls.Config{ServerName: host})
+		} else {
+			conn, err = dialer.Dial("tcp", t.host)
+		}
+		if err != nil {
+			return err
+		}
+		t.conn = conn
+	}
+	var b bytes.Buffer
+	for _, item := range batch {
+		msg := formatSyslog(item.info)
+		fmt.Fprintf(&b, "%d %s", len(msg), msg)
+	}
+	t.conn.SetWriteDeadline(time.Now().Add(exportTimeout))
+	if _, err := t.conn.Write(b.Bytes()); err != nil {
+		t.conn.Close()
+		t.conn = nil
+		return err
+	}
+	return nil
+}
+
+// syslogName returns a PRINTUSASCII header field of at most max characters
+func syslogName(s string, max int) string {
+	if s == "" {
+		return "-"
+	}
+	b := []byte(s)
+	if len(b) > max {
+		b = b[:max]
+	}
+	for i, c := range b {
+		if c < 33 || c > 126 {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// formatSyslog returns the RFC5424 message with the Loginfo JSON as MSG
+func formatSyslog(info agentlog.Loginfo) string {
+	facility := syslogFacilityDaemon
+	if info.Appuuid != "" {
+		facility = syslogFacilityUser
+	}
+	timestamp := "-"
+	if t, err := time.Parse(time.RFC3339Nano, info.Time); err == nil {
+		timestamp = t.UTC().Format("2006-01-02T15:04:05.000000Z07:00")
+	}
+	procID := "-"
+	if info.Pid != 0 {
+		procID = strconv.Itoa(info.Pid)
+	}
+	msg, _ := json.Marshal(&info)
+	return fmt.Sprintf("<%d>1 %s %s %s %s - - %s", facility*8+severityOf(info.Level),
+		timestamp, syslogName(devMetaData.uuid, 255), syslogName(info.Source, 48),
+		procID, msg)
+}
+
+// OTLP/HTTP JSON encoding of ExportLogsServiceRequest
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpLogRecord struct {
+	TimeUnixNano   string          `json:"timeUnixNano,omitempty"`
+	SeverityNumber int             `json:"severityNumber"`
+	SeverityText   string          `json:"severityText,omitempty"`
+	Body           otlpValue       `json:"body"`
+	Attributes     []otlpAttribute `json:"attributes,omitempty"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpResourceLogs struct {
+	Resource struct {
+		Attributes []otlpAttribute `json:"attributes"`
+	} `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+func otlpRecord(info agentlog.Loginfo) otlpLogRecord {
+	record := otlpLogRecord{
+		SeverityNumber: otlpSeverity[severityOf(info.Level)],
+		SeverityText:   info.Level,
+		Body:           otlpValue{info.Msg},
+	}
+	if t, err := time.Parse(time.RFC3339Nano, info.Time); err == nil {
+		record.TimeUnixNano = strconv.FormatInt(t.UnixNano(), 10)
+	}
+	attrs := []struct{ key, value string }{
+		{"source", info.Source},
+		{"func", info.Function},
+		{"file", info.Filename},
+		{"appuuid", info.Appuuid},
+		{"containername", info.Containername},
+		{"eventtime", info.Eventtime},
+	}
+	if info.Pid != 0 {
+		attrs = append(attrs, struct{ key, value string }{"pid", strconv.Itoa(info.Pid)})
+	}
+	for _, a := range attrs {
+		if a.value != "" {
+			record.Attributes = append(record.Attributes,
+				otlpAttribute{Key: a.key, Value: otlpValue{a.value}})
+		}
+	}
+	return record
+}
+
+func (t *exportTarget) sendOTLP(batch []exportItem) error {
+	var resource otlpResourceLogs
+	resource.Resource.Attributes = []otlpAttribute{
+		{Key: "service.name", Value: otlpValue{"eve"}},
+		{Key: "host.id", Value: otlpValue{devMetaData.uuid}},
+		{Key: "service.version", Value: otlpValue{devMetaData.imageVer}},
+	}
+	scope := otlpScopeLogs{Scope: otlpScope{Name: agentName}}
+	for _, item := range batch {
+		scope.LogRecords = append(scope.LogRecords, otlpRecord(item.info))
+	}
+	resource.ScopeLogs = []otlpScopeLogs{scope}
+	body, err := json.Marshal(otlpRequest{ResourceLogs: []otlpResourceLogs{resource}})
+	if err != nil {
+		return err
+	}
+	resp, err := t.client.Post(t.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+		resp.StatusCode != http.StatusTooManyRequests:
+		// retrying the same entries would fail again
+		log.Errorf("export to %s: %s, dropped %d entries", t.url, resp.Status, len(batch))
+		return nil
+	default:
+		return fmt.Errorf("%s: %s", t.url, resp.Status)
+	}
+}
@@ -0,0 +1,416 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/sirupsen/logrus"
+)
+
+func TestNewExportTarget(t *testing.T) {
+	testMatrix := map[string]struct {
+		target     string
+		expErr     bool
+		expHost    string
+		expURL     string
+		expDevLogs bool
+		expAppLogs bool
+		expMaxSev  int
+		expSources map[string]bool
+		expMaxBuf  int
+	}{
+		"syslog tcp": {
+			target:     "syslog+tcp://10.1.0.5:514",
+			expHost:    "10.1.0.5:514",
+			expDevLogs: true,
+			expAppLogs: true,
+			expMaxSev:  -1,
+			expMaxBuf:  defaultExportBuffer,
+		},
+		"syslog tls with filters": {
+			target:     "syslog+tls://collector:6514?level=warning&source=zedagent,nim&logs=device",
+			expHost:    "collector:6514",
+			expDevLogs: true,
+			expMaxSev:  4,
+			expSources: map[string]bool{"zedagent": true, "nim": true},
+			expMaxBuf:  defaultExportBuffer,
+		},
+		"otlp default path": {
+			target:     "otlp+http://collector:4318?logs=apps&buffer=50000",
+			expURL:     "http://collector:4318/v1/logs",
+			expAppLogs: true,
+			expMaxSev:  -1,
+			expMaxBuf:  50000,
+		},
+		"otlp https with path": {
+			target:     "otlp+https://collector/logs/v1?logs=all&level=ERR",
+			expURL:     "https://collector/logs/v1",
+			expDevLogs: true,
+			expAppLogs: true,
+			expMaxSev:  3,
+			expMaxBuf:  defaultExportBuffer,
+		},
+		"no host": {
+			target: "otlp+http:///v1/logs",
+			expErr: true,
+		},
+		"unknown scheme": {
+			target: "syslog+udp://10.1.0.5:514",
+			expErr: true,
+		},
+		"bad logs": {
+			target: "syslog+tcp://10.1.0.5:514?logs=kernel",
+			expErr: true,
+		},
+		"bad level": {
+			target: "syslog+tcp://10.1.0.5:514?level=loud",
+			expErr: true,
+		},
+		"bad buffer": {
+			target: "syslog+tcp://10.1.0.5:514?buffer=0",
+			expErr: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		target, err := newExportTarget(test.target)
+		if test.expErr {
+			if err == nil {
+				t.Errorf("%s: no error", testname)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", testname, err)
+			continue
+		}
+		if target.host != test.expHost || target.url != test.expURL {
+			t.Errorf("%s: host %s url %s instead of %s %s", testname,
+				target.host, target.url, test.expHost, test.expURL)
+		}
+		if (target.client != nil) != (test.expURL != "") {
+			t.Errorf("%s: client %v", testname, target.client)
+		}
+		if target.devLogs != test.expDevLogs || target.appLogs != test.expAppLogs {
+			t.Errorf("%s: devLogs %v appLogs %v", testname, target.devLogs,
+				target.appLogs)
+		}
+		if target.maxSev != test.expMaxSev || target.maxBuf != test.expMaxBuf {
+			t.Errorf("%s: maxSev %d maxBuf %d", testname, target.maxSev,
+				target.maxBuf)
+		}
+		if !reflect.DeepEqual(target.sources, test.expSources) {
+			t.Errorf("%s: sources %v", testname, target.sources)
+		}
+	}
+}
+
+func TestExportTargetMatch(t *testing.T) {
+	target, err := newExportTarget("syslog+tcp://10.1.0.5:514?level=warning&source=zedagent&logs=device")
+	if err != nil {
+		t.Fatal(err)
+	}
+	testMatrix := map[string]struct {
+		info     agentlog.Loginfo
+		expMatch bool
+	}{
+		"match": {
+			info:     agentlog.Loginfo{Level: "error", Source: "zedagent"},
+			expMatch: true,
+		},
+		"level": {
+			info: agentlog.Loginfo{Level: "info", Source: "zedagent"},
+		},
+		"source": {
+			info: agentlog.Loginfo{Level: "error", Source: "nim"},
+		},
+		"app": {
+			info: agentlog.Loginfo{Level: "error", Source: "zedagent", Appuuid: "app1"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		if match := target.match(test.info); match != test.expMatch {
+			t.Errorf("%s: match %v", testname, match)
+		}
+	}
+}
+
+func TestExportTargetQueue(t *testing.T) {
+	target, err := newExportTarget("syslog+tcp://10.1.0.5:514?buffer=3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		target.queue(agentlog.Loginfo{Msg: fmt.Sprintf("msg%d", i)})
+	}
+	// the oldest entries are dropped once the buffer is full
+	batch := target.peek()
+	if len(batch) != 3 || target.dropped != 2 {
+		t.Fatalf("%d entries %d dropped instead of 3 2", len(batch), target.dropped)
+	}
+	for i, item := range batch {
+		if item.seq != uint64(i+2) || item.info.Msg != fmt.Sprintf("msg%d", i+2) {
+			t.Errorf("entry %d: %d %s", i, item.seq, item.info.Msg)
+		}
+	}
+	// entries queued while the batch was sent stay
+	target.queue(agentlog.Loginfo{Msg: "msg5"})
+	target.remove(batch[len(batch)-1].seq)
+	batch = target.peek()
+	if len(batch) != 1 || batch[0].seq != 5 {
+		t.Errorf("after remove: %+v", batch)
+	}
+	// entries of the batch dropped meanwhile are not an issue
+	for i := 6; i < 10; i++ {
+		target.queue(agentlog.Loginfo{Msg: fmt.Sprintf("msg%d", i)})
+	}
+	target.remove(5)
+	batch = target.peek()
+	if len(batch) != 3 || batch[0].seq != 7 {
+		t.Errorf("after remove of dropped entries: %+v", batch)
+	}
+	target.remove(9)
+	if batch = target.peek(); len(batch) != 0 {
+		t.Errorf("after remove of all: %+v", batch)
+	}
+
+	// a batch is at most exportBatchSize entries
+	target, err = newExportTarget("syslog+tcp://10.1.0.5:514")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < exportBatchSize+10; i++ {
+		target.queue(agentlog.Loginfo{})
+	}
+	if batch = target.peek(); len(batch) != exportBatchSize {
+		t.Errorf("batch of %d", len(batch))
+	}
+}
+
+func TestFormatSyslog(t *testing.T) {
+	devMetaData.uuid = "8a2a6c24-4b4a-4d5e-a9b1-0c5c6d7e8f90"
+	testMatrix := map[string]struct {
+		info      agentlog.Loginfo
+		expHeader string
+	}{
+		"device": {
+			info: agentlog.Loginfo{
+				Level:  "error",
+				Time:   "2021-06-01T12:00:00.123456789Z",
+				Msg:    "hello",
+				Pid:    42,
+				Source: "zedagent",
+			},
+			expHeader: "<27>1 2021-06-01T12:00:00.123456Z " + devMetaData.uuid +
+				" zedagent 42 - - ",
+		},
+		"app": {
+			info: agentlog.Loginfo{
+				Level:   "info",
+				Time:    "2021-06-01T14:00:00+02:00",
+				Msg:     "hello",
+				Source:  "guest vm",
+				Appuuid: "app1",
+			},
+			expHeader: "<14>1 2021-06-01T12:00:00.000000Z " + devMetaData.uuid +
+				" guest_vm - - - ",
+		},
+		"unknown level and no time": {
+			info: agentlog.Loginfo{
+				Level:  "verbose",
+				Source: strings.Repeat("s", 60),
+			},
+			expHeader: "<30>1 - " + devMetaData.uuid + " " +
+				strings.Repeat("s", 48) + " - - - ",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		msg := formatSyslog(test.info)
+		if !strings.HasPrefix(msg, test.expHeader) {
+			t.Errorf("%s: %q does not start with %q", testname, msg,
+				test.expHeader)
+			continue
+		}
+		var info agentlog.Loginfo
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(msg, test.expHeader)),
+			&info); err != nil {
+			t.Errorf("%s: MSG is not JSON: %v", testname, err)
+		} else if info != test.info {
+			t.Errorf("%s: MSG %+v instead of %+v", testname, info, test.info)
+		}
+	}
+}
+
+func TestSendSyslog(t *testing.T) {
+	target, err := newExportTarget("syslog+tcp://10.1.0.5:514")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, server := net.Pipe()
+	target.conn = client
+	batch := []exportItem{
+		{seq: 0, info: agentlog.Loginfo{Level: "info", Msg: "first", Source: "nim"}},
+		{seq: 1, info: agentlog.Loginfo{Level: "info", Msg: "second", Source: "nim"}},
+	}
+	received := make(chan string)
+	go func() {
+		data, _ := ioutil.ReadAll(server)
+		received <- string(data)
+	}()
+	if err := target.sendSyslog(batch); err != nil {
+		t.Fatal(err)
+	}
+	client.Close()
+	// octet counting framing
+	var expected string
+	for _, item := range batch {
+		msg := formatSyslog(item.info)
+		expected += fmt.Sprintf("%d %s", len(msg), msg)
+	}
+	if data := <-received; data != expected {
+		t.Errorf("sent %q instead of %q", data, expected)
+	}
+}
+
+func TestOtlpRecord(t *testing.T) {
+	testMatrix := map[string]struct {
+		info      agentlog.Loginfo
+		expRecord otlpLogRecord
+	}{
+		"device": {
+			info: agentlog.Loginfo{
+				Level:    "warning",
+				Time:     "2021-06-01T12:00:00.5Z",
+				Msg:      "hello",
+				Pid:      42,
+				Function: "main.run",
+				Filename: "run.go:12",
+				Source:   "zedagent",
+			},
+			expRecord: otlpLogRecord{
+				TimeUnixNano:   "1622548800500000000",
+				SeverityNumber: 13,
+				SeverityText:   "warning",
+				Body:           otlpValue{"hello"},
+				Attributes: []otlpAttribute{
+					{Key: "source", Value: otlpValue{"zedagent"}},
+					{Key: "func", Value: otlpValue{"main.run"}},
+					{Key: "file", Value: otlpValue{"run.go:12"}},
+					{Key: "pid", Value: otlpValue{"42"}},
+				},
+			},
+		},
+		"app": {
+			info: agentlog.Loginfo{
+				Level:         "debug",
+				Time:          "bad",
+				Msg:           "hello",
+				Source:        "guest_vm",
+				Appuuid:       "app1",
+				Containername: "web",
+				Eventtime:     "2021-06-01T12:00:00Z",
+			},
+			expRecord: otlpLogRecord{
+				SeverityNumber: 5,
+				SeverityText:   "debug",
+				Body:           otlpValue{"hello"},
+				Attributes: []otlpAttribute{
+					{Key: "source", Value: otlpValue{"guest_vm"}},
+					{Key: "appuuid", Value: otlpValue{"app1"}},
+					{Key: "containername", Value: otlpValue{"web"}},
+					{Key: "eventtime", Value: otlpValue{"2021-06-01T12:00:00Z"}},
+				},
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		record := otlpRecord(test.info)
+		if !reflect.DeepEqual(record, test.expRecord) {
+			t.Errorf("%s: %+v instead of %+v", testname, record, test.expRecord)
+		}
+	}
+}
+
+func TestSendOTLP(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), agentName, 0)
+	devMetaData.uuid = "8a2a6c24-4b4a-4d5e-a9b1-0c5c6d7e8f90"
+	devMetaData.imageVer = "0.0.0-test"
+	var status int
+	var request otlpRequest
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		request = otlpRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("bad request: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	target, err := newExportTarget("otlp+" + server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch := []exportItem{
+		{seq: 0, info: agentlog.Loginfo{Level: "info", Msg: "first", Source: "nim"}},
+		{seq: 1, info: agentlog.Loginfo{Level: "error", Msg: "second", Appuuid: "app1"}},
+	}
+
+	testMatrix := map[string]struct {
+		status int
+		expErr bool
+	}{
+		"ok":                {status: http.StatusOK},
+		"bad request drops": {status: http.StatusBadRequest},
+		"too many requests": {status: http.StatusTooManyRequests, expErr: true},
+		"server error":      {status: http.StatusServiceUnavailable, expErr: true},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		status = test.status
+		err := target.sendOTLP(batch)
+		if (err != nil) != test.expErr {
+			t.Errorf("%s: error %v", testname, err)
+		}
+		if contentType != "application/json" {
+			t.Errorf("%s: Content-Type %s", testname, contentType)
+		}
+		if len(request.ResourceLogs) != 1 {
+			t.Errorf("%s: %d resources", testname, len(request.ResourceLogs))
+			continue
+		}
+		resource := request.ResourceLogs[0]
+		expAttrs := []otlpAttribute{
+			{Key: "service.name", Value: otlpValue{"eve"}},
+			{Key: "host.id", Value: otlpValue{devMetaData.uuid}},
+			{Key: "service.version", Value: otlpValue{devMetaData.imageVer}},
+		}
+		if !reflect.DeepEqual(resource.Resource.Attributes, expAttrs) {
+			t.Errorf("%s: resource %+v", testname, resource.Resource.Attributes)
+		}
+		if len(resource.ScopeLogs) != 1 || resource.ScopeLogs[0].Scope.Name != agentName {
+			t.Errorf("%s: scopes %+v", testname, resource.ScopeLogs)
+			continue
+		}
+		records := resource.ScopeLogs[0].LogRecords
+		expRecords := []otlpLogRecord{otlpRecord(batch[0].info), otlpRecord(batch[1].info)}
+		if !reflect.DeepEqual(records, expRecords) {
+			t.Errorf("%s: records %+v instead of %+v", testname, records, expRecords)
+		}
+	}
+}
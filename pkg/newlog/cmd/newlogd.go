@@ -95,6 +95,9 @@ var (
 	// device source input bytes written to log file
 	devSourceBytes *base.LockedStringMap
 
+	// device source messages dropped over the rate limit
+	devRateLimitDrop *base.LockedStringMap
+
 	//domainUUID
 	domainUUID *base.LockedStringMap // App log, from domain-id to appDomain
 	// syslog/kmsg priority string definition
@@ -189,6 +192,9 @@ func main() {
 	// handle linux Syslog /dev/log messages
 	go getSyslogMsg(loggerChan)
 
+	// handle the queries of the log files
+	go serveQueries()
+
 	stillRunning := time.NewTicker(stillRunningInerval)
 	ps.StillRunning(agentName, warningTime, errorTime)
 
@@ -292,6 +298,7 @@ func main() {
 		select {
 		case <-metricsPublishTimer.C:
 			getDevTop10Inputs()
+			getDevTop10RateLimitDrop()
 			err = metricsPub.Publish("global", logmetrics)
 			if err != nil {
 				log.Error(err)
@@ -493,6 +500,9 @@ func handleGlobalConfigImp(ctxArg interface{}, key string, statusArg interface{}
 		if limitGzipFilesMbyts > uint32(persistMbytes/10) {
 			limitGzipFilesMbyts = uint32(persistMbytes / 10)
 		}
+
+		limiter.setConfig(getRateLimitConfig(gcp))
+		updateExportTargets(gcp.GlobalValueString(types.LogExportTargets))
 	}
 	log.Tracef("handleGlobalConfigModify done for %s, debug set %v, fastupload enabled %v", key, debug, enableFastUpload)
 }
@@ -731,6 +741,7 @@ func writelogFile(logChan <-chan inputEntry, moveChan chan fileChanInfo) {
 	var devStats statsLogFile
 
 	devSourceBytes = base.NewLockedStringMap()
+	devRateLimitDrop = base.NewLockedStringMap()
 	appStatsMap = make(map[string]statsLogFile)
 	checklogTimer := time.NewTimer(5 * time.Second)
 	devStats.file = devlogFile
@@ -748,42 +759,71 @@ func writelogFile(logChan <-chan inputEntry, moveChan chan fileChanInfo) {
 		case <-checklogTimer.C:
 			timeIdx++
 			checkLogTimeExpire(fileinfo, &devStats, moveChan)
+			now := time.Now()
+			for _, s := range limiter.summaries(now) {
+				entry := summaryEntry(s, now)
+				exportEntry(entry, s.appUUID)
+				writeInputEntry(entry, s.appUUID, fileinfo, &devStats, moveChan)
+			}
 			checklogTimer = time.NewTimer(5 * time.Second) // check the file time limit every 5 seconds
 
 		case entry := <-logChan:
+			origEntry := entry // before the content of container logs is reformatted
 			appuuid := checkAppEntry(&entry)
-			var appM statsLogFile
-			if appuuid != "" {
-				appM = getAppStatsMap(appuuid)
+			allowed, sampled := limiter.allow(entry.source, appuuid, time.Now())
+			if !allowed {
+				if appuuid != "" {
+					logmetrics.AppMetrics.NumRateLimitDrop++
+				} else {
+					updateDevRateLimitDrop(entry.source)
+				}
+				continue
 			}
-			timeS := getPtypeTimestamp(entry.timestamp)
-			mapLog := logs.LogEntry{
-				Severity:  entry.severity,
-				Source:    entry.source,
-				Content:   entry.content,
-				Iid:       entry.pid,
-				Filename:  entry.filename,
-				Msgid:     updateLogMsgID(appuuid),
-				Function:  entry.function,
-				Timestamp: timeS,
+			if sampled {
+				if appuuid != "" {
+					logmetrics.AppMetrics.NumRateLimitKept++
+				} else {
+					logmetrics.DevMetrics.NumRateLimitKept++
+				}
 			}
-			mapJentry, _ := json.Marshal(&mapLog)
-			logline := string(mapJentry) + "\n"
-			if appuuid != "" {
-				len := writelogEntry(&appM, logline)
+			exportEntry(origEntry, appuuid)
+			writeInputEntry(entry, appuuid, fileinfo, &devStats, moveChan)
+		}
+	}
+}
+
+// writeInputEntry to the device log file or to the log file of the app
+func writeInputEntry(entry inputEntry, appuuid string, fileinfo fileChanInfo, devStats *statsLogFile, moveChan chan fileChanInfo) {
+	var appM statsLogFile
+	if appuuid != "" {
+		appM = getAppStatsMap(appuuid)
+	}
+	timeS := getPtypeTimestamp(entry.timestamp)
+	mapLog := logs.LogEntry{
+		Severity:  entry.severity,
+		Source:    entry.source,
+		Content:   entry.content,
+		Iid:       entry.pid,
+		Filename:  entry.filename,
+		Msgid:     updateLogMsgID(appuuid),
+		Function:  entry.function,
+		Timestamp: timeS,
+	}
+	mapJentry, _ := json.Marshal(&mapLog)
+	logline := string(mapJentry) + "\n"
+	if appuuid != "" {
+		len := writelogEntry(&appM, logline)
 
-				logmetrics.AppMetrics.NumBytesWrite += uint64(len)
-				appStatsMap[appuuid] = appM
+		logmetrics.AppMetrics.NumBytesWrite += uint64(len)
+		appStatsMap[appuuid] = appM
 
-				trigMoveToGzip(fileinfo, &appM, appuuid, moveChan, false)
+		trigMoveToGzip(fileinfo, &appM, appuuid, moveChan, false)
 
-			} else {
-				len := writelogEntry(&devStats, logline)
-				updateDevInputlogStats(entry.source, uint64(len))
+	} else {
+		len := writelogEntry(devStats, logline)
+		updateDevInputlogStats(entry.source, uint64(len))
 
-				trigMoveToGzip(fileinfo, &devStats, "", moveChan, false)
-			}
-		}
+		trigMoveToGzip(fileinfo, devStats, "", moveChan, false)
 	}
 }
 
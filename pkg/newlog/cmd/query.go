@@ -0,0 +1,38 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/lf-edge/eve/pkg/pillar/logquery"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// serveQueries - go routine to answer the log queries, e.g. from the
+// logquery command, on the logquery.SocketPath unix socket
+func serveQueries() {
+	if err := os.MkdirAll(filepath.Dir(logquery.SocketPath), 0755); err != nil {
+		log.Error(err)
+		return
+	}
+	listener, err := logquery.Listen(logquery.SocketPath)
+	if err != nil {
+		log.Errorf("serveQueries: %v", err)
+		return
+	}
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.Errorf("serveQueries: accept: %v", err)
+			return
+		}
+		go func() {
+			if err := logquery.Serve(conn, types.NewlogDir); err != nil {
+				log.Warnf("serveQueries: %v", err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// how often a source over the limit gets a summary of the suppressed messages
+	rateSummaryInterval = 30 * time.Second
+	// buckets of sources which did not log for this long are removed
+	rateBucketIdleTime = 10 * time.Minute
+)
+
+// rateLimitConfig is set from the global config
+type rateLimitConfig struct {
+	devRate    uint32            // messages per second for each device source
+	appRate    uint32            // messages per second for each app
+	burstSec   uint32            // bucket size in seconds worth of messages
+	sampleRate uint32            // keep one of every N messages over the limit
+	sourceRate map[string]uint32 // per-agent overrides of devRate
+}
+
+// tokenBucket of a device log source or an app
+type tokenBucket struct {
+	tokens     float64
+	last       time.Time // last refill
+	overCount  uint64    // messages over the limit, for sampling
+	suppressed uint64    // messages dropped since the last summary
+	since      time.Time // first message dropped since the last summary
+}
+
+// rateSummary reports the messages dropped for a source or an app
+type rateSummary struct {
+	source     string
+	appUUID    string
+	suppressed uint64
+	since      time.Time
+}
+
+// rateLimiter is configured from the main goroutine and used by writelogFile
+type rateLimiter struct {
+	sync.Mutex
+	config     rateLimitConfig
+	devBuckets map[string]*tokenBucket // key is the source
+	appBuckets map[string]*tokenBucket // key is the app UUID
+}
+
+var limiter = rateLimiter{
+	config:     rateLimitConfig{burstSec: 1},
+	devBuckets: make(map[string]*tokenBucket),
+	appBuckets: make(map[string]*tokenBucket),
+}
+
+// getRateLimitConfig from the global settings and the per-agent settings
+func getRateLimitConfig(gcp *types.ConfigItemValueMap) rateLimitConfig {
+	config := rateLimitConfig{
+		devRate:    gcp.GlobalValueInt(types.LogRateLimitDevSource),
+		appRate:    gcp.GlobalValueInt(types.LogRateLimitApp),
+		burstSec:   gcp.GlobalValueInt(types.LogRateLimitBurst),
+		sampleRate: gcp.GlobalValueInt(types.LogRateLimitSample),
+		sourceRate: make(map[string]uint32),
+	}
+	for agent := range gcp.AgentSettings {
+		val := gcp.AgentSettingStringValue(agent, types.LogRateLimit)
+		if val == "" {
+			continue
+		}
+		rate, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			log.Errorf("getRateLimitConfig: agent %s: %v", agent, err)
+			continue
+		}
+		config.sourceRate[agent] = uint32(rate)
+	}
+	return config
+}
+
+func (r *rateLimiter) setConfig(config rateLimitConfig) {
+	r.Lock()
+	defer r.Unlock()
+	r.config = config
+}
+
+// allow returns whether the entry from the source, of the app if appUUID
+// is set, is written, and whether it is over the limit but kept as a sample
+func (r *rateLimiter) allow(source, appUUID string, now time.Time) (bool, bool) {
+	r.Lock()
+	defer r.Unlock()
+	rate := r.config.devRate
+	buckets := r.devBuckets
+	key := source
+	if appUUID != "" {
+		rate = r.config.appRate
+		buckets = r.appBuckets
+		key = appUUID
+	} else if agentRate, ok := r.config.sourceRate[source]; ok {
+		rate = agentRate
+	}
+	if rate == 0 {
+		return true, false
+	}
+	size := float64(rate) * float64(r.config.burstSec)
+	b, ok := buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: size, last: now}
+		buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens += elapsed * float64(rate)
+		b.last = now
+	}
+	if b.tokens > size {
+		b.tokens = size
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, false
+	}
+	b.overCount++
+	if r.config.sampleRate != 0 && (b.overCount-1)%uint64(r.config.sampleRate) == 0 {
+		return true, true
+	}
+	if b.suppressed == 0 {
+		b.since = now
+	}
+	b.suppressed++
+	return false, false
+}
+
+// summaries returns the sources and apps with messages dropped at least
+// rateSummaryInterval ago and resets their counts. Idle buckets are removed.
+func (r *rateLimiter) summaries(now time.Time) []rateSummary {
+	r.Lock()
+	defer r.Unlock()
+	var summaries []rateSummary
+	check := func(buckets map[string]*tokenBucket, isApp bool) {
+		for key, b := range buckets {
+			if b.suppressed != 0 && now.Sub(b.since) >= rateSummaryInterval {
+				s := rateSummary{suppressed: b.suppressed, since: b.since}
+				if isApp {
+					s.appUUID = key
+				} else {
+					s.source = key
+				}
+				summaries = append(summaries, s)
+				b.suppressed = 0
+			}
+			if b.suppressed == 0 && now.Sub(b.last) >= rateBucketIdleTime {
+				delete(buckets, key)
+			}
+		}
+	}
+	check(r.devBuckets, false)
+	check(r.appBuckets, true)
+	return summaries
+}
+
+// summaryEntry is the log entry written for a rateSummary, to the app
+// log file if appUUID is set
+func summaryEntry(s rateSummary, now time.Time) inputEntry {
+	what := "source " + s.source
+	if s.appUUID != "" {
+		what = "app " + s.appUUID
+	}
+	return inputEntry{
+		severity: "warning",
+		source:   agentName,
+		content: fmt.Sprintf("suppressed %d messages from %s over the rate limit in the last %d seconds",
+			s.suppressed, what, int(now.Sub(s.since).Seconds())),
+		timestamp: now.Format(time.RFC3339Nano),
+	}
+}
+
+// count the dropped messages of the device source for metrics
+func updateDevRateLimitDrop(source string) {
+	var n uint64
+	val, ok := devRateLimitDrop.Load(source)
+	if ok {
+		n = val.(uint64)
+	}
+	devRateLimitDrop.Store(source, n+1)
+	logmetrics.DevMetrics.NumRateLimitDrop++
+}
+
+// generate top 10 sources in dropped messages
+func getDevTop10RateLimitDrop() {
+	if logmetrics.DevMetrics.NumRateLimitDrop == 0 {
+		return
+	}
+	top10 := make(map[string]uint64)
+	pl := rankByInputCount(devRateLimitDrop)
+	for i, p := range pl {
+		if i >= 10 {
+			break
+		}
+		top10[p.Key] = p.Value
+	}
+	logmetrics.DevTop10RateLimitDrop = top10
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+// rateCall is a call to allow and its expected outcome
+type rateCall struct {
+	source     string
+	appUUID    string
+	after      time.Duration // since the start of the test case
+	expAllow   bool
+	expSampled bool
+}
+
+func newTestLimiter(config rateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		config:     config,
+		devBuckets: make(map[string]*tokenBucket),
+		appBuckets: make(map[string]*tokenBucket),
+	}
+}
+
+func TestRateLimiterAllow(t *testing.T) {
+	testMatrix := map[string]struct {
+		config rateLimitConfig
+		calls  []rateCall
+	}{
+		"no limit": {
+			config: rateLimitConfig{burstSec: 1},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{appUUID: "app1", expAllow: true},
+			},
+		},
+		"burst then drop": {
+			config: rateLimitConfig{devRate: 2, burstSec: 1},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+				{source: "zedagent", expAllow: false},
+			},
+		},
+		"burst of several seconds": {
+			config: rateLimitConfig{devRate: 1, burstSec: 3},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+			},
+		},
+		"refill": {
+			config: rateLimitConfig{devRate: 1, burstSec: 1},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+				{source: "zedagent", after: 500 * time.Millisecond, expAllow: false},
+				{source: "zedagent", after: 1000 * time.Millisecond, expAllow: true},
+				// the bucket does not fill beyond the burst
+				{source: "zedagent", after: 10 * time.Second, expAllow: true},
+				{source: "zedagent", after: 10 * time.Second, expAllow: false},
+			},
+		},
+		"sources are limited separately": {
+			config: rateLimitConfig{devRate: 1, burstSec: 1},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+				{source: "nim", expAllow: true},
+				{source: "nim", expAllow: false},
+			},
+		},
+		"per-agent rate": {
+			config: rateLimitConfig{devRate: 1, burstSec: 1,
+				sourceRate: map[string]uint32{"nim": 2, "zedrouter": 0}},
+			calls: []rateCall{
+				{source: "nim", expAllow: true},
+				{source: "nim", expAllow: true},
+				{source: "nim", expAllow: false},
+				{source: "zedrouter", expAllow: true},
+				{source: "zedrouter", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+			},
+		},
+		"apps use the app rate": {
+			config: rateLimitConfig{devRate: 1, appRate: 2, burstSec: 1,
+				sourceRate: map[string]uint32{"app1": 5}},
+			calls: []rateCall{
+				{source: "app1", appUUID: "app1", expAllow: true},
+				{source: "app1", appUUID: "app1", expAllow: true},
+				{source: "app1", appUUID: "app1", expAllow: false},
+				{appUUID: "app2", expAllow: true},
+				{source: "app1", expAllow: true},
+				{source: "app1", expAllow: true},
+			},
+		},
+		"no app limit": {
+			config: rateLimitConfig{devRate: 1, burstSec: 1},
+			calls: []rateCall{
+				{appUUID: "app1", expAllow: true},
+				{appUUID: "app1", expAllow: true},
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: false},
+			},
+		},
+		"sampling": {
+			config: rateLimitConfig{devRate: 1, burstSec: 1, sampleRate: 3},
+			calls: []rateCall{
+				{source: "zedagent", expAllow: true},
+				{source: "zedagent", expAllow: true, expSampled: true},
+				{source: "zedagent", expAllow: false},
+				{source: "zedagent", expAllow: false},
+				{source: "zedagent", expAllow: true, expSampled: true},
+				{source: "zedagent", expAllow: false},
+			},
+		},
+	}
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		r := newTestLimiter(test.config)
+		for i, call := range test.calls {
+			allow, sampled := r.allow(call.source, call.appUUID,
+				start.Add(call.after))
+			if allow != call.expAllow || sampled != call.expSampled {
+				t.Errorf("%s: call %d: allow %v sampled %v instead of %v %v",
+					testname, i, allow, sampled, call.expAllow, call.expSampled)
+			}
+		}
+	}
+}
+
+func TestRateLimiterSummaries(t *testing.T) {
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	r := newTestLimiter(rateLimitConfig{devRate: 1, appRate: 1, burstSec: 1})
+	for i := 0; i < 4; i++ {
+		r.allow("zedagent", "", start)
+		r.allow("", "app1", start.Add(time.Second/2))
+	}
+	r.allow("nim", "", start)
+
+	if s := r.summaries(start.Add(rateSummaryInterval - time.Second)); len(s) != 0 {
+		t.Errorf("summaries before the interval: %+v", s)
+	}
+	summaries := r.summaries(start.Add(rateSummaryInterval))
+	if len(summaries) != 1 {
+		t.Fatalf("expected the zedagent summary: %+v", summaries)
+	}
+	s := summaries[0]
+	if s.source != "zedagent" || s.appUUID != "" || s.suppressed != 3 ||
+		!s.since.Equal(start) {
+		t.Errorf("wrong source summary %+v", s)
+	}
+	summaries = r.summaries(start.Add(rateSummaryInterval + time.Second/2))
+	if len(summaries) != 1 {
+		t.Fatalf("expected the app1 summary: %+v", summaries)
+	}
+	s = summaries[0]
+	if s.source != "" || s.appUUID != "app1" || s.suppressed != 3 {
+		t.Errorf("wrong app summary %+v", s)
+	}
+	// the counts are reset
+	if s := r.summaries(start.Add(2 * rateSummaryInterval)); len(s) != 0 {
+		t.Errorf("summaries after reset: %+v", s)
+	}
+
+	// drops after a summary get their own summary
+	now := start.Add(2 * rateSummaryInterval)
+	r.allow("zedagent", "", now)
+	r.allow("zedagent", "", now)
+	summaries = r.summaries(now.Add(rateSummaryInterval))
+	if len(summaries) != 1 || summaries[0].suppressed != 1 ||
+		!summaries[0].since.Equal(now) {
+		t.Errorf("wrong summary after reset: %+v", summaries)
+	}
+
+	// idle buckets are removed
+	r.summaries(now.Add(rateBucketIdleTime))
+	if len(r.devBuckets) != 0 || len(r.appBuckets) != 0 {
+		t.Errorf("idle buckets not removed: %d dev %d app",
+			len(r.devBuckets), len(r.appBuckets))
+	}
+}
+
+func TestSummaryEntry(t *testing.T) {
+	since := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	now := since.Add(45 * time.Second)
+	testMatrix := map[string]struct {
+		summary    rateSummary
+		expContent string
+	}{
+		"source": {
+			summary: rateSummary{source: "zedagent", suppressed: 12, since: since},
+			expContent: "suppressed 12 messages from source zedagent " +
+				"over the rate limit in the last 45 seconds",
+		},
+		"app": {
+			summary: rateSummary{appUUID: "app1", suppressed: 1, since: since},
+			expContent: "suppressed 1 messages from app app1 " +
+				"over the rate limit in the last 45 seconds",
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		entry := summaryEntry(test.summary, now)
+		if entry.content != test.expContent {
+			t.Errorf("%s: content %q instead of %q", testname, entry.content,
+				test.expContent)
+		}
+		if entry.severity != "warning" || entry.source != agentName {
+			t.Errorf("%s: severity %s source %s", testname, entry.severity,
+				entry.source)
+		}
+		if entry.timestamp != now.Format(time.RFC3339Nano) {
+			t.Errorf("%s: timestamp %s", testname, entry.timestamp)
+		}
+	}
+}
@@ -14,3 +14,7 @@ require (
 replace github.com/lf-edge/eve/api/go => ../../api/go
 
 replace github.com/lf-edge/eve/pkg/pillar => ../pillar
+
+replace github.com/lf-edge/eve/libs/depgraph => ../../libs/depgraph
+
+replace github.com/lf-edge/eve/libs/reconciler => ../../libs/reconciler
@@ -0,0 +1,252 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localverifier
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/lf-edge/eve/api/go/attest"
+)
+
+// Policy holds the golden measurements a quote is checked against.
+// Example:
+//
+//	{
+//	  "pcrs": {"0": ["3d45..."], "7": ["a1b2...", "c3d4..."]},
+//	  "event_log": {
+//	    "required": true,
+//	    "replay": [0, 1, 2, 3, 4, 5, 6, 7],
+//	    "expected": ["..."],
+//	    "forbidden": ["..."]
+//	  },
+//	  "device_certs": {"d5d41d3c-...": "-----BEGIN CERTIFICATE-----\n..."}
+//	}
+//
+// The attestation certificate of a device must be signed by its device
+// certificate from device_certs, or by device_cert if it is not listed.
+// Devices without either can not attest.
+type Policy struct {
+	// PCRs maps a PCR index to the allowed hex encoded sha256 values.
+	// PCRs which are not listed are not checked.
+	PCRs map[uint32][]string `json:"pcrs"`
+	// EventLog is the policy for the TPM event log
+	EventLog EventLogPolicy `json:"event_log"`
+	// DeviceCert is the PEM encoded device certificate which must have
+	// signed the attestation certificate of the devices not listed in
+	// DeviceCerts
+	DeviceCert string `json:"device_cert,omitempty"`
+	// DeviceCerts maps a device ID to its PEM encoded device certificate,
+	// for verifiers serving several devices
+	DeviceCerts map[string]string `json:"device_certs,omitempty"`
+
+	pcrs        map[uint32][][]byte
+	expected    [][]byte
+	forbidden   [][]byte
+	deviceCert  *x509.Certificate
+	deviceCerts map[string]*x509.Certificate
+}
+
+// EventLogPolicy is the policy for the TPM event log sent with the quote
+type EventLogPolicy struct {
+	// Required fails quotes which do not include an event log
+	Required bool `json:"required"`
+	// Replay lists the PCRs for which replaying the sha256 digests of the
+	// event log must result in the quoted PCR value
+	Replay []uint32 `json:"replay,omitempty"`
+	// Expected are hex encoded sha256 event digests which must be present
+	// e.g., the measurements of the approved shim and kernel
+	Expected []string `json:"expected,omitempty"`
+	// Forbidden are hex encoded sha256 event digests which must not be
+	// present e.g., revoked bootloaders
+	Forbidden []string `json:"forbidden,omitempty"`
+}
+
+// LoadPolicy reads and validates a policy file
+func LoadPolicy(filename string) (*Policy, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePolicy(b)
+}
+
+// ParsePolicy parses and validates a JSON policy
+func ParsePolicy(b []byte) (*Policy, error) {
+	var p Policy
+	if err := json.Unmarshal(b, &p); err != nil {
+		return nil, fmt.Errorf("policy: %v", err)
+	}
+	p.pcrs = make(map[uint32][][]byte)
+	for index, values := range p.PCRs {
+		if index > maxPCRIndex {
+			return nil, fmt.Errorf("policy: PCR %d out of range", index)
+		}
+		if len(values) == 0 {
+			return nil, fmt.Errorf("policy: no values for PCR %d", index)
+		}
+		for _, value := range values {
+			digest, err := decodeDigest(value)
+			if err != nil {
+				return nil, fmt.Errorf("policy: PCR %d: %v", index, err)
+			}
+			p.pcrs[index] = append(p.pcrs[index], digest)
+		}
+	}
+	for _, index := range p.EventLog.Replay {
+		if index > maxPCRIndex {
+			return nil, fmt.Errorf("policy: replay PCR %d out of range", index)
+		}
+	}
+	for _, value := range p.EventLog.Expected {
+		digest, err := decodeDigest(value)
+		if err != nil {
+			return nil, fmt.Errorf("policy: expected: %v", err)
+		}
+		p.expected = append(p.expected, digest)
+	}
+	for _, value := range p.EventLog.Forbidden {
+		digest, err := decodeDigest(value)
+		if err != nil {
+			return nil, fmt.Errorf("policy: forbidden: %v", err)
+		}
+		p.forbidden = append(p.forbidden, digest)
+	}
+	if p.DeviceCert != "" {
+		cert, err := parseDeviceCert(p.DeviceCert)
+		if err != nil {
+			return nil, fmt.Errorf("policy: device_cert: %v", err)
+		}
+		p.deviceCert = cert
+	}
+	p.deviceCerts = make(map[string]*x509.Certificate)
+	for deviceID, certPEM := range p.DeviceCerts {
+		cert, err := parseDeviceCert(certPEM)
+		if err != nil {
+			return nil, fmt.Errorf("policy: device_certs %s: %v", deviceID, err)
+		}
+		p.deviceCerts[deviceID] = cert
+	}
+	return &p, nil
+}
+
+func parseDeviceCert(certPEM string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// deviceCertFor returns the device certificate configured for the device,
+// if any
+func (p *Policy) deviceCertFor(deviceID string) *x509.Certificate {
+	if cert, ok := p.deviceCerts[deviceID]; ok {
+		return cert
+	}
+	return p.deviceCert
+}
+
+func decodeDigest(value string) ([]byte, error) {
+	digest, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", value, err)
+	}
+	if len(digest) != sha256.Size {
+		return nil, fmt.Errorf("%s: not a sha256 digest", value)
+	}
+	return digest, nil
+}
+
+// checkPCRs checks the sha256 PCR values against the golden values
+func (p *Policy) checkPCRs(pcrs map[uint32][]byte) error {
+	var indexes []int
+	for index := range p.pcrs {
+		indexes = append(indexes, int(index))
+	}
+	sort.Ints(indexes)
+	for _, i := range indexes {
+		index := uint32(i)
+		value, ok := pcrs[index]
+		if !ok {
+			return fmt.Errorf("PCR %d is missing", index)
+		}
+		if !containsDigest(p.pcrs[index], value) {
+			return fmt.Errorf("PCR %d value %x does not match the policy",
+				index, value)
+		}
+	}
+	return nil
+}
+
+// checkEventLog checks the event log against the policy and the quoted
+// PCR values
+func (p *Policy) checkEventLog(events []*attest.TpmEventLogEntry, pcrs map[uint32][]byte) error {
+	if len(events) == 0 {
+		if p.EventLog.Required {
+			return fmt.Errorf("no event log")
+		}
+		return nil
+	}
+	// Replay in the order of the log
+	sorted := append([]*attest.TpmEventLogEntry{}, events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Index < sorted[j].Index
+	})
+	replayed := make(map[uint32][]byte)
+	var digests [][]byte
+	for _, event := range sorted {
+		digest := event.GetDigest()
+		if digest.GetHashAlgo() != attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256 ||
+			len(digest.GetDigest()) != sha256.Size {
+			return fmt.Errorf("event %d has no sha256 digest", event.Index)
+		}
+		if containsDigest(p.forbidden, digest.Digest) {
+			return fmt.Errorf("event %d has forbidden digest %x",
+				event.Index, digest.Digest)
+		}
+		digests = append(digests, digest.Digest)
+		value, ok := replayed[event.PcrIndex]
+		if !ok {
+			value = make([]byte, sha256.Size)
+		}
+		h := sha256.New()
+		h.Write(value)
+		h.Write(digest.Digest)
+		replayed[event.PcrIndex] = h.Sum(nil)
+	}
+	for _, expected := range p.expected {
+		if !containsDigest(digests, expected) {
+			return fmt.Errorf("expected digest %x is not in the event log",
+				expected)
+		}
+	}
+	for _, index := range p.EventLog.Replay {
+		value, ok := replayed[index]
+		if !ok {
+			return fmt.Errorf("no events for PCR %d", index)
+		}
+		if !bytes.Equal(value, pcrs[index]) {
+			return fmt.Errorf("event log replay of PCR %d gives %x but the quote has %x",
+				index, value, pcrs[index])
+		}
+	}
+	return nil
+}
+
+func containsDigest(digests [][]byte, digest []byte) bool {
+	for _, d := range digests {
+		if bytes.Equal(d, digest) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localverifier
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// URLPath is the path prefix of the attestation requests; the device
+	// ID is appended
+	URLPath        = "/attest/"
+	contentType    = "application/x-proto-binary"
+	maxRequestSize = 1024 * 1024
+)
+
+// ServeHTTP handles POST URLPath<deviceID> with a ZAttestReq as the body.
+// It must be served over TLS so that the devices can authenticate the
+// verifier. The devices are not authenticated by TLS: their quotes are
+// signed by the attestation key whose certificate must chain to the device
+// certificate in the policy, the nonces are bound to the device ID, and
+// the escrowed keys are encrypted by the device.
+func (v *Verifier) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	deviceID := strings.TrimPrefix(r.URL.Path, URLPath)
+	if deviceID == r.URL.Path {
+		http.NotFound(w, r)
+		return
+	}
+	b, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	resp, err := v.HandleRequestBytes(deviceID, b)
+	if err != nil {
+		v.log.Errorf("localverifier: request from %s (%s): %v",
+			deviceID, r.RemoteAddr, err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write(resp)
+}
+
+// Client sends the requests of a device to a remote Verifier
+type Client struct {
+	URL        string // e.g., https://10.1.0.1:8088
+	DeviceID   string
+	HTTPClient *http.Client
+}
+
+// NewClient returns a Client with a default timeout. The URL must be https;
+// the certificate of the verifier is checked against rootCAs, or the
+// system roots if nil.
+func NewClient(url string, deviceID string, rootCAs *x509.CertPool) (*Client, error) {
+	if !strings.HasPrefix(url, "https://") {
+		return nil, fmt.Errorf("verifier URL %s is not https", url)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		RootCAs:    rootCAs,
+		MinVersion: tls.VersionTLS12,
+	}
+	return &Client{
+		URL:      strings.TrimSuffix(url, "/"),
+		DeviceID: deviceID,
+		HTTPClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: transport,
+		},
+	}, nil
+}
+
+// Send posts the marshaled ZAttestReq and returns the marshaled response
+func (c *Client) Send(b []byte) ([]byte, error) {
+	url := c.URL + URLPath + c.DeviceID
+	resp, err := c.HTTPClient.Post(url, contentType, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s: %s", url, resp.Status,
+			strings.TrimSpace(string(contents)))
+	}
+	return contents, nil
+}
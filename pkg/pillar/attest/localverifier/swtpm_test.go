@@ -0,0 +1,194 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localverifier
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/lf-edge/eve/api/go/attest"
+	"github.com/stretchr/testify/assert"
+)
+
+// Same as the quote key created by tpmmgr
+var quoteKeyTemplate = tpm2.Public{
+	Type:    tpm2.AlgECC,
+	NameAlg: tpm2.AlgSHA256,
+	Attributes: tpm2.FlagFixedTPM | tpm2.FlagFixedParent |
+		tpm2.FlagSensitiveDataOrigin | tpm2.FlagUserWithAuth |
+		tpm2.FlagRestricted | tpm2.FlagSign | tpm2.FlagNoDA,
+	ECCParameters: &tpm2.ECCParams{
+		Sign: &tpm2.SigScheme{
+			Alg:  tpm2.AlgECDSA,
+			Hash: tpm2.AlgSHA256,
+		},
+		CurveID: tpm2.CurveNISTP256,
+	},
+}
+
+// startSwtpm starts a swtpm listening on a unix socket and returns the
+// path of the socket, or skips the test if swtpm is not installed
+func startSwtpm(t *testing.T) string {
+	swtpm, err := exec.LookPath("swtpm")
+	if err != nil {
+		t.Skip("swtpm is not installed")
+	}
+	dir := t.TempDir()
+	sock := filepath.Join(dir, "tpm.sock")
+	cmd := exec.Command(swtpm, "socket", "--tpm2",
+		"--tpmstate", "dir="+dir,
+		"--server", "type=unixio,path="+sock,
+		"--ctrl", "type=unixio,path="+filepath.Join(dir, "ctrl.sock"),
+		"--flags", "not-need-init,startup-clear")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		t.Fatalf("swtpm: %v", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	for i := 0; i < 50; i++ {
+		if _, err := os.Stat(sock); err == nil {
+			return sock
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	t.Fatalf("swtpm did not create %s", sock)
+	return ""
+}
+
+// tpmQuote quotes PCRs 0-15 like tpmmgr does
+func tpmQuote(t *testing.T, rw io.ReadWriter, handle tpmutil.Handle, nonce []byte) *attest.ZAttestQuote {
+	quote := &attest.ZAttestQuote{}
+	sel := tpm2.PCRSelection{Hash: tpm2.AlgSHA256}
+	for i := 0; i <= 15; i++ {
+		value, err := tpm2.ReadPCR(rw, i, tpm2.AlgSHA256)
+		if err != nil {
+			t.Fatalf("ReadPCR %d: %v", i, err)
+		}
+		quote.PcrValues = append(quote.PcrValues, &attest.TpmPCRValue{
+			Index:    uint32(i),
+			HashAlgo: attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256,
+			Value:    value,
+		})
+		sel.PCRs = append(sel.PCRs, i)
+	}
+	attestData, sig, err := tpm2.Quote(rw, handle, "", "", nonce, sel,
+		tpm2.AlgNull)
+	if err != nil {
+		t.Fatalf("Quote: %v", err)
+	}
+	signature, err := asn1.Marshal(struct {
+		R, S *big.Int
+	}{sig.ECC.R, sig.ECC.S})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote.AttestData = attestData
+	quote.Signature = signature
+	return quote
+}
+
+// TestSwtpm runs the attestation end to end with quotes from a software
+// TPM: a boot measuring into PCR 8 which matches the policy unlocks the
+// escrowed key, and an unexpected measurement does not.
+func TestSwtpm(t *testing.T) {
+	sock := startSwtpm(t)
+	rw, err := tpm2.OpenTPM(sock)
+	if err != nil {
+		t.Fatalf("OpenTPM: %v", err)
+	}
+	defer rw.Close()
+
+	handle, pub, err := tpm2.CreatePrimary(rw, tpm2.HandleOwner,
+		tpm2.PCRSelection{}, "", "", quoteKeyTemplate)
+	if err != nil {
+		t.Fatalf("CreatePrimary: %v", err)
+	}
+	defer tpm2.FlushContext(rw, handle)
+	// The attestation certificate is signed by the device certificate
+	deviceKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	devicePEM, deviceCert := makeCert(t, deviceKey.Public(), nil, deviceKey)
+	attestPEM, _ := makeCert(t, pub, deviceCert, deviceKey)
+
+	var events []*attest.TpmEventLogEntry
+	for i, name := range []string{"grub", "kernel", "initrd"} {
+		digest := digestOf(name)
+		if err := tpm2.PCRExtend(rw, tpmutil.Handle(8), tpm2.AlgSHA256,
+			digest, ""); err != nil {
+			t.Fatalf("PCRExtend: %v", err)
+		}
+		events = append(events, &attest.TpmEventLogEntry{
+			Index:    uint32(i),
+			PcrIndex: 8,
+			Digest: &attest.TpmEventDigest{
+				HashAlgo: attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256,
+				Digest:   digest,
+			},
+		})
+	}
+	pcr0, err := tpm2.ReadPCR(rw, 0, tpm2.AlgSHA256)
+	if err != nil {
+		t.Fatalf("ReadPCR: %v", err)
+	}
+	policy, err := ParsePolicy([]byte(fmt.Sprintf(`{
+		"pcrs": {"0": ["%x"]},
+		"event_log": {"required": true, "replay": [8], "expected": ["%x"]},
+		"device_cert": %q
+	}`, pcr0, digestOf("kernel"), devicePEM)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := New(testLog, policy, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceID := "swtpm"
+	if _, err := v.HandleRequest(deviceID, certReq(attestPEM)); err != nil {
+		t.Fatal(err)
+	}
+
+	quote := tpmQuote(t, rw, handle, getNonce(t, v, deviceID))
+	quote.EventLog = events
+	quoteResp := sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS,
+		quoteResp.Response)
+	assert.Equal(t, attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_SUCCESS,
+		storeKeys(t, v, deviceID, quoteResp.IntegrityToken, []byte("vault key")))
+
+	quote = tpmQuote(t, rw, handle, getNonce(t, v, deviceID))
+	quote.EventLog = events
+	quoteResp = sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS,
+		quoteResp.Response)
+	if assert.Len(t, quoteResp.Keys, 1) {
+		assert.Equal(t, []byte("vault key"), quoteResp.Keys[0].Key)
+	}
+
+	// A measurement which is not in the event log
+	if err := tpm2.PCRExtend(rw, tpmutil.Handle(8), tpm2.AlgSHA256,
+		digestOf("rootkit"), ""); err != nil {
+		t.Fatalf("PCRExtend: %v", err)
+	}
+	quote = tpmQuote(t, rw, handle, getNonce(t, v, deviceID))
+	quote.EventLog = events
+	quoteResp = sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_QUOTE_FAILED,
+		quoteResp.Response)
+	assert.Empty(t, quoteResp.Keys)
+}
@@ -0,0 +1,415 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package localverifier implements the Controller side of the attestation
+// protocol against golden PCR values and event log policies from a file.
+// It lets air-gapped sites gate the release of the escrowed vault keys on
+// measured boot on an adjacent edge server (see cmd/attestverifier).
+// It does not run on the device itself since the policy and the escrowed
+// keys would then be stored where an attacker who changed the boot can
+// rewrite them.
+package localverifier
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/lf-edge/eve/api/go/attest"
+	zcert "github.com/lf-edge/eve/api/go/certs"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const (
+	maxPCRIndex = 23
+	nonceSize   = 32
+	tokenSize   = 32
+	// NonceLifetime is how long a nonce can be used for a quote
+	NonceLifetime = 5 * time.Minute
+)
+
+var (
+	errNonceMismatch = errors.New("nonce mismatch")
+	deviceIDRegexp   = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*$`)
+)
+
+// Verifier answers attestation requests for one or more devices
+type Verifier struct {
+	sync.Mutex
+	log      *base.LogObject
+	policy   *Policy
+	stateDir string
+	devices  map[string]*deviceState
+	// nonceKey authenticates the nonces we handed out, so that a nonce
+	// request from anybody can not replace the nonce of a device
+	nonceKey []byte
+	// usedNonces are the nonces which were used in a quote, until they
+	// expire
+	usedNonces map[string]time.Time
+}
+
+// deviceState is what we know about a device. The exported fields are
+// persisted in stateDir.
+type deviceState struct {
+	AttestCert     []byte        `json:"attest_cert,omitempty"`
+	IntegrityToken []byte        `json:"integrity_token,omitempty"`
+	Keys           []escrowedKey `json:"keys,omitempty"`
+}
+
+type escrowedKey struct {
+	KeyType attest.AttestVolumeKeyType `json:"key_type"`
+	Key     []byte                     `json:"key"`
+}
+
+// New returns a Verifier which keeps the certificates, integrity tokens
+// and escrowed keys of the devices in stateDir
+func New(log *base.LogObject, policy *Policy, stateDir string) (*Verifier, error) {
+	if err := os.MkdirAll(stateDir, 0700); err != nil {
+		return nil, err
+	}
+	nonceKey := make([]byte, sha256.Size)
+	if _, err := rand.Read(nonceKey); err != nil {
+		return nil, err
+	}
+	return &Verifier{
+		log:        log,
+		policy:     policy,
+		stateDir:   stateDir,
+		devices:    make(map[string]*deviceState),
+		nonceKey:   nonceKey,
+		usedNonces: make(map[string]time.Time),
+	}, nil
+}
+
+// HandleRequestBytes unmarshals a ZAttestReq, handles it, and returns the
+// marshaled ZAttestResponse
+func (v *Verifier) HandleRequestBytes(deviceID string, b []byte) ([]byte, error) {
+	req := &attest.ZAttestReq{}
+	if err := proto.Unmarshal(b, req); err != nil {
+		return nil, fmt.Errorf("unmarshal request: %v", err)
+	}
+	resp, err := v.HandleRequest(deviceID, req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
+// HandleRequest handles one request from the device. An error is returned
+// for malformed requests; verification failures are reported in the
+// response.
+func (v *Verifier) HandleRequest(deviceID string, req *attest.ZAttestReq) (*attest.ZAttestResponse, error) {
+	if !deviceIDRegexp.MatchString(deviceID) {
+		return nil, fmt.Errorf("invalid device ID %q", deviceID)
+	}
+	v.Lock()
+	defer v.Unlock()
+	state, err := v.getDeviceState(deviceID)
+	if err != nil {
+		return nil, err
+	}
+	switch req.GetReqType() {
+	case attest.ZAttestReqType_ATTEST_REQ_CERT:
+		return v.handleCerts(deviceID, state, req.GetCerts())
+	case attest.ZAttestReqType_ATTEST_REQ_NONCE:
+		return v.handleNonce(deviceID, time.Now())
+	case attest.ZAttestReqType_ATTEST_REQ_QUOTE:
+		if req.GetQuote() == nil {
+			return nil, fmt.Errorf("quote request without quote")
+		}
+		return v.handleQuote(deviceID, state, req.GetQuote())
+	case attest.ZAttestReqType_Z_ATTEST_REQ_TYPE_STORE_KEYS:
+		if req.GetStorageKeys() == nil {
+			return nil, fmt.Errorf("store keys request without keys")
+		}
+		return v.handleStoreKeys(deviceID, state, req.GetStorageKeys())
+	default:
+		return nil, fmt.Errorf("unsupported request type %v", req.GetReqType())
+	}
+}
+
+// handleCerts records the attestation certificate of the device. Since the
+// requests are not authenticated the certificate is only accepted if it is
+// signed by the device certificate in the policy; trusting the first one
+// would let whoever connects first lock the device out.
+func (v *Verifier) handleCerts(deviceID string, state *deviceState, certs []*zcert.ZCert) (*attest.ZAttestResponse, error) {
+	for _, c := range certs {
+		if c.GetType() != zcert.ZCertType_CERT_TYPE_DEVICE_RESTRICTED_SIGNING {
+			continue
+		}
+		if _, err := v.parseAttestCert(deviceID, c.GetCert()); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(state.AttestCert, c.GetCert()) {
+			v.log.Noticef("localverifier: new attestation certificate for %s",
+				deviceID)
+			state.AttestCert = c.GetCert()
+			if err := v.saveDeviceState(deviceID, state); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &attest.ZAttestResponse{
+		RespType: attest.ZAttestRespType_ATTEST_RESP_CERT,
+	}, nil
+}
+
+// handleNonce returns a nonce for the device. The nonce is not stored:
+// it carries its creation time and a MAC over the device ID and that time,
+// so that handleQuote can check it while nonce requests, which anybody can
+// send, do not change any state.
+func (v *Verifier) handleNonce(deviceID string, now time.Time) (*attest.ZAttestResponse, error) {
+	nonce := v.makeNonce(deviceID, now)
+	v.log.Functionf("localverifier: nonce for %s", deviceID)
+	return &attest.ZAttestResponse{
+		RespType: attest.ZAttestRespType_ATTEST_RESP_NONCE,
+		Nonce:    &attest.ZAttestNonceResp{Nonce: nonce},
+	}, nil
+}
+
+func (v *Verifier) makeNonce(deviceID string, now time.Time) []byte {
+	nonce := make([]byte, 8, nonceSize)
+	binary.BigEndian.PutUint64(nonce, uint64(now.UnixNano()))
+	mac := hmac.New(sha256.New, v.nonceKey)
+	mac.Write(nonce)
+	mac.Write([]byte(deviceID))
+	return append(nonce, mac.Sum(nil)[:nonceSize-8]...)
+}
+
+// checkNonce checks that we made the nonce for the device, that it has not
+// expired and that it was not used before
+func (v *Verifier) checkNonce(deviceID string, nonce []byte, now time.Time) bool {
+	if len(nonce) != nonceSize ||
+		!hmac.Equal(v.makeNonce(deviceID, timeOfNonce(nonce)), nonce) {
+		return false
+	}
+	age := now.Sub(timeOfNonce(nonce))
+	if age < 0 || age > NonceLifetime {
+		return false
+	}
+	_, used := v.usedNonces[string(nonce)]
+	return !used
+}
+
+func timeOfNonce(nonce []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(nonce)))
+}
+
+// useNonce makes sure the nonce can only be used once, and forgets about
+// the nonces which expired
+func (v *Verifier) useNonce(nonce []byte, now time.Time) {
+	for n, created := range v.usedNonces {
+		if now.Sub(created) > NonceLifetime {
+			delete(v.usedNonces, n)
+		}
+	}
+	v.usedNonces[string(nonce)] = timeOfNonce(nonce)
+}
+
+func (v *Verifier) handleQuote(deviceID string, state *deviceState, quote *attest.ZAttestQuote) (*attest.ZAttestResponse, error) {
+	quoteResp := func(code attest.ZAttestResponseCode) *attest.ZAttestResponse {
+		return &attest.ZAttestResponse{
+			RespType:  attest.ZAttestRespType_ATTEST_RESP_QUOTE_RESP,
+			QuoteResp: &attest.ZAttestQuoteResp{Response: code},
+		}
+	}
+	if state.AttestCert == nil {
+		v.log.Warnf("localverifier: no attestation certificate for %s", deviceID)
+		return quoteResp(attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NO_CERT_FOUND), nil
+	}
+	cert, err := v.parseAttestCert(deviceID, state.AttestCert)
+	if err != nil {
+		v.log.Errorf("localverifier: %s: %v", deviceID, err)
+		return quoteResp(attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NO_CERT_FOUND), nil
+	}
+	now := time.Now()
+	pcrs, nonce, err := verifyQuote(cert.PublicKey, quote,
+		func(nonce []byte) bool {
+			return v.checkNonce(deviceID, nonce, now)
+		})
+	if err == errNonceMismatch {
+		v.log.Warnf("localverifier: nonce mismatch for %s", deviceID)
+		return quoteResp(attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH), nil
+	}
+	if err == nil {
+		// A nonce can only be used once
+		v.useNonce(nonce, now)
+		err = v.policy.checkPCRs(pcrs)
+	}
+	if err == nil {
+		err = v.policy.checkEventLog(quote.GetEventLog(), pcrs)
+	}
+	if err != nil {
+		v.log.Errorf("localverifier: quote from %s failed: %v", deviceID, err)
+		return quoteResp(attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_QUOTE_FAILED), nil
+	}
+
+	token := make([]byte, tokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+	state.IntegrityToken = token
+	if err := v.saveDeviceState(deviceID, state); err != nil {
+		return nil, err
+	}
+	v.log.Noticef("localverifier: quote from %s verified, returning %d keys",
+		deviceID, len(state.Keys))
+	resp := quoteResp(attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS)
+	resp.QuoteResp.IntegrityToken = token
+	for _, key := range state.Keys {
+		resp.QuoteResp.Keys = append(resp.QuoteResp.Keys,
+			&attest.AttestVolumeKey{KeyType: key.KeyType, Key: key.Key})
+	}
+	return resp, nil
+}
+
+func (v *Verifier) handleStoreKeys(deviceID string, state *deviceState, storageKeys *attest.AttestStorageKeys) (*attest.ZAttestResponse, error) {
+	resp := &attest.ZAttestResponse{
+		RespType:        attest.ZAttestRespType_Z_ATTEST_RESP_TYPE_STORE_KEYS,
+		StorageKeysResp: &attest.AttestStorageKeysResp{},
+	}
+	if state.IntegrityToken == nil ||
+		!bytes.Equal(state.IntegrityToken, storageKeys.GetIntegrityToken()) {
+		v.log.Warnf("localverifier: integrity token mismatch for %s", deviceID)
+		resp.StorageKeysResp.Response = attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_ITOKEN_MISMATCH
+		return resp, nil
+	}
+	var keys []escrowedKey
+	for _, key := range storageKeys.GetKeys() {
+		keys = append(keys, escrowedKey{KeyType: key.GetKeyType(), Key: key.GetKey()})
+	}
+	state.Keys = keys
+	if err := v.saveDeviceState(deviceID, state); err != nil {
+		return nil, err
+	}
+	v.log.Noticef("localverifier: stored %d keys for %s", len(keys), deviceID)
+	resp.StorageKeysResp.Response = attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_SUCCESS
+	return resp, nil
+}
+
+// parseAttestCert parses the PEM attestation certificate and checks it
+// against the device certificate of the device in the policy
+func (v *Verifier) parseAttestCert(deviceID string, certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data in attestation certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("attestation certificate: %v", err)
+	}
+	if _, ok := cert.PublicKey.(*ecdsa.PublicKey); !ok {
+		return nil, fmt.Errorf("attestation certificate has %T key, not ECDSA",
+			cert.PublicKey)
+	}
+	deviceCert := v.policy.deviceCertFor(deviceID)
+	if deviceCert == nil {
+		return nil, fmt.Errorf("no device certificate for %s in the policy",
+			deviceID)
+	}
+	if err := cert.CheckSignatureFrom(deviceCert); err != nil {
+		return nil, fmt.Errorf("attestation certificate not signed by the device certificate: %v",
+			err)
+	}
+	return cert, nil
+}
+
+// verifyQuote checks the signature and the nonce of the quote and returns
+// the sha256 PCR values it covers and the nonce
+func verifyQuote(pub interface{}, quote *attest.ZAttestQuote, checkNonce func([]byte) bool) (map[uint32][]byte, []byte, error) {
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("unsupported key type %T", pub)
+	}
+	digest := sha256.Sum256(quote.GetAttestData())
+	if !ecdsa.VerifyASN1(ecdsaPub, digest[:], quote.GetSignature()) {
+		return nil, nil, fmt.Errorf("quote signature verification failed")
+	}
+	ad, err := tpm2.DecodeAttestationData(quote.GetAttestData())
+	if err != nil {
+		return nil, nil, err
+	}
+	if ad.Type != tpm2.TagAttestQuote || ad.AttestedQuoteInfo == nil {
+		return nil, nil, fmt.Errorf("attestation data is not a quote")
+	}
+	if !checkNonce(ad.ExtraData) {
+		return nil, nil, errNonceMismatch
+	}
+	sel := ad.AttestedQuoteInfo.PCRSelection
+	if sel.Hash != tpm2.AlgSHA256 {
+		return nil, nil, fmt.Errorf("quote is over PCR bank %v, not sha256", sel.Hash)
+	}
+
+	pcrs := make(map[uint32][]byte)
+	for _, pcr := range quote.GetPcrValues() {
+		if pcr.GetHashAlgo() == attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256 {
+			pcrs[pcr.GetIndex()] = pcr.GetValue()
+		}
+	}
+	indexes := append([]int{}, sel.PCRs...)
+	sort.Ints(indexes)
+	h := sha256.New()
+	for _, index := range indexes {
+		value, ok := pcrs[uint32(index)]
+		if !ok {
+			return nil, nil, fmt.Errorf("no value for quoted PCR %d", index)
+		}
+		h.Write(value)
+	}
+	if !bytes.Equal(h.Sum(nil), ad.AttestedQuoteInfo.PCRDigest) {
+		return nil, nil, fmt.Errorf("PCR values do not match the quoted digest")
+	}
+	// Only return what the signature covers
+	quoted := make(map[uint32][]byte)
+	for _, index := range indexes {
+		quoted[uint32(index)] = pcrs[uint32(index)]
+	}
+	return quoted, ad.ExtraData, nil
+}
+
+func (v *Verifier) stateFile(deviceID string) string {
+	return filepath.Join(v.stateDir, deviceID+".json")
+}
+
+func (v *Verifier) getDeviceState(deviceID string) (*deviceState, error) {
+	if state, ok := v.devices[deviceID]; ok {
+		return state, nil
+	}
+	state := &deviceState{}
+	b, err := ioutil.ReadFile(v.stateFile(deviceID))
+	if err == nil {
+		if err := json.Unmarshal(b, state); err != nil {
+			return nil, fmt.Errorf("state of %s: %v", deviceID, err)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	v.devices[deviceID] = state
+	return state, nil
+}
+
+func (v *Verifier) saveDeviceState(deviceID string, state *deviceState) error {
+	b, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return fileutils.WriteRename(v.stateFile(deviceID), b)
+}
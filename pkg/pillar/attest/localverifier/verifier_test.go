@@ -0,0 +1,447 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package localverifier
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpmutil"
+	"github.com/lf-edge/eve/api/go/attest"
+	zcert "github.com/lf-edge/eve/api/go/certs"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLog = base.NewSourceLogObject(logrus.StandardLogger(), "test", 1234)
+
+// makeCert returns a PEM certificate for pub signed by parent, or self
+// signed if parent is nil
+func makeCert(t *testing.T, pub crypto.PublicKey, parent *x509.Certificate, parentKey crypto.Signer) ([]byte, *x509.Certificate) {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), cert
+}
+
+// softwareQuote creates a quote like TPM2_Quote over the given sha256 PCRs
+func softwareQuote(t *testing.T, key *ecdsa.PrivateKey, nonce []byte, pcrs map[int][]byte) *attest.ZAttestQuote {
+	var indexes []int
+	for index := range pcrs {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	bitmap := make([]byte, 3)
+	h := sha256.New()
+	quote := &attest.ZAttestQuote{}
+	for _, index := range indexes {
+		bitmap[index/8] |= 1 << (index % 8)
+		h.Write(pcrs[index])
+		quote.PcrValues = append(quote.PcrValues, &attest.TpmPCRValue{
+			Index:    uint32(index),
+			HashAlgo: attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256,
+			Value:    pcrs[index],
+		})
+	}
+	attestData, err := tpmutil.Pack(uint32(0xff544347), tpm2.TagAttestQuote,
+		tpmutil.U16Bytes(nil), tpmutil.U16Bytes(nonce), tpm2.ClockInfo{},
+		uint64(0), uint32(1), tpm2.AlgSHA256, uint8(len(bitmap)),
+		tpmutil.RawBytes(bitmap), tpmutil.U16Bytes(h.Sum(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest := sha256.Sum256(attestData)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	quote.AttestData = attestData
+	quote.Signature = signature
+	return quote
+}
+
+func digestOf(s string) []byte {
+	sum := sha256.Sum256([]byte(s))
+	return sum[:]
+}
+
+func extend(pcr []byte, digest []byte) []byte {
+	sum := sha256.Sum256(append(append([]byte{}, pcr...), digest...))
+	return sum[:]
+}
+
+func certReq(certPEM []byte) *attest.ZAttestReq {
+	return &attest.ZAttestReq{
+		ReqType: attest.ZAttestReqType_ATTEST_REQ_CERT,
+		Certs: []*zcert.ZCert{{
+			Type: zcert.ZCertType_CERT_TYPE_DEVICE_RESTRICTED_SIGNING,
+			Cert: certPEM,
+		}},
+	}
+}
+
+func getNonce(t *testing.T, v *Verifier, deviceID string) []byte {
+	resp, err := v.HandleRequest(deviceID, &attest.ZAttestReq{
+		ReqType: attest.ZAttestReqType_ATTEST_REQ_NONCE,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, attest.ZAttestRespType_ATTEST_RESP_NONCE, resp.RespType)
+	assert.Len(t, resp.GetNonce().GetNonce(), nonceSize)
+	return resp.GetNonce().GetNonce()
+}
+
+func sendQuote(t *testing.T, v *Verifier, deviceID string, quote *attest.ZAttestQuote) *attest.ZAttestQuoteResp {
+	resp, err := v.HandleRequest(deviceID, &attest.ZAttestReq{
+		ReqType: attest.ZAttestReqType_ATTEST_REQ_QUOTE,
+		Quote:   quote,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, attest.ZAttestRespType_ATTEST_RESP_QUOTE_RESP, resp.RespType)
+	return resp.GetQuoteResp()
+}
+
+func storeKeys(t *testing.T, v *Verifier, deviceID string, token []byte, key []byte) attest.AttestStorageKeysResponseCode {
+	resp, err := v.HandleRequest(deviceID, &attest.ZAttestReq{
+		ReqType: attest.ZAttestReqType_Z_ATTEST_REQ_TYPE_STORE_KEYS,
+		StorageKeys: &attest.AttestStorageKeys{
+			IntegrityToken: token,
+			Keys: []*attest.AttestVolumeKey{{
+				KeyType: attest.AttestVolumeKeyType_ATTEST_VOLUME_KEY_TYPE_VSK,
+				Key:     key,
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.GetStorageKeysResp().GetResponse()
+}
+
+func TestVerifier(t *testing.T) {
+	deviceKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	devicePEM, deviceCert := makeCert(t, deviceKey.Public(), nil, deviceKey)
+	attestKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	attestPEM, _ := makeCert(t, attestKey.Public(), deviceCert, deviceKey)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherPEM, _ := makeCert(t, otherKey.Public(), nil, otherKey)
+
+	// PCR 7 is measured from the event log, PCR 0 is only checked
+	// against its golden value
+	events := []*attest.TpmEventLogEntry{}
+	pcrs := map[int][]byte{0: digestOf("firmware"), 7: make([]byte, sha256.Size)}
+	for i, name := range []string{"secureboot", "db", "shim"} {
+		pcrs[7] = extend(pcrs[7], digestOf(name))
+		events = append(events, &attest.TpmEventLogEntry{
+			Index:    uint32(i),
+			PcrIndex: 7,
+			Digest: &attest.TpmEventDigest{
+				HashAlgo: attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256,
+				Digest:   digestOf(name),
+			},
+		})
+	}
+	policy, err := ParsePolicy([]byte(fmt.Sprintf(`{
+		"pcrs": {"0": ["%x"]},
+		"event_log": {
+			"required": true,
+			"replay": [7],
+			"expected": ["%x"],
+			"forbidden": ["%x"]
+		},
+		"device_cert": %q
+	}`, pcrs[0], digestOf("shim"), digestOf("revoked"), devicePEM)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateDir := t.TempDir()
+	v, err := New(testLog, policy, stateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceID := "d5d41d3c-8c3d-4d4b-9f34-4a2d1fd0e3a1"
+
+	// No certificate yet
+	nonce := getNonce(t, v, deviceID)
+	quoteResp := sendQuote(t, v, deviceID, softwareQuote(t, attestKey, nonce, pcrs))
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NO_CERT_FOUND,
+		quoteResp.Response)
+
+	// Certificates not signed by the device certificate are rejected
+	_, err = v.HandleRequest(deviceID, certReq(otherPEM))
+	assert.Error(t, err)
+	resp, err := v.HandleRequest(deviceID, certReq(attestPEM))
+	assert.NoError(t, err)
+	assert.Equal(t, attest.ZAttestRespType_ATTEST_RESP_CERT, resp.RespType)
+
+	// Nothing can be escrowed before a successful quote
+	assert.Equal(t, attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_ITOKEN_MISMATCH,
+		storeKeys(t, v, deviceID, nil, []byte("vault key")))
+
+	nonce = getNonce(t, v, deviceID)
+	quote := softwareQuote(t, attestKey, nonce, pcrs)
+	quote.EventLog = events
+	quoteResp = sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS,
+		quoteResp.Response)
+	assert.Len(t, quoteResp.IntegrityToken, tokenSize)
+	assert.Empty(t, quoteResp.Keys)
+	token := quoteResp.IntegrityToken
+
+	// The nonce can not be reused
+	quoteResp = sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH,
+		quoteResp.Response)
+
+	assert.Equal(t, attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_ITOKEN_MISMATCH,
+		storeKeys(t, v, deviceID, []byte("wrong"), []byte("vault key")))
+	assert.Equal(t, attest.AttestStorageKeysResponseCode_ATTEST_STORAGE_KEYS_RESPONSE_CODE_SUCCESS,
+		storeKeys(t, v, deviceID, token, []byte("vault key")))
+
+	// A quote over a different nonce
+	getNonce(t, v, deviceID)
+	quote = softwareQuote(t, attestKey, []byte("old nonce"), pcrs)
+	quote.EventLog = events
+	quoteResp = sendQuote(t, v, deviceID, quote)
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_NONCE_MISMATCH,
+		quoteResp.Response)
+
+	// Quotes which do not match the policy fail
+	failures := map[string]func() *attest.ZAttestQuote{
+		"PCR 0": func() *attest.ZAttestQuote {
+			bad := map[int][]byte{0: digestOf("other firmware"), 7: pcrs[7]}
+			q := softwareQuote(t, attestKey, getNonce(t, v, deviceID), bad)
+			q.EventLog = events
+			return q
+		},
+		"no event log": func() *attest.ZAttestQuote {
+			return softwareQuote(t, attestKey, getNonce(t, v, deviceID), pcrs)
+		},
+		"replay": func() *attest.ZAttestQuote {
+			q := softwareQuote(t, attestKey, getNonce(t, v, deviceID), pcrs)
+			q.EventLog = events[:2]
+			return q
+		},
+		"forbidden": func() *attest.ZAttestQuote {
+			bad := map[int][]byte{0: pcrs[0], 7: extend(pcrs[7], digestOf("revoked"))}
+			q := softwareQuote(t, attestKey, getNonce(t, v, deviceID), bad)
+			q.EventLog = append(append([]*attest.TpmEventLogEntry{}, events...),
+				&attest.TpmEventLogEntry{
+					Index:    3,
+					PcrIndex: 7,
+					Digest: &attest.TpmEventDigest{
+						HashAlgo: attest.TpmHashAlgo_TPM_HASH_ALGO_SHA256,
+						Digest:   digestOf("revoked"),
+					},
+				})
+			return q
+		},
+		"PCR values": func() *attest.ZAttestQuote {
+			q := softwareQuote(t, attestKey, getNonce(t, v, deviceID), pcrs)
+			q.EventLog = events
+			q.PcrValues[0].Value = digestOf("lie")
+			return q
+		},
+		"signature": func() *attest.ZAttestQuote {
+			q := softwareQuote(t, otherKey, getNonce(t, v, deviceID), pcrs)
+			q.EventLog = events
+			return q
+		},
+	}
+	for name, f := range failures {
+		quoteResp = sendQuote(t, v, deviceID, f())
+		assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_QUOTE_FAILED,
+			quoteResp.Response, name)
+		assert.Empty(t, quoteResp.Keys, name)
+	}
+
+	// The escrowed keys are returned after a restart over HTTP
+	v, err = New(testLog, policy, stateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewTLSServer(v)
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+	client, err := NewClient(server.URL, deviceID, rootCAs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	send := func(req *attest.ZAttestReq) *attest.ZAttestResponse {
+		b, err := proto.Marshal(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err = client.Send(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp := &attest.ZAttestResponse{}
+		if err := proto.Unmarshal(b, resp); err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+	resp = send(&attest.ZAttestReq{ReqType: attest.ZAttestReqType_ATTEST_REQ_NONCE})
+	quote = softwareQuote(t, attestKey, resp.GetNonce().GetNonce(), pcrs)
+	quote.EventLog = events
+	resp = send(&attest.ZAttestReq{
+		ReqType: attest.ZAttestReqType_ATTEST_REQ_QUOTE,
+		Quote:   quote,
+	})
+	assert.Equal(t, attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS,
+		resp.GetQuoteResp().GetResponse())
+	if assert.Len(t, resp.GetQuoteResp().GetKeys(), 1) {
+		assert.Equal(t, []byte("vault key"), resp.GetQuoteResp().GetKeys()[0].Key)
+	}
+	assert.NotEqual(t, hex.EncodeToString(token),
+		hex.EncodeToString(resp.GetQuoteResp().GetIntegrityToken()))
+
+	client.DeviceID = "../etc"
+	_, err = client.Send([]byte{})
+	assert.Error(t, err)
+
+	// The verifier must be authenticated
+	_, err = NewClient("http://"+server.Listener.Addr().String(), deviceID, nil)
+	assert.Error(t, err)
+	client, err = NewClient(server.URL, deviceID, nil)
+	if assert.NoError(t, err) {
+		_, err = client.Send([]byte{})
+		assert.Error(t, err, "unknown authority")
+	}
+}
+
+func TestAttestCert(t *testing.T) {
+	deviceKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	devicePEM, deviceCert := makeCert(t, deviceKey.Public(), nil, deviceKey)
+	attestKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	attestPEM, _ := makeCert(t, attestKey.Public(), deviceCert, deviceKey)
+	newAttestPEM, _ := makeCert(t, attestKey.Public(), deviceCert, deviceKey)
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	otherPEM, _ := makeCert(t, otherKey.Public(), nil, otherKey)
+	deviceID := "d5d41d3c-8c3d-4d4b-9f34-4a2d1fd0e3a1"
+	otherID := "0f0e0d0c-8c3d-4d4b-9f34-4a2d1fd0e3a1"
+
+	// Without device certificates nothing is accepted, not even the
+	// first certificate
+	policy, err := ParsePolicy([]byte(`{"pcrs": {}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateDir := t.TempDir()
+	v, err := New(testLog, policy, stateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = v.HandleRequest(deviceID, certReq(attestPEM))
+	assert.Error(t, err)
+	_, err = v.HandleRequest(otherID, certReq(otherPEM))
+	assert.Error(t, err)
+
+	// The device certificates only apply to their devices, and a new
+	// attestation certificate signed by the device certificate replaces
+	// the old one
+	policy, err = ParsePolicy([]byte(fmt.Sprintf(`{
+		"pcrs": {},
+		"device_certs": {%q: %q}
+	}`, deviceID, devicePEM)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = New(testLog, policy, stateDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = v.HandleRequest(deviceID, certReq(attestPEM))
+	assert.NoError(t, err)
+	_, err = v.HandleRequest(deviceID, certReq(newAttestPEM))
+	assert.NoError(t, err)
+	_, err = v.HandleRequest(deviceID, certReq(otherPEM))
+	assert.Error(t, err)
+	_, err = v.HandleRequest(otherID, certReq(attestPEM))
+	assert.Error(t, err)
+	_, err = ParsePolicy([]byte(`{"device_certs": {"x": "garbage"}}`))
+	assert.Error(t, err)
+}
+
+func TestNonce(t *testing.T) {
+	policy, err := ParsePolicy([]byte(`{"pcrs": {}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := New(testLog, policy, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	deviceID := "d5d41d3c-8c3d-4d4b-9f34-4a2d1fd0e3a1"
+	otherID := "0f0e0d0c-8c3d-4d4b-9f34-4a2d1fd0e3a1"
+	now := time.Now()
+	nonce := v.makeNonce(deviceID, now)
+	assert.Len(t, nonce, nonceSize)
+
+	// Nonces requested by others do not replace the nonce of the device
+	v.makeNonce(deviceID, now)
+	v.makeNonce(otherID, now)
+	assert.True(t, v.checkNonce(deviceID, nonce, now.Add(time.Second)))
+	assert.False(t, v.checkNonce(otherID, nonce, now.Add(time.Second)))
+
+	forged := append([]byte{}, nonce...)
+	forged[nonceSize-1]++
+	assert.False(t, v.checkNonce(deviceID, forged, now))
+	assert.False(t, v.checkNonce(deviceID, nonce[:nonceSize-1], now))
+	assert.False(t, v.checkNonce(deviceID, nonce, now.Add(NonceLifetime+time.Second)))
+	assert.False(t, v.checkNonce(deviceID, nonce, now.Add(-time.Second)))
+
+	// Nonces of another verifier or from before a restart
+	other, err := New(testLog, policy, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.False(t, other.checkNonce(deviceID, nonce, now))
+
+	v.useNonce(nonce, now)
+	assert.False(t, v.checkNonce(deviceID, nonce, now))
+	// Used nonces are forgotten once they expired
+	v.useNonce(v.makeNonce(deviceID, now.Add(NonceLifetime+time.Second)),
+		now.Add(NonceLifetime+time.Second))
+	assert.Len(t, v.usedNonces, 1)
+}
@@ -114,6 +114,8 @@ const (
 	CipherContextLogType LogObjectType = "cipher_context"
 	// CipherMetricsLogType:
 	CipherMetricsLogType LogObjectType = "cipher_metric"
+	// CASFsckMetricsLogType:
+	CASFsckMetricsLogType LogObjectType = "cas_fsck_metric"
 	// ControllerCertLogType:
 	ControllerCertLogType LogObjectType = "controller_cert"
 	// EdgeNodeCertLogType:
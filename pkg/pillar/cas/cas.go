@@ -90,6 +90,9 @@ type CAS interface {
 	MountSnapshot(snapshotID, targetPath string) error
 	//ListSnapshots: returns a list of snapshotIDs where each entry is of format <algo>:<hash> (currently supporting only sha256:<hash>).
 	ListSnapshots() ([]string, error)
+	//ListActiveSnapshots: returns a list of snapshotIDs of the writable snapshots i.e. excluding the
+	//committed image layers which are kept as long as the images referring to them exist.
+	ListActiveSnapshots() ([]string, error)
 	//ListSnapshots: removes a snapshot matching the given 'snapshotID'.
 	//Arg 'snapshotID' should be of format <algo>:<hash> (currently supporting only sha256:<hash>).
 	//To keep this method idempotent, no error  is returned if the given 'snapshotID' is not found.
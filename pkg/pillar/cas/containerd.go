@@ -16,6 +16,7 @@ import (
 	"github.com/containerd/containerd/content"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/snapshots"
 	"github.com/lf-edge/edge-containers/pkg/resolver"
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -554,6 +555,23 @@ func (c *containerdCAS) ListSnapshots() ([]string, error) {
 	return snapshotIDList, nil
 }
 
+//ListActiveSnapshots: returns a list of snapshotIDs of the writable snapshots.
+func (c *containerdCAS) ListActiveSnapshots() ([]string, error) {
+	ctrdCtx, done := c.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	snapshotInfoList, err := c.ctrdClient.CtrListSnapshotInfo(ctrdCtx)
+	if err != nil {
+		return nil, fmt.Errorf("ListActiveSnapshots: unable to get snapshot info list: %s", err.Error())
+	}
+	snapshotIDList := make([]string, 0)
+	for _, snapshotInfo := range snapshotInfoList {
+		if snapshotInfo.Kind == snapshots.KindActive {
+			snapshotIDList = append(snapshotIDList, snapshotInfo.Name)
+		}
+	}
+	return snapshotIDList, nil
+}
+
 //ListSnapshots: removes a snapshot matching the given 'snapshotID'.
 //Arg 'snapshotID' should be of format sha256:<hash>.
 //To keep this method idempotent, no error  is returned if the given 'snapshotID' is not found.
@@ -589,8 +607,9 @@ func (c *containerdCAS) PrepareContainerRootDir(rootPath, reference, rootBlobSha
 		return err
 	}
 
-	//Step 3: write OCI image config/spec json under the container's rootPath.
-	clientImageSpec, err := getImageConfig(c, reference)
+	//Step 3: write the image config json as is under the container's rootPath.
+	//It has the Docker specific fields such as Healthcheck in addition to the OCI ones.
+	clientImageSpec, clientImageSpecJSON, err := getImageConfig(c, reference)
 	if err != nil {
 		err = fmt.Errorf("PrepareContainerRootDir: exception while fetching image config for reference %s: %s",
 			reference, err.Error())
@@ -604,20 +623,12 @@ func (c *containerdCAS) PrepareContainerRootDir(rootPath, reference, rootBlobSha
 	unProcessedEnv := clientImageSpec.Config.Env
 	logrus.Infof("PrepareContainerRootDir: mountPoints %+v execpath %+v cmd %+v workdir %+v env %+v",
 		mountpoints, execpath, cmd, workdir, unProcessedEnv)
-	clientImageSpecJSON, err := getJSON(clientImageSpec)
-	if err != nil {
-		err = fmt.Errorf("PrepareContainerRootDir: Could not build json of image: %v. %v",
-			reference, err.Error())
-		logrus.Errorf(err.Error())
-		return err
-	}
-
 	if err := os.MkdirAll(rootPath, 0766); err != nil {
 		err = fmt.Errorf("PrepareContainerRootDir: Exception while creating rootPath dir. %v", err)
 		logrus.Errorf(err.Error())
 		return err
 	}
-	if err := ioutil.WriteFile(filepath.Join(rootPath, imageConfigFilename), []byte(clientImageSpecJSON), 0666); err != nil {
+	if err := ioutil.WriteFile(filepath.Join(rootPath, imageConfigFilename), clientImageSpecJSON, 0666); err != nil {
 		err = fmt.Errorf("PrepareContainerRootDir: Exception while writing image info to %v/%v. %v",
 			rootPath, imageConfigFilename, err)
 		logrus.Errorf(err.Error())
@@ -821,8 +832,9 @@ func getBlobSize(c *containerdCAS, blobHash string) (int64, error) {
 	return info.Size, nil
 }
 
-//getImageConfig returns imageConfig for a reference
-func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error) {
+//getImageConfig returns imageConfig for a reference and the raw config which
+//also has the Docker specific fields such as Healthcheck
+func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, []byte, error) {
 	index := ocispec.Index{}
 	manifests := ocispec.Manifest{}
 	imageConfig := ocispec.Image{}
@@ -843,14 +855,14 @@ func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error)
 		err = fmt.Errorf("getImageConfig: exception while reading blob %s for reference %s: %s",
 			imageParentHash, reference, err.Error())
 		logrus.Errorf(err.Error())
-		return nil, err
+		return nil, nil, err
 	}
 	blobData, err := ioutil.ReadAll(blobReader)
 	if err != nil {
 		err = fmt.Errorf("getImageConfig: could not read blobdata %s for reference %s: %+s",
 			imageParentHash, reference, err.Error())
 		logrus.Errorf(err.Error())
-		return nil, err
+		return nil, nil, err
 	}
 
 	//Step 3: Get the manifest of the image
@@ -862,7 +874,7 @@ func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error)
 			err = fmt.Errorf("getImageConfig: could not read imageManifest %s for reference %s: %+s",
 				imageParentHash, reference, err.Error())
 			logrus.Errorf(err.Error())
-			return nil, err
+			return nil, nil, err
 		}
 	} else {
 		//Step 3.1.1: Fetch manifest hash from index
@@ -874,7 +886,7 @@ func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error)
 					err = fmt.Errorf("getImageConfig: exception while reading manifest blob %s for reference %s: %s",
 						m.Digest.String(), reference, err.Error())
 					logrus.Errorf(err.Error())
-					return nil, err
+					return nil, nil, err
 				}
 				//Step 3.1.3: Read the manifest data
 				blobData, err = ioutil.ReadAll(blobReader)
@@ -882,13 +894,13 @@ func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error)
 					err = fmt.Errorf("getImageConfig: could not parsr manifestBlob %s for reference %s: %+s",
 						m.Digest.String(), reference, err.Error())
 					logrus.Errorf(err.Error())
-					return nil, err
+					return nil, nil, err
 				}
 				if err := json.Unmarshal(blobData, &manifests); err != nil {
 					err = fmt.Errorf("getImageConfig: could not parse manifestBlob %s for reference %s: %+s",
 						m.Digest.String(), reference, err.Error())
 					logrus.Errorf(err.Error())
-					return nil, err
+					return nil, nil, err
 				}
 				break
 			}
@@ -902,29 +914,20 @@ func getImageConfig(c *containerdCAS, reference string) (*ocispec.Image, error)
 		err = fmt.Errorf("getImageConfig: exception while reading config blob %s for reference %s: %s",
 			configHash, reference, err.Error())
 		logrus.Errorf(err.Error())
-		return nil, err
+		return nil, nil, err
 	}
 	blobData, err = ioutil.ReadAll(blobReader)
 	if err != nil {
 		err = fmt.Errorf("getImageConfig: could not read config blobdata %s for reference %s: %+s",
 			configHash, reference, err.Error())
 		logrus.Errorf(err.Error())
-		return nil, err
+		return nil, nil, err
 	}
 	if err := json.Unmarshal(blobData, &imageConfig); err != nil {
 		err = fmt.Errorf("getImageConfig: could not parse configBlob %s for reference %s: %+s",
 			configHash, reference, err.Error())
 		logrus.Errorf(err.Error())
-		return nil, err
-	}
-	return &imageConfig, nil
-}
-
-// getJSON - returns input in JSON format
-func getJSON(x interface{}) (string, error) {
-	b, err := json.MarshalIndent(x, "", "    ")
-	if err != nil {
-		return "", fmt.Errorf("getJSON: Exception while marshalling container spec JSON. %v", err)
+		return nil, nil, err
 	}
-	return fmt.Sprint(string(b)), nil
+	return &imageConfig, blobData, nil
 }
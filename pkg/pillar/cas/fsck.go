@@ -0,0 +1,225 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cas
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// FsckOptions controls what Fsck checks and removes
+type FsckOptions struct {
+	// Roots are blob hashes which are in use in addition to the ones
+	// reachable from the images e.g. blobs which are being downloaded.
+	Roots []string
+	// Snapshots are the snapshotIDs which are in use
+	Snapshots []string
+	// Verify re-hashes the content of all the blobs
+	Verify bool
+	// Remove the corrupt blobs which are not reachable and the orphaned
+	// blobs and snapshots. Corrupt blobs which are reachable are kept
+	// for the caller to download them again. If CanRemove is set only
+	// the orphans for which it returns true are removed; this allows the
+	// caller to only remove content which has been orphaned for a while.
+	Remove    bool
+	CanRemove func(id string) bool
+}
+
+// FsckReport is the result of a Fsck
+type FsckReport struct {
+	StartTime       time.Time
+	Duration        time.Duration
+	Images          int
+	Blobs           int
+	BlobBytes       int64
+	ReachableBlobs  int
+	Snapshots       int
+	MissingBlobs    []string // Reachable but not in the blob store
+	CorruptBlobs    []string // Content does not match the digest
+	OrphanBlobs     []string // Not reachable from any root
+	OrphanBytes     int64
+	OrphanSnapshots []string
+	RemovedBlobs    []string
+	RemovedBytes    int64
+	RemovedSnaps    []string
+	Errors          []string // Errors which did not stop the check
+}
+
+// Fsck walks the graph from the images and the additional roots to find
+// orphaned blobs and snapshots, optionally re-hashes all the blobs, and
+// optionally removes the orphaned content and the corrupt content which
+// is not in use.
+// Returns an error if the CAS content could not be listed at all.
+func Fsck(c CAS, opts FsckOptions) (*FsckReport, error) {
+	report := &FsckReport{StartTime: time.Now()}
+	defer func() {
+		report.Duration = time.Since(report.StartTime)
+	}()
+
+	blobInfos, err := c.ListBlobInfo()
+	if err != nil {
+		return report, fmt.Errorf("Fsck: %v", err)
+	}
+	blobSizes := make(map[string]int64, len(blobInfos))
+	for _, info := range blobInfos {
+		blobSizes[info.Digest] = info.Size
+		report.BlobBytes += info.Size
+	}
+	report.Blobs = len(blobInfos)
+
+	images, err := c.ListImages()
+	if err != nil {
+		return report, fmt.Errorf("Fsck: %v", err)
+	}
+	report.Images = len(images)
+	roots := append([]string{}, opts.Roots...)
+	for _, image := range images {
+		hash, err := c.GetImageHash(image)
+		if err != nil {
+			report.addError("image %s: %v", image, err)
+			continue
+		}
+		roots = append(roots, hash)
+	}
+
+	// Walk from the roots. Only the blobs which exist can have children.
+	reachable := make(map[string]bool)
+	for len(roots) != 0 {
+		hash := roots[0]
+		roots = roots[1:]
+		if reachable[hash] {
+			continue
+		}
+		reachable[hash] = true
+		if _, ok := blobSizes[hash]; !ok {
+			report.MissingBlobs = append(report.MissingBlobs, hash)
+			continue
+		}
+		children, err := c.Children(hash)
+		if err != nil {
+			report.addError("children of %s: %v", hash, err)
+			continue
+		}
+		roots = append(roots, children...)
+	}
+
+	for _, info := range blobInfos {
+		if reachable[info.Digest] {
+			report.ReachableBlobs++
+		} else {
+			report.OrphanBlobs = append(report.OrphanBlobs, info.Digest)
+			report.OrphanBytes += info.Size
+		}
+	}
+
+	snapshots, err := c.ListActiveSnapshots()
+	if err != nil {
+		report.addError("snapshots: %v", err)
+	}
+	report.Snapshots = len(snapshots)
+	inUse := make(map[string]bool, len(opts.Snapshots))
+	for _, snapshotID := range opts.Snapshots {
+		inUse[snapshotID] = true
+	}
+	for _, snapshotID := range snapshots {
+		if !inUse[snapshotID] {
+			report.OrphanSnapshots = append(report.OrphanSnapshots, snapshotID)
+		}
+	}
+
+	// Remove the orphans before the (slow) verification to keep the
+	// time since the roots were determined short
+	if opts.Remove {
+		for _, snapshotID := range report.OrphanSnapshots {
+			if opts.CanRemove != nil && !opts.CanRemove(snapshotID) {
+				continue
+			}
+			if err := c.RemoveSnapshot(snapshotID); err != nil {
+				report.addError("%v", err)
+				continue
+			}
+			report.RemovedSnaps = append(report.RemovedSnaps, snapshotID)
+		}
+		for _, hash := range report.OrphanBlobs {
+			if opts.CanRemove != nil && !opts.CanRemove(hash) {
+				continue
+			}
+			report.removeBlob(c, hash, blobSizes[hash])
+		}
+	}
+
+	if !opts.Verify {
+		return report, nil
+	}
+	removed := make(map[string]bool, len(report.RemovedBlobs))
+	for _, hash := range report.RemovedBlobs {
+		removed[hash] = true
+	}
+	for _, info := range blobInfos {
+		if removed[info.Digest] {
+			continue
+		}
+		if err := verifyBlob(c, info.Digest, info.Size); err != nil {
+			report.addError("%v", err)
+			report.CorruptBlobs = append(report.CorruptBlobs, info.Digest)
+			if opts.Remove && !reachable[info.Digest] {
+				report.removeBlob(c, info.Digest, info.Size)
+			}
+		}
+	}
+	return report, nil
+}
+
+func (report *FsckReport) removeBlob(c CAS, hash string, size int64) {
+	if err := c.RemoveBlob(hash); err != nil {
+		report.addError("%v", err)
+		return
+	}
+	report.RemovedBlobs = append(report.RemovedBlobs, hash)
+	report.RemovedBytes += size
+}
+
+func (report *FsckReport) addError(format string, args ...interface{}) {
+	report.Errors = append(report.Errors, fmt.Sprintf(format, args...))
+}
+
+// String returns a one line summary of the report
+func (report *FsckReport) String() string {
+	return fmt.Sprintf("%d images, %d/%d blobs (%d bytes) reachable, %d missing, %d corrupt, %d orphaned (%d bytes), %d/%d orphaned snapshots, removed %d blobs (%d bytes) and %d snapshots, %d errors in %v",
+		report.Images, report.ReachableBlobs, report.Blobs, report.BlobBytes,
+		len(report.MissingBlobs), len(report.CorruptBlobs),
+		len(report.OrphanBlobs), report.OrphanBytes,
+		len(report.OrphanSnapshots), report.Snapshots,
+		len(report.RemovedBlobs), report.RemovedBytes, len(report.RemovedSnaps),
+		len(report.Errors), report.Duration)
+}
+
+// verifyBlob re-hashes the content of the blob
+func verifyBlob(c CAS, hash string, size int64) error {
+	if !strings.HasPrefix(hash, "sha256:") {
+		return fmt.Errorf("blob %s: unsupported digest algorithm", hash)
+	}
+	ctx, done := c.CtrNewUserServicesCtx()
+	defer done()
+	reader, err := c.ReadBlob(ctx, hash)
+	if err != nil {
+		return fmt.Errorf("blob %s: %v", hash, err)
+	}
+	h := sha256.New()
+	n, err := io.Copy(h, reader)
+	if err != nil {
+		return fmt.Errorf("blob %s: read failed after %d bytes: %v", hash, n, err)
+	}
+	if n != size {
+		return fmt.Errorf("blob %s: size %d does not match %d", hash, n, size)
+	}
+	if got := "sha256:" + hex.EncodeToString(h.Sum(nil)); got != hash {
+		return fmt.Errorf("blob %s: content hash is %s", hash, got)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package cas
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeCAS implements the subset of CAS used by Fsck
+type fakeCAS struct {
+	CAS
+	blobs     map[string][]byte
+	children  map[string][]string
+	images    map[string]string
+	snapshots []string
+}
+
+func blobHash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func (f *fakeCAS) add(data string, children ...string) string {
+	hash := blobHash(data)
+	f.blobs[hash] = []byte(data)
+	f.children[hash] = children
+	return hash
+}
+
+func (f *fakeCAS) ListBlobInfo() ([]*BlobInfo, error) {
+	var infos []*BlobInfo
+	for hash, data := range f.blobs {
+		infos = append(infos, &BlobInfo{Digest: hash, Size: int64(len(data))})
+	}
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Digest < infos[j].Digest })
+	return infos, nil
+}
+
+func (f *fakeCAS) ListImages() ([]string, error) {
+	var images []string
+	for image := range f.images {
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+func (f *fakeCAS) GetImageHash(reference string) (string, error) {
+	return f.images[reference], nil
+}
+
+func (f *fakeCAS) Children(blobHash string) ([]string, error) {
+	return f.children[blobHash], nil
+}
+
+func (f *fakeCAS) ReadBlob(ctx context.Context, blobHash string) (io.Reader, error) {
+	data, ok := f.blobs[blobHash]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", blobHash)
+	}
+	return bytes.NewReader(data), nil
+}
+
+func (f *fakeCAS) RemoveBlob(blobHash string) error {
+	delete(f.blobs, blobHash)
+	return nil
+}
+
+func (f *fakeCAS) ListActiveSnapshots() ([]string, error) {
+	return f.snapshots, nil
+}
+
+func (f *fakeCAS) RemoveSnapshot(snapshotID string) error {
+	for i, id := range f.snapshots {
+		if id == snapshotID {
+			f.snapshots = append(f.snapshots[:i], f.snapshots[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeCAS) CtrNewUserServicesCtx() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+func TestFsck(t *testing.T) {
+	f := &fakeCAS{
+		blobs:    make(map[string][]byte),
+		children: make(map[string][]string),
+		images:   make(map[string]string),
+	}
+	layer := f.add("layer")
+	config := f.add("config")
+	missing := blobHash("missing")
+	manifest := f.add("manifest", config, layer, missing)
+	f.images["image"] = f.add("index", manifest)
+	downloading := f.add("downloading")
+	orphan := f.add("orphan")
+	corrupt := f.add("corrupt")
+	f.blobs[corrupt] = []byte("tprroc")
+	corruptOrphan := f.add("corrupt orphan")
+	f.blobs[corruptOrphan] = []byte("nahpro tpurroc")
+	f.snapshots = []string{"vol1", "vol2"}
+
+	opts := FsckOptions{
+		Roots:     []string{downloading, corrupt},
+		Snapshots: []string{"vol1"},
+		Verify:    true,
+	}
+	report, err := Fsck(f, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, report.Images)
+	assert.Equal(t, 8, report.Blobs)
+	assert.Equal(t, 6, report.ReachableBlobs)
+	assert.Equal(t, []string{missing}, report.MissingBlobs)
+	assert.ElementsMatch(t, []string{orphan, corruptOrphan}, report.OrphanBlobs)
+	assert.Equal(t, int64(len("orphan")+len("nahpro tpurroc")), report.OrphanBytes)
+	assert.ElementsMatch(t, []string{corrupt, corruptOrphan}, report.CorruptBlobs)
+	assert.Equal(t, []string{"vol2"}, report.OrphanSnapshots)
+	assert.Empty(t, report.RemovedBlobs)
+	assert.Len(t, f.blobs, 8)
+
+	// Only remove the orphans allowed by CanRemove, and the corrupt
+	// blobs which are not in use
+	opts.Remove = true
+	opts.CanRemove = func(id string) bool {
+		return id != "vol2" && id != corruptOrphan
+	}
+	report, err = Fsck(f, opts)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{orphan, corruptOrphan}, report.RemovedBlobs)
+	assert.ElementsMatch(t, []string{corrupt, corruptOrphan}, report.CorruptBlobs)
+	assert.Empty(t, report.RemovedSnaps)
+	assert.Len(t, f.blobs, 6)
+	assert.Contains(t, f.blobs, corrupt)
+	assert.Len(t, f.snapshots, 2)
+
+	opts.CanRemove = nil
+	report, err = Fsck(f, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol2"}, report.RemovedSnaps)
+	assert.Empty(t, report.RemovedBlobs)
+	assert.Equal(t, []string{"vol1"}, f.snapshots)
+}
@@ -25,6 +25,8 @@ func getEncryptionBlock(
 	decBlock.WifiUserName = zconfigDecBlockPtr.WifiUserName
 	decBlock.WifiPassword = zconfigDecBlockPtr.WifiPassword
 	decBlock.ProtectedUserData = zconfigDecBlockPtr.ProtectedUserData
+	decBlock.WireGuardPrivateKey = zconfigDecBlockPtr.WireguardPrivateKey
+	decBlock.WireGuardPresharedKeys = zconfigDecBlockPtr.WireguardPresharedKeys
 	return decBlock
 }
 
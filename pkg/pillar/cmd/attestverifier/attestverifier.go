@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Serve attestation requests from EVE devices on an edge server, verifying
+// the quotes against a local policy file. The devices are pointed at it
+// with types.AttestVerifierURLFile, and trust its TLS certificate through
+// types.AttestVerifierCAFile.
+// Usage:
+//	attestverifier -p policy.json -c cert -k key [-s statedir] [-l addr]
+
+package attestverifier
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/lf-edge/eve/pkg/pillar/attest/localverifier"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/sirupsen/logrus"
+)
+
+const agentName = "attestverifier"
+
+var logger *logrus.Logger
+var log *base.LogObject
+
+// Run is the main aka only entrypoint
+func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) int {
+	logger = loggerArg
+	log = logArg
+	debugPtr := flag.Bool("d", false, "Debug flag")
+	policyPtr := flag.String("p", "", "Policy file")
+	statePtr := flag.String("s", "/var/lib/"+agentName, "State directory")
+	listenPtr := flag.String("l", ":8088", "Listen address")
+	certPtr := flag.String("c", "", "TLS certificate file")
+	keyPtr := flag.String("k", "", "TLS key file")
+	flag.Parse()
+	if *debugPtr {
+		logger.SetLevel(logrus.TraceLevel)
+	} else {
+		logger.SetLevel(logrus.InfoLevel)
+	}
+	if *policyPtr == "" || *certPtr == "" || *keyPtr == "" {
+		fmt.Fprintf(os.Stderr, "Usage: %s -p policy -c cert -k key [options]\n",
+			agentName)
+		flag.PrintDefaults()
+		return 1
+	}
+	policy, err := localverifier.LoadPolicy(*policyPtr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	verifier, err := localverifier.New(log, policy, *statePtr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return 1
+	}
+	mux := http.NewServeMux()
+	mux.Handle(localverifier.URLPath, verifier)
+	server := &http.Server{Addr: *listenPtr, Handler: mux}
+	// The devices authenticate the verifier through TLS hence there is no
+	// plain HTTP fallback
+	log.Noticef("Serving attestation requests on %s with policy %s",
+		*listenPtr, *policyPtr)
+	err = server.ListenAndServeTLS(*certPtr, *keyPtr)
+	fmt.Fprintf(os.Stderr, "%v\n", err)
+	return 1
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+// Keep track of which physical CPUs the domains run on.
+// The first cpus.eve.reserved CPUs are left for EVE. Domains which specify
+// VmConfig.CPUs get those CPUs exclusively and the other domains share the
+// remaining CPUs. The set of shared CPUs changes when exclusive
+// allocations come and go; verifyStatus repins the running domains.
+// The shared domains never run on exclusively allocated CPUs, hence an
+// exclusive allocation which would leave them without any CPU is refused.
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type cpuAllocator struct {
+	sync.Mutex
+	numCPUs   int
+	eveCPUs   int
+	exclusive map[string][]int // Key is DomainStatus.Key()
+	shared    map[string]bool
+}
+
+func newCPUAllocator(numCPUs int, eveCPUs int) (*cpuAllocator, error) {
+	if numCPUs <= 0 {
+		return nil, fmt.Errorf("invalid number of CPUs %d", numCPUs)
+	}
+	return &cpuAllocator{
+		numCPUs:   numCPUs,
+		eveCPUs:   eveCPUs,
+		exclusive: make(map[string][]int),
+		shared:    make(map[string]bool),
+	}, nil
+}
+
+// setEveCPUs changes the number of reserved CPUs. Existing exclusive
+// allocations are kept even if they now overlap the reserved CPUs.
+func (a *cpuAllocator) setEveCPUs(eveCPUs int) {
+	a.Lock()
+	defer a.Unlock()
+	a.eveCPUs = eveCPUs
+}
+
+// allocate the CPUs for the domain. An empty cpuList means shared.
+func (a *cpuAllocator) allocate(key string, cpuList string) (types.CPUPlacement, error) {
+	a.Lock()
+	defer a.Unlock()
+	delete(a.exclusive, key)
+	delete(a.shared, key)
+	if cpuList == "" {
+		if len(a.sharedCPUsLocked()) == 0 {
+			return types.CPUPlacement{}, fmt.Errorf("all CPUs are used exclusively by %d domains",
+				len(a.exclusive))
+		}
+		a.shared[key] = true
+		return a.placementLocked(key), nil
+	}
+	cpus, err := types.ParseCPUList(cpuList)
+	if err != nil {
+		return types.CPUPlacement{}, err
+	}
+	if len(cpus) == 0 {
+		return types.CPUPlacement{}, fmt.Errorf("no CPUs in %q", cpuList)
+	}
+	for _, cpu := range cpus {
+		if cpu >= a.numCPUs {
+			return types.CPUPlacement{}, fmt.Errorf("CPU %d does not exist; there are %d CPUs",
+				cpu, a.numCPUs)
+		}
+		if cpu < a.eveCPUs {
+			return types.CPUPlacement{}, fmt.Errorf("CPU %d is reserved for EVE (%s %d)",
+				cpu, types.EveReservedCPUs, a.eveCPUs)
+		}
+		for otherKey, otherCPUs := range a.exclusive {
+			for _, other := range otherCPUs {
+				if other == cpu {
+					return types.CPUPlacement{}, fmt.Errorf("CPU %d is already used by %s",
+						cpu, otherKey)
+				}
+			}
+		}
+	}
+	a.exclusive[key] = cpus
+	if len(a.shared) != 0 && len(a.sharedCPUsLocked()) == 0 {
+		delete(a.exclusive, key)
+		return types.CPUPlacement{}, fmt.Errorf("CPUs %s would leave no CPU for the %d shared domains",
+			cpuList, len(a.shared))
+	}
+	return a.placementLocked(key), nil
+}
+
+// release the CPUs of the domain
+func (a *cpuAllocator) release(key string) {
+	a.Lock()
+	defer a.Unlock()
+	delete(a.exclusive, key)
+	delete(a.shared, key)
+}
+
+// placement returns the current placement of an allocated domain
+func (a *cpuAllocator) placement(key string) (types.CPUPlacement, bool) {
+	a.Lock()
+	defer a.Unlock()
+	if _, ok := a.exclusive[key]; !ok && !a.shared[key] {
+		return types.CPUPlacement{}, false
+	}
+	return a.placementLocked(key), true
+}
+
+func (a *cpuAllocator) placementLocked(key string) types.CPUPlacement {
+	if cpus, ok := a.exclusive[key]; ok {
+		return types.CPUPlacement{
+			Exclusive: true,
+			CPUs:      types.FormatCPUList(cpus),
+		}
+	}
+	return types.CPUPlacement{CPUs: types.FormatCPUList(a.sharedCPUsLocked())}
+}
+
+// sharedCPUsLocked returns the CPUs which are neither reserved for EVE nor
+// exclusively allocated. If there are none the shared domains run on the
+// EVE CPUs which are not exclusively allocated.
+func (a *cpuAllocator) sharedCPUsLocked() []int {
+	used := make(map[int]bool)
+	for _, cpus := range a.exclusive {
+		for _, cpu := range cpus {
+			used[cpu] = true
+		}
+	}
+	var shared, eve []int
+	for cpu := 0; cpu < a.numCPUs; cpu++ {
+		if used[cpu] {
+			continue
+		}
+		if cpu < a.eveCPUs {
+			eve = append(eve, cpu)
+		} else {
+			shared = append(shared, cpu)
+		}
+	}
+	if len(shared) != 0 {
+		return shared
+	}
+	return eve
+}
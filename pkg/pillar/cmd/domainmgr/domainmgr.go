@@ -85,6 +85,7 @@ type domainContext struct {
 	subGlobalConfig        pubsub.Subscription
 	pubAssignableAdapters  pubsub.Publication
 	pubDomainMetric        pubsub.Publication
+	pubAppContainerMetrics pubsub.Publication
 	pubHostMemory          pubsub.Publication
 	pubProcessMetric       pubsub.Publication
 	pubCipherBlockStatus   pubsub.Publication
@@ -106,6 +107,8 @@ type domainContext struct {
 
 	// From global config setting
 	processCloudInitMultiPart bool
+	// Physical CPUs used by the domains
+	cpuAllocator *cpuAllocator
 }
 
 func (ctx *domainContext) publishAssignableAdapters() {
@@ -206,6 +209,20 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	aa := types.AssignableAdapters{}
 	domainCtx.assignableAdapters = &aa
 
+	// Without the number of CPUs the domains could be placed on
+	// non-existing or exclusively allocated CPUs hence retry
+	hostMemory, err := hyper.GetHostCPUMem()
+	for err != nil {
+		log.Errorf("GetHostCPUMem failed, retrying: %v", err)
+		time.Sleep(5 * time.Second)
+		ps.StillRunning(agentName, warningTime, errorTime)
+		hostMemory, err = hyper.GetHostCPUMem()
+	}
+	domainCtx.cpuAllocator, err = newCPUAllocator(int(hostMemory.Ncpus), 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	// Allow only one concurrent domain create
 	domainCtx.createSema = sema.New(log, 1)
 	domainCtx.createSema.P(1)
@@ -241,6 +258,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	domainCtx.pubDomainMetric = pubDomainMetric
 
+	pubAppContainerMetrics, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.AppContainerMetrics{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	domainCtx.pubAppContainerMetrics = pubAppContainerMetrics
+
 	pubProcessMetric, err := ps.NewPublication(
 		pubsub.PublicationOptions{
 			AgentName: agentName,
@@ -796,6 +823,10 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 	min := max * 0.3
 	ticker := flextimer.NewRangeTicker(time.Duration(min),
 		time.Duration(max))
+	healthTimer := time.NewTimer(types.DefaultHealthCheckInterval)
+	defer healthTimer.Stop()
+	snapWorker := newSnapshotWorker(ctx)
+	defer snapWorker.Done()
 
 	closed := false
 	for !closed {
@@ -815,6 +846,7 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 				} else {
 					handleModify(ctx, key, &config, status)
 				}
+				doSnapshotCmds(ctx, snapWorker, key)
 			} else {
 				// Closed
 				status := lookupDomainStatus(ctx, key)
@@ -830,6 +862,16 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 				verifyStatus(ctx, status)
 				maybeRetry(ctx, status)
 			}
+		case <-healthTimer.C:
+			status := lookupDomainStatus(ctx, key)
+			if status != nil {
+				runHealthCheck(ctx, status)
+			}
+			healthTimer.Reset(healthCheckInterval(ctx, key))
+		case res := <-snapWorker.MsgChan():
+			res.Process(ctx, false)
+			// Perform any command which arrived in the meantime
+			doSnapshotCmds(ctx, snapWorker, key)
 		}
 	}
 	log.Functionf("runHandler(%s) DONE", key)
@@ -839,9 +881,11 @@ func runHandler(ctx *domainContext, key string, c <-chan Notify) {
 func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 	// Check config.Active to avoid spurious errors when shutting down
 	configActivate := false
+	restartPolicy := types.RestartPolicyUnspecified
 	config := lookupDomainConfig(ctx, status.Key())
 	if config != nil && config.Activate {
 		configActivate = true
+		restartPolicy = config.RestartPolicy
 	}
 
 	domainID, domainStatus, err := hyper.Task(status).Info(status.DomainName)
@@ -860,7 +904,11 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			// level of recovery or at least gather some intel on why and how it crashed)
 			// NOTE: we don't do anything for repairing tasks in the UNKNOWN state, for those
 			// the only remedy is an explicit user action (delete, restart, etc.)
-			if domainStatus == types.BROKEN {
+			// unless a restart policy is specified
+			if restartPolicy != types.RestartPolicyUnspecified {
+				handleDomainExit(ctx, status, restartPolicy,
+					domainStatus != types.HALTED, err)
+			} else if domainStatus == types.BROKEN {
 				err := fmt.Errorf("one of the %s tasks has crashed (%v)", status.Key(), err)
 				log.Errorf(err.Error())
 				status.SetErrorNow("one of the application's tasks has crashed - please restart application instance")
@@ -871,7 +919,7 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 				errDescription := types.ErrorDescription{
 					Error:               err.Error(),
 					ErrorSeverity:       types.ErrorSeverityWarning,
-					ErrorRetryCondition: fmt.Sprintf("will retry in %s", time.Duration(ctx.domainBootRetryTime)*time.Second),
+					ErrorRetryCondition: fmt.Sprintf("will retry in %s", bootRetryTime(ctx, status)),
 				}
 				status.SetErrorDescription(errDescription)
 			}
@@ -903,6 +951,11 @@ func verifyStatus(ctx *domainContext, status *types.DomainStatus) {
 			status.Activated = true
 			status.State = types.RUNNING
 			publishDomainStatus(ctx, status)
+		} else if placement, ok := ctx.cpuAllocator.placement(status.Key()); ok &&
+			status.Activated && placement.CPUs != status.CPUPlacement.CPUs {
+			// The shared CPUs changed
+			pinDomainCPUs(ctx, status)
+			publishDomainStatus(ctx, status)
 		} else if domainID != status.DomainId {
 			// XXX shutdown + create?
 			log.Warnf("verifyDomain(%s) domainID changed from %d to %d",
@@ -953,7 +1006,7 @@ func maybeRetryBoot(ctx *domainContext, status *types.DomainStatus) {
 
 	t := time.Now()
 	elapsed := t.Sub(status.ErrorTime)
-	timeLimit := time.Duration(ctx.domainBootRetryTime) * time.Second
+	timeLimit := bootRetryTime(ctx, status)
 	if elapsed < timeLimit {
 		log.Functionf("maybeRetryBoot(%s) %d remaining",
 			status.Key(),
@@ -1102,6 +1155,11 @@ func handleCreate(ctx *domainContext, key string, config *types.DomainConfig) {
 		DisableLogs:        config.DisableLogs,
 		State:              types.INSTALLED,
 		VmConfig:           config.VmConfig,
+		// Do not act on requests made before the domain existed
+		Snapshot: types.DomainSnapshotStatus{
+			SnapshotCounter: config.SnapshotCmd.Counter,
+			RestoreCounter:  config.RestoreCmd.Counter,
+		},
 	}
 	// Note that the -emu interface doesn't exist until after boot of the domU, but we
 	// initialize the VifList here with the VifUsed.
@@ -1281,6 +1339,17 @@ func doActivate(ctx *domainContext, config types.DomainConfig,
 		}
 	}
 
+	placement, err := ctx.cpuAllocator.allocate(status.Key(), config.CPUs)
+	if err != nil {
+		err = fmt.Errorf("doActivate: failed to allocate CPUs %q for %s: %v",
+			config.CPUs, config.UUIDandVersion.UUID, err)
+		log.Error(err.Error())
+		status.SetErrorNow(err.Error())
+		return
+	}
+	status.CPUPlacement = placement
+	config.CPUs = placement.CPUs
+
 	filename := xenCfgFilename(config.AppNum)
 	file, err := os.Create(filename)
 	if err != nil {
@@ -1387,6 +1456,7 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 			status.Key())
 	}
 	status.Activated = true
+	pinDomainCPUs(ctx, status)
 	err = setupVlans(status.VifList)
 	if err != nil {
 		log.Errorf("setupVlans failed: %v", err)
@@ -1395,6 +1465,25 @@ func doActivateTail(ctx *domainContext, status *types.DomainStatus,
 		status.UUIDandVersion, status.DisplayName)
 }
 
+// pinDomainCPUs restricts the running domain to its current CPU placement
+func pinDomainCPUs(ctx *domainContext, status *types.DomainStatus) {
+	placement, ok := ctx.cpuAllocator.placement(status.Key())
+	if !ok || placement.CPUs == "" {
+		return
+	}
+	vcpus, err := hyper.Task(status).PinCPUs(status.DomainName,
+		placement.CPUs, placement.Exclusive)
+	if err != nil {
+		log.Errorf("pinDomainCPUs(%s) to %s failed: %v",
+			status.Key(), placement.CPUs, err)
+		return
+	}
+	placement.VCPUs = vcpus
+	status.CPUPlacement = placement
+	log.Noticef("pinDomainCPUs(%s) to %s vCPUs %v",
+		status.Key(), placement.CPUs, vcpus)
+}
+
 // VLAN filtering could have been enabled on the bridge immediately after
 // it's creation in zedrouter. For some strange reason netlink
 // throws back error stating that the device is busy if enabling the vlan
@@ -1479,15 +1568,22 @@ func setupVlans(vifList []types.VifInfo) error {
 					vif.Vlan.Start, vif.Vif, err)
 				return err
 			}
+			// Keep the access port out of the untagged traffic
+			// of the default VLAN
+			err = netlink.BridgeVlanDel(link, 1, false, false, false, false)
+			if err != nil {
+				log.Warnf("setupVlans: failed to remove default VLAN from access port '%s': %v",
+					vif.Vif, err)
+			}
 		} else {
-			start := vif.Vlan.Start
-			end := vif.Vlan.End
-			for vlanID := start; vlanID <= end; vlanID++ {
-				err = netlink.BridgeVlanAdd(link, uint16(vlanID), false, false, false, false)
-				if err != nil {
-					err = fmt.Errorf("failed to configure VLAN (%d) for trunk port '%s': %w",
-						vlanID, vif.Vif, err)
-					return err
+			for _, vlanRange := range vif.Vlan.VlanRanges() {
+				for vlanID := vlanRange.Start; vlanID <= vlanRange.End; vlanID++ {
+					err = netlink.BridgeVlanAdd(link, uint16(vlanID), false, false, false, false)
+					if err != nil {
+						err = fmt.Errorf("failed to configure VLAN (%d) for trunk port '%s': %w",
+							vlanID, vif.Vif, err)
+						return err
+					}
 				}
 			}
 		}
@@ -1533,6 +1629,7 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 	if status.DomainId != 0 {
 		status.State = types.HALTING
 		publishDomainStatus(ctx, status)
+		deleteSnapshots(status)
 
 		if doShutdown {
 			// If the Shutdown fails we don't wait; assume failure
@@ -1606,6 +1703,11 @@ func doInactivate(ctx *domainContext, status *types.DomainStatus, impatient bool
 	} else {
 		status.Activated = false
 		status.State = types.HALTED
+		ctx.cpuAllocator.release(status.Key())
+		status.CPUPlacement = types.CPUPlacement{}
+		status.Health = types.HealthStatus{}
+		status.RestartCount = 0
+		status.RestartBackoff = 0
 	}
 	unmountContainers(ctx, status.DiskStatusList)
 	releaseAdapters(ctx, status.IoAdapterList, status.UUIDandVersion.UUID,
@@ -1719,6 +1821,16 @@ func configToStatus(ctx *domainContext, config types.DomainConfig,
 		ds.Vdev = fmt.Sprintf("xvd%c", int('a')+i)
 	}
 
+	status.ImageHealthCheck = nil
+	if status.OCIConfigDir != "" {
+		hc, err := containerd.GetImageHealthCheck(status.OCIConfigDir)
+		if err != nil {
+			log.Warnf("configToStatus(%s) no image health check: %v",
+				config.Key(), err)
+		}
+		status.ImageHealthCheck = hc
+	}
+
 	//clean environment variables
 	status.EnvVariables = nil
 
@@ -2064,6 +2176,7 @@ func handleDelete(ctx *domainContext, key string, status *types.DomainStatus) {
 	unpublishDomainStatus(ctx, status)
 	// No point in publishing metrics any more
 	ctx.pubDomainMetric.Unpublish(status.Key())
+	unpublishContainerMetrics(ctx, status.Key())
 
 	log.Functionf("handleDelete(%v) DONE for %s",
 		status.UUIDandVersion, status.DisplayName)
@@ -2203,6 +2316,7 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			ctx.metricInterval = gcp.GlobalValueInt(types.MetricInterval)
 		}
 		ctx.processCloudInitMultiPart = gcp.GlobalValueBool(types.ProcessCloudInitMultiPart)
+		ctx.cpuAllocator.setEveCPUs(int(gcp.GlobalValueInt(types.EveReservedCPUs)))
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s. "+
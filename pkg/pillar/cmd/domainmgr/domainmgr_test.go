@@ -10,13 +10,18 @@ package domainmgr
 
 import (
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/hypervisor"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 )
@@ -409,3 +414,193 @@ func TestHandleMimeMultipart(t *testing.T) {
 	}
 	os.RemoveAll(dir)
 }
+
+func TestSnapshotWork(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "domainmgr", 0)
+	var err error
+	hyper, err = hypervisor.GetHypervisor("null")
+	if err != nil {
+		t.Fatalf("GetHypervisor failed: %v", err)
+	}
+	conf, err := ioutil.TempFile("", "config")
+	if err != nil {
+		t.Fatalf("TempFile failed: %v", err)
+	}
+	defer os.Remove(conf.Name())
+	conf.Close()
+
+	status := &types.DomainStatus{
+		DomainName:         "test.1",
+		VirtualizationMode: types.HVM,
+	}
+	assert.Error(t, checkSnapshotDomain(status))
+	status.DomainId, err = hyper.Task(status).Create(status.DomainName,
+		conf.Name(), &types.DomainConfig{})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer hyper.Task(status).Delete(status.DomainName)
+	assert.NoError(t, hyper.Task(status).Start(status.DomainName))
+	status.Activated = true
+	assert.NoError(t, checkSnapshotDomain(status))
+
+	// The work is performed by the worker and applied to the status
+	// once done
+	w := worker.NewWorker(log, nil, 1, map[string]worker.Handler{
+		workSnapshot: {Request: snapshotWorker},
+	})
+	defer w.Done()
+	perform := func(work snapshotWork) {
+		work.domainName = status.DomainName
+		work.domainID = status.DomainId
+		status.Snapshot.InProgress = work.String()
+		if err := w.Submit(worker.Work{Key: "test", Kind: workSnapshot,
+			Description: work}); err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+		res := <-w.MsgChan()
+		assert.NoError(t, res.Process(nil, true))
+		result := w.Pop("test")
+		if assert.NotNil(t, result) {
+			applySnapshotResult(status, result.Description.(snapshotWork),
+				result.Error, time.Now())
+		}
+		assert.Empty(t, status.Snapshot.InProgress)
+	}
+	perform(snapshotWork{name: "snapshot-1"})
+	perform(snapshotWork{name: "good"})
+	perform(snapshotWork{name: "snapshot-3"})
+	// A snapshot with the same name is replaced
+	perform(snapshotWork{name: "snapshot-1"})
+	assert.Empty(t, status.Snapshot.Error)
+	if assert.Equal(t, 3, len(status.Snapshot.Snapshots)) {
+		assert.Equal(t, "good", status.Snapshot.Snapshots[0].Name)
+		assert.Equal(t, "snapshot-3", status.Snapshot.Snapshots[1].Name)
+		assert.Equal(t, "snapshot-1", status.Snapshot.Snapshots[2].Name)
+	}
+	assert.Equal(t, "snapshot-1", status.Snapshot.LookupSnapshot("").Name)
+
+	perform(snapshotWork{restore: true, name: "good"})
+	assert.Empty(t, status.Snapshot.Error)
+	assert.Equal(t, "good", status.Snapshot.RestoredFrom)
+	assert.False(t, status.Snapshot.RestoredAt.IsZero())
+
+	// The result is dropped when the domain was restarted meanwhile
+	applySnapshotResult(status, snapshotWork{name: "old",
+		domainID: status.DomainId + 1}, nil, time.Now())
+	assert.NotEmpty(t, status.Snapshot.Error)
+	assert.Nil(t, status.Snapshot.LookupSnapshot("old"))
+	applySnapshotResult(status, snapshotWork{name: "failed",
+		domainID: status.DomainId}, errors.New("savevm failed"), time.Now())
+	assert.Equal(t, "savevm failed", status.Snapshot.Error)
+	assert.Equal(t, 3, len(status.Snapshot.Snapshots))
+
+	deleteSnapshots(status)
+	assert.Empty(t, status.Snapshot.Snapshots)
+}
+
+func TestCPUAllocator(t *testing.T) {
+	_, err := newCPUAllocator(0, 0)
+	assert.Error(t, err, "unknown number of CPUs")
+
+	a, err := newCPUAllocator(8, 1)
+	if err != nil {
+		t.Fatalf("newCPUAllocator failed: %v", err)
+	}
+	p, err := a.allocate("shared1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, types.CPUPlacement{CPUs: "1-7"}, p)
+
+	p, err = a.allocate("excl1", "2-3")
+	assert.NoError(t, err)
+	assert.Equal(t, types.CPUPlacement{Exclusive: true, CPUs: "2-3"}, p)
+	p, _ = a.placement("shared1")
+	assert.Equal(t, "1,4-7", p.CPUs)
+
+	_, err = a.allocate("excl2", "3,4")
+	assert.Error(t, err, "overlaps excl1")
+	_, err = a.allocate("excl2", "0")
+	assert.Error(t, err, "reserved for EVE")
+	_, err = a.allocate("excl2", "8")
+	assert.Error(t, err, "does not exist")
+	_, ok := a.placement("excl2")
+	assert.False(t, ok)
+
+	// Re-allocating the same domain replaces its CPUs; the shared
+	// domains fall back to the EVE CPUs
+	_, err = a.allocate("excl1", "1-7")
+	assert.NoError(t, err)
+	p, _ = a.placement("shared1")
+	assert.Equal(t, "0", p.CPUs)
+
+	// The shared domains never run on exclusive CPUs
+	a.setEveCPUs(0)
+	p, _ = a.placement("shared1")
+	assert.Equal(t, "0", p.CPUs)
+	_, err = a.allocate("excl1", "0-7")
+	assert.Error(t, err, "no CPU left for shared1")
+	_, ok = a.placement("excl1")
+	assert.False(t, ok)
+	p, _ = a.placement("shared1")
+	assert.Equal(t, "0-7", p.CPUs)
+
+	a.release("shared1")
+	_, ok = a.placement("shared1")
+	assert.False(t, ok)
+	_, err = a.allocate("excl1", "0-7")
+	assert.NoError(t, err)
+	_, err = a.allocate("shared2", "")
+	assert.Error(t, err, "all CPUs are exclusive")
+	_, ok = a.placement("shared2")
+	assert.False(t, ok)
+
+	a.release("excl1")
+	p, err = a.allocate("shared2", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "0-7", p.CPUs)
+}
+
+func TestNextHealthStatus(t *testing.T) {
+	now := time.Now()
+	checkErr := errors.New("execution failed with exit status 1")
+	health := types.HealthStatus{State: types.HealthStateStarting, Since: now}
+
+	// Failures during the start period do not count
+	health = nextHealthStatus(health, checkErr, " not ready\n", true, 2, now)
+	assert.Equal(t, types.HealthStateStarting, health.State)
+	assert.Equal(t, uint32(0), health.FailingStreak)
+	assert.Equal(t, "execution failed with exit status 1: not ready", health.LastError)
+
+	health = nextHealthStatus(health, nil, "", true, 2, now)
+	assert.Equal(t, types.HealthStateHealthy, health.State)
+	assert.Empty(t, health.LastError)
+
+	health = nextHealthStatus(health, checkErr, "", false, 2, now)
+	assert.Equal(t, types.HealthStateHealthy, health.State)
+	assert.Equal(t, uint32(1), health.FailingStreak)
+	later := now.Add(time.Minute)
+	health = nextHealthStatus(health, checkErr, "", false, 2, later)
+	assert.Equal(t, types.HealthStateUnhealthy, health.State)
+	assert.Equal(t, uint32(2), health.FailingStreak)
+	assert.True(t, later.Equal(health.Since))
+
+	health = nextHealthStatus(health, nil, "", false, 2, now)
+	assert.Equal(t, types.HealthStateHealthy, health.State)
+	assert.Equal(t, uint32(0), health.FailingStreak)
+}
+
+func TestRestartBackoff(t *testing.T) {
+	maxBackoff := 600 * time.Second
+	backoff := restartBackoff(0, time.Second, maxBackoff)
+	assert.Equal(t, restartBackoffMin, backoff)
+	backoff = restartBackoff(backoff, time.Second, maxBackoff)
+	assert.Equal(t, 2*restartBackoffMin, backoff)
+	backoff = restartBackoff(500*time.Second, time.Second, maxBackoff)
+	assert.Equal(t, maxBackoff, backoff)
+	// Reset after running for a while
+	backoff = restartBackoff(backoff, restartBackoffReset, maxBackoff)
+	assert.Equal(t, restartBackoffMin, backoff)
+	// timer.boot.retry below the minimum
+	backoff = restartBackoff(backoff, time.Second, time.Second)
+	assert.Equal(t, restartBackoffMin, backoff)
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package domainmgr
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	// First delay before a restart due to the RestartPolicy; it doubles
+	// for each restart up to timer.boot.retry
+	restartBackoffMin = 10 * time.Second
+	// A domain which ran for this long is restarted after restartBackoffMin
+	restartBackoffReset = 10 * time.Minute
+	// Limit for the output of a failed health check in HealthStatus
+	maxHealthErrorLen = 256
+	// Error of a domain with a health check which can not be run
+	healthCheckUnsupported = "health check is only supported for containers without a hypervisor"
+)
+
+// healthCheckFor returns the health check from the controller, or else
+// the one from the container image
+func healthCheckFor(config *types.DomainConfig, status *types.DomainStatus) *types.HealthCheckConfig {
+	if config.HealthCheck != nil {
+		return config.HealthCheck
+	}
+	return status.ImageHealthCheck
+}
+
+// healthCheckInterval returns when to run the next health check
+func healthCheckInterval(ctx *domainContext, key string) time.Duration {
+	config := lookupDomainConfig(ctx, key)
+	status := lookupDomainStatus(ctx, key)
+	if config == nil || status == nil {
+		return types.DefaultHealthCheckInterval
+	}
+	hc := healthCheckFor(config, status)
+	if hc == nil {
+		return types.DefaultHealthCheckInterval
+	}
+	interval := hc.IntervalOrDefault()
+	if interval < time.Second {
+		interval = time.Second
+	}
+	return interval
+}
+
+// runHealthCheck runs the health check in a running container and updates
+// status.Health. Restarts the domain if it became unhealthy and the
+// RestartPolicy says so.
+func runHealthCheck(ctx *domainContext, status *types.DomainStatus) {
+	config := lookupDomainConfig(ctx, status.Key())
+	if config == nil {
+		return
+	}
+	var command []string
+	hc := healthCheckFor(config, status)
+	if hc != nil {
+		command = hc.Command()
+	}
+	changed := false
+	// The check can only be run in a container without a hypervisor,
+	// elsewhere it would have to run inside the VM. This is an error for
+	// a check from the controller, the HEALTHCHECK of the container image
+	// is skipped.
+	if config.HealthCheck != nil && command != nil && status.Activated &&
+		status.VirtualizationMode != types.NOHYPER {
+		if !status.HasError() {
+			log.Errorf("runHealthCheck(%s): %s", status.Key(),
+				healthCheckUnsupported)
+			status.SetErrorDescription(types.ErrorDescription{
+				Error: healthCheckUnsupported,
+			})
+			changed = true
+		}
+	} else if status.Error == healthCheckUnsupported {
+		status.ClearError()
+		changed = true
+	}
+	if command == nil || !status.Activated ||
+		status.VirtualizationMode != types.NOHYPER {
+		if status.Health.State != types.HealthStateNone {
+			status.Health = types.HealthStatus{}
+			changed = true
+		}
+		if changed {
+			publishDomainStatus(ctx, status)
+		}
+		return
+	}
+	health := status.Health
+	if health.State == types.HealthStateNone {
+		health.State = types.HealthStateStarting
+		health.Since = time.Now()
+	}
+	output, err := hyper.Task(status).Exec(status.DomainName, command,
+		hc.TimeoutOrDefault())
+	starting := time.Since(status.BootTime) < hc.StartPeriod
+	health = nextHealthStatus(health, err, output, starting,
+		hc.RetriesOrDefault(), time.Now())
+	if health.Equal(status.Health) {
+		return
+	}
+	if health.State != status.Health.State {
+		log.Noticef("runHealthCheck(%s) health changed from %s to %s: %s",
+			status.Key(), status.Health.State, health.State,
+			health.LastError)
+	}
+	becameUnhealthy := health.State == types.HealthStateUnhealthy &&
+		status.Health.State != types.HealthStateUnhealthy
+	status.Health = health
+	if becameUnhealthy && config.RestartPolicy == types.RestartPolicyOnUnhealthy {
+		restartUnhealthy(ctx, status, config.RestartPolicy)
+	}
+	publishDomainStatus(ctx, status)
+}
+
+// nextHealthStatus determines the health after a check. Failures during
+// the start period do not count unless the domain was healthy already.
+func nextHealthStatus(health types.HealthStatus, checkErr error, output string,
+	starting bool, retries uint32, now time.Time) types.HealthStatus {
+
+	if checkErr == nil {
+		if health.State != types.HealthStateHealthy {
+			health.State = types.HealthStateHealthy
+			health.Since = now
+		}
+		health.FailingStreak = 0
+		health.LastError = ""
+		return health
+	}
+	lastError := checkErr.Error()
+	if output = strings.TrimSpace(output); output != "" {
+		lastError = fmt.Sprintf("%s: %s", lastError, output)
+	}
+	if len(lastError) > maxHealthErrorLen {
+		lastError = lastError[:maxHealthErrorLen]
+	}
+	health.LastError = lastError
+	if starting && health.State == types.HealthStateStarting {
+		return health
+	}
+	health.FailingStreak++
+	if health.FailingStreak >= retries &&
+		health.State != types.HealthStateUnhealthy {
+		health.State = types.HealthStateUnhealthy
+		health.Since = now
+	}
+	return health
+}
+
+// restartUnhealthy kills the domain and schedules a restart
+func restartUnhealthy(ctx *domainContext, status *types.DomainStatus,
+	policy types.RestartPolicy) {
+
+	log.Warnf("restartUnhealthy(%s) restarting due to health check failure: %s",
+		status.Key(), status.Health.LastError)
+	if err := hyper.Task(status).Stop(status.DomainName, true); err != nil {
+		log.Errorf("failed to stop domain: %s (%v)", status.DomainName, err)
+	}
+	if err := hyper.Task(status).Delete(status.DomainName); err != nil {
+		log.Errorf("failed to delete domain: %s (%v)", status.DomainName, err)
+	}
+	if err := hyper.Task(status).Cleanup(status.DomainName); err != nil {
+		log.Errorf("failed to cleanup domain: %s (%v)", status.DomainName, err)
+	}
+	status.Activated = false
+	status.State = types.HALTED
+	status.DomainId = 0
+	scheduleRestart(ctx, status, policy,
+		fmt.Sprintf("health check failed: %s", status.Health.LastError))
+}
+
+// handleDomainExit applies the RestartPolicy to a domain which exited
+func handleDomainExit(ctx *domainContext, status *types.DomainStatus,
+	policy types.RestartPolicy, failed bool, exitErr error) {
+
+	status.Health = types.HealthStatus{}
+	if !policy.RestartOnExit(failed) {
+		if failed {
+			log.Errorf("handleDomainExit(%s) failed: %v; not restarted due to restart policy %s",
+				status.Key(), exitErr, policy)
+			status.SetErrorNow(fmt.Sprintf("application exited with an error: %v", exitErr))
+			status.State = types.BROKEN
+		} else {
+			log.Noticef("handleDomainExit(%s) exited; not restarted due to restart policy %s",
+				status.Key(), policy)
+		}
+		return
+	}
+	reason := "application exited"
+	if failed {
+		reason = fmt.Sprintf("application exited with an error: %v", exitErr)
+	}
+	scheduleRestart(ctx, status, policy, reason)
+}
+
+// scheduleRestart makes maybeRetryBoot restart the domain after the backoff
+func scheduleRestart(ctx *domainContext, status *types.DomainStatus,
+	policy types.RestartPolicy, reason string) {
+
+	maxBackoff := time.Duration(ctx.domainBootRetryTime) * time.Second
+	status.RestartBackoff = restartBackoff(status.RestartBackoff,
+		time.Since(status.BootTime), maxBackoff)
+	status.RestartCount++
+	status.BootFailed = true
+	log.Noticef("scheduleRestart(%s) restart %d in %v: %s",
+		status.Key(), status.RestartCount, status.RestartBackoff, reason)
+	status.SetErrorDescription(types.ErrorDescription{
+		Error:         reason,
+		ErrorSeverity: types.ErrorSeverityWarning,
+		ErrorRetryCondition: fmt.Sprintf("will restart in %s due to restart policy %s",
+			status.RestartBackoff, policy),
+	})
+}
+
+// restartBackoff doubles the previous backoff up to maxBackoff unless the
+// domain ran for restartBackoffReset
+func restartBackoff(prev time.Duration, uptime time.Duration,
+	maxBackoff time.Duration) time.Duration {
+
+	backoff := restartBackoffMin
+	if prev != 0 && uptime < restartBackoffReset {
+		backoff = 2 * prev
+	}
+	if maxBackoff < restartBackoffMin {
+		maxBackoff = restartBackoffMin
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff
+}
+
+// bootRetryTime returns the delay before maybeRetryBoot boots the domain
+func bootRetryTime(ctx *domainContext, status *types.DomainStatus) time.Duration {
+	if status.RestartBackoff != 0 {
+		return status.RestartBackoff
+	}
+	return time.Duration(ctx.domainBootRetryTime) * time.Second
+}
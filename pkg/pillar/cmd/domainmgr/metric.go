@@ -154,6 +154,7 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 		dm.UsedMemoryPercent = 0
 		ctx.pubDomainMetric.Publish(dm.Key(), dm)
 	}
+	publishContainerMetrics(ctx, hyper, now)
 	if hyper.Name() != "xen" {
 		// the the hypervisor other than Xen, we don't have the Dom0 stats in dmList. Get the host
 		// cpu and memory for the device here
@@ -162,6 +163,41 @@ func getAndPublishMetrics(ctx *domainContext, hyper hypervisor.Hypervisor) {
 	ctx.pubHostMemory.Publish("global", hm)
 }
 
+// publishContainerMetrics publishes the cgroup usage, including block IO,
+// PIDs and hugepages, of the native container domains
+func publishContainerMetrics(ctx *domainContext, hyper hypervisor.Hypervisor, now time.Time) {
+	items := ctx.pubDomainStatus.GetAll()
+	for key, item := range items {
+		status := item.(types.DomainStatus)
+		if status.VirtualizationMode != types.NOHYPER || !status.Activated {
+			unpublishContainerMetrics(ctx, key)
+			continue
+		}
+		stats, err := hyper.Task(&status).ContainerStats(status.DomainName)
+		if err != nil {
+			log.Functionf("publishContainerMetrics(%s): %v",
+				status.DomainName, err)
+			continue
+		}
+		stats.ContainerName = status.DisplayName
+		if !status.BootTime.IsZero() {
+			stats.Uptime = status.BootTime.UnixNano()
+		}
+		acMetrics := types.AppContainerMetrics{
+			UUIDandVersion: status.UUIDandVersion,
+			CollectTime:    now,
+			StatsList:      []types.AppContainerStats{*stats},
+		}
+		ctx.pubAppContainerMetrics.Publish(acMetrics.Key(), acMetrics)
+	}
+}
+
+func unpublishContainerMetrics(ctx *domainContext, key string) {
+	if m, _ := ctx.pubAppContainerMetrics.Get(key); m != nil {
+		ctx.pubAppContainerMetrics.Unpublish(key)
+	}
+}
+
 func formatAndPublishHostCPUMem(ctx *domainContext, hm types.HostMemory, now time.Time) {
 	var hostUUID types.UUIDandVersion
 	var usedPerc, busy float64
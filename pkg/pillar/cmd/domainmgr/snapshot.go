@@ -0,0 +1,194 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Snapshots of the memory and disk state of running domains.
+// Taking or restoring a snapshot can take a long time for large domains
+// hence it is done by a worker of the runHandler of the domain, which
+// applies the result to the DomainStatus once done. Only one snapshot or
+// restore is performed at a time; commands which arrive in the meantime are
+// performed when it completes.
+
+package domainmgr
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
+)
+
+const workSnapshot = "snapshot"
+
+// snapshotWork describes a snapshot or restore submitted to the worker
+type snapshotWork struct {
+	restore    bool
+	domainName string
+	domainID   int
+	name       string
+}
+
+func (work snapshotWork) String() string {
+	if work.restore {
+		return "restore " + work.name
+	}
+	return "snapshot " + work.name
+}
+
+func newSnapshotWorker(ctx *domainContext) worker.Worker {
+	return worker.NewWorker(log, ctx, 1, map[string]worker.Handler{
+		workSnapshot: {Request: snapshotWorker,
+			Response: processSnapshotWorkResult},
+	})
+}
+
+// doSnapshotCmds submits any new snapshot or restore request unless one is
+// already in progress
+func doSnapshotCmds(ctx *domainContext, snapWorker worker.Worker, key string) {
+	config := lookupDomainConfig(ctx, key)
+	status := lookupDomainStatus(ctx, key)
+	if config == nil || status == nil || status.Snapshot.InProgress != "" {
+		return
+	}
+	var work snapshotWork
+	var err error
+	if config.SnapshotCmd.Counter != status.Snapshot.SnapshotCounter {
+		status.Snapshot.SnapshotCounter = config.SnapshotCmd.Counter
+		work.name = config.SnapshotCmd.Name
+		if work.name == "" {
+			work.name = fmt.Sprintf("snapshot-%d", config.SnapshotCmd.Counter)
+		}
+		err = checkSnapshotDomain(status)
+	} else if config.RestoreCmd.Counter != status.Snapshot.RestoreCounter {
+		status.Snapshot.RestoreCounter = config.RestoreCmd.Counter
+		work.restore = true
+		work.name = config.RestoreCmd.Name
+		err = checkSnapshotDomain(status)
+		if err == nil {
+			snapshot := status.Snapshot.LookupSnapshot(work.name)
+			if snapshot == nil {
+				err = fmt.Errorf("domain %s has no snapshot %s",
+					status.DomainName, work.name)
+			} else {
+				work.name = snapshot.Name
+			}
+		}
+	} else {
+		return
+	}
+	if err == nil {
+		work.domainName = status.DomainName
+		work.domainID = status.DomainId
+		_, err = snapWorker.TrySubmit(worker.Work{
+			Key:         key,
+			Kind:        workSnapshot,
+			Description: work,
+		})
+	}
+	if err != nil {
+		log.Errorf("doSnapshotCmds(%s) %s failed: %v", key, work, err)
+		status.Snapshot.Error = err.Error()
+		status.Snapshot.ErrorTime = time.Now()
+	} else {
+		log.Noticef("doSnapshotCmds(%s) %s started", key, work)
+		status.Snapshot.InProgress = work.String()
+	}
+	publishDomainStatus(ctx, status)
+}
+
+func checkSnapshotDomain(status *types.DomainStatus) error {
+	if !status.Activated || status.DomainId == 0 {
+		return fmt.Errorf("domain %s is not running", status.DomainName)
+	}
+	if status.VirtualizationMode == types.NOHYPER {
+		return fmt.Errorf("domain %s is a container", status.DomainName)
+	}
+	return nil
+}
+
+// snapshotWorker runs in the worker goroutine
+func snapshotWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	work := w.Description.(snapshotWork)
+	var err error
+	if work.restore {
+		err = hyper.Restore(work.domainName, work.name)
+	} else {
+		err = hyper.Snapshot(work.domainName, work.name)
+	}
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: work,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
+// processSnapshotWorkResult runs in the runHandler goroutine of the domain
+func processSnapshotWorkResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*domainContext)
+	status := lookupDomainStatus(ctx, res.Key)
+	if status == nil {
+		log.Warnf("processSnapshotWorkResult(%s) no status", res.Key)
+		return nil
+	}
+	applySnapshotResult(status, res.Description.(snapshotWork), res.Error,
+		time.Now())
+	publishDomainStatus(ctx, status)
+	return nil
+}
+
+// applySnapshotResult records the outcome of a snapshot or restore.
+// The result is dropped if the domain was halted or restarted meanwhile
+// since its snapshots are gone.
+func applySnapshotResult(status *types.DomainStatus, work snapshotWork,
+	err error, now time.Time) {
+
+	status.Snapshot.InProgress = ""
+	if !status.Activated || status.DomainId != work.domainID {
+		err = fmt.Errorf("domain %s halted during %s", work.domainName, work)
+	}
+	if err != nil {
+		log.Errorf("applySnapshotResult(%s) %s failed: %v",
+			status.Key(), work, err)
+		status.Snapshot.Error = err.Error()
+		status.Snapshot.ErrorTime = now
+		return
+	}
+	status.Snapshot.Error = ""
+	status.Snapshot.ErrorTime = time.Time{}
+	if work.restore {
+		status.Snapshot.RestoredFrom = work.name
+		status.Snapshot.RestoredAt = now
+		log.Noticef("applySnapshotResult(%s) restored snapshot %s",
+			status.Key(), work.name)
+		return
+	}
+	// A snapshot with the same name is replaced
+	snapshots := []types.DomainSnapshot{}
+	for _, snapshot := range status.Snapshot.Snapshots {
+		if snapshot.Name != work.name {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	status.Snapshot.Snapshots = append(snapshots, types.DomainSnapshot{
+		Name:      work.name,
+		CreatedAt: now,
+	})
+	log.Noticef("applySnapshotResult(%s) took snapshot %s", status.Key(),
+		work.name)
+}
+
+// deleteSnapshots removes the snapshots before the domain is halted since
+// they only apply to the running domain and take up disk space
+func deleteSnapshots(status *types.DomainStatus) {
+	for _, snapshot := range status.Snapshot.Snapshots {
+		if err := hyper.DeleteSnapshot(status.DomainName, snapshot.Name); err != nil {
+			log.Warnf("deleteSnapshots(%s) %s failed: %v",
+				status.Key(), snapshot.Name, err)
+		}
+	}
+	status.Snapshot.Snapshots = nil
+}
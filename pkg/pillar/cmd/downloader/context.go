@@ -28,6 +28,12 @@ type downloaderContext struct {
 	cipherMetrics            *cipher.AgentMetrics
 	GCInitialized            bool
 	downloadMaxPortCost      uint8
+	downloadParallelParts    int
+	peerSharing              bool
+	peerServe                bool
+	peerKey                  string
+	peers                    *peerShare
+	peerTried                peerAttempts
 }
 
 func (ctx *downloaderContext) registerHandlers(ps *pubsub.PubSub) error {
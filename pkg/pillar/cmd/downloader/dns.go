@@ -33,6 +33,7 @@ func handleDNSImpl(ctxArg interface{}, key string,
 		return
 	}
 	ctx.deviceNetworkStatus = status
+	updatePeerSharing(ctx)
 	log.Functionf("handleDNSImpl done for %s", key)
 }
 
@@ -45,5 +46,6 @@ func handleDNSDelete(ctxArg interface{}, key string, statusArg interface{}) {
 		return
 	}
 	ctx.deviceNetworkStatus = types.DeviceNetworkStatus{}
+	updatePeerSharing(ctx)
 	log.Functionf("handleDNSDelete done for %s", key)
 }
@@ -10,10 +10,12 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/lf-edge/eve/libs/zedUpload"
 	"github.com/lf-edge/eve/libs/zedUpload/types"
+	pillartypes "github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zedcloud"
 )
 
@@ -34,7 +36,60 @@ func loadDownloadedParts(locFilename string) types.DownloadedParts {
 	return downloadedParts
 }
 
+// partsStatus returns the progress of the parts of a ranged download
+func partsStatus(downloadedParts types.DownloadedParts, totalSize int64) []pillartypes.DownloadPartStatus {
+	var parts []pillartypes.DownloadPartStatus
+	for _, p := range downloadedParts.Parts {
+		partTotal := downloadedParts.PartSize
+		if rest := totalSize - p.Ind*downloadedParts.PartSize; rest < partTotal {
+			partTotal = rest
+		}
+		parts = append(parts, pillartypes.DownloadPartStatus{
+			Index:       p.Ind,
+			CurrentSize: p.Size,
+			TotalSize:   partTotal,
+		})
+	}
+	sort.Slice(parts, func(i, j int) bool { return parts[i].Index < parts[j].Index })
+	return parts
+}
+
+// partsProgressInterval is how often the parts of a ranged download are
+// saved and published while no part completes
+const partsProgressInterval = time.Second
+
+// partsThrottle limits how often the parts are saved to /persist and
+// published, since the progress is reported for every chunk received
+type partsThrottle struct {
+	lastTime  time.Time
+	completed int
+}
+
+// due returns true if a part completed or partsProgressInterval passed
+// since the last time it returned true
+func (t *partsThrottle) due(parts []pillartypes.DownloadPartStatus, now time.Time) bool {
+	completed := 0
+	for _, p := range parts {
+		if p.CurrentSize >= p.TotalSize {
+			completed++
+		}
+	}
+	if completed == t.completed && now.Sub(t.lastTime) < partsProgressInterval {
+		return false
+	}
+	t.completed = completed
+	t.lastTime = now
+	return true
+}
+
+// saveDownloadedParts writes the parts manifest once the data it
+// describes is on disk, so that a resumed download does not skip data
+// which was lost in a power failure
 func saveDownloadedParts(locFilename string, downloadedParts types.DownloadedParts) {
+	if err := syncFile(locFilename); err != nil {
+		log.Errorf("not saving the progress file: %s", err)
+		return
+	}
 	fd, err := os.Create(locFilename + progressFileSuffix)
 	if err != nil {
 		log.Errorf("error creating progress file: %s", err)
@@ -51,6 +106,15 @@ func saveDownloadedParts(locFilename string, downloadedParts types.DownloadedPar
 	}
 }
 
+func syncFile(filename string) error {
+	fd, err := os.OpenFile(filename, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer fd.Close()
+	return fd.Sync()
+}
+
 // download perform the actual download, given the necessary information.
 // Returns the content-type of the object downloaded, normally from the
 // Content-Type header, but subject to whatever the DronaRequest implementation
@@ -128,10 +192,11 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	// create Request
 	req := dEndPoint.NewRequest(syncOp, filename, locFilename,
 		int64(maxsize), true, respChan)
-	req = req.WithDoneParts(downloadedParts)
 	if req == nil {
 		return "", cancel, errors.New("NewRequest failed")
 	}
+	req = req.WithDoneParts(downloadedParts)
+	req = req.WithConcurrency(ctx.downloadParallelParts)
 
 	req = req.WithCancel(context.Background())
 	defer req.Cancel()
@@ -151,13 +216,21 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 	req.Post()
 
 	lastProgress := time.Now()
+	var throttle partsThrottle
+	partsUnsaved := false
+	// Save what we have when the download stops for any reason
+	defer func() {
+		if partsUnsaved {
+			saveDownloadedParts(locFilename, downloadedParts)
+		}
+	}()
 	for resp := range respChan {
 		newDownloadedParts := resp.GetDoneParts()
 		newDownloadedPartsHash := newDownloadedParts.Hash()
 		if downloadedPartsHash != newDownloadedPartsHash {
 			downloadedPartsHash = newDownloadedPartsHash
 			downloadedParts = newDownloadedParts
-			saveDownloadedParts(locFilename, downloadedParts)
+			partsUnsaved = true
 		}
 
 		if resp.IsDnUpdate() {
@@ -176,6 +249,14 @@ func download(ctx *downloaderContext, trType zedUpload.SyncTransportType,
 			// Did anything change since last update?
 			change := status.Progress(progress, currentSize,
 				totalSize)
+			parts := partsStatus(downloadedParts, totalSize)
+			if partsUnsaved && throttle.due(parts, time.Now()) {
+				saveDownloadedParts(locFilename, downloadedParts)
+				partsUnsaved = false
+				if status.PartsProgress(parts) {
+					change = true
+				}
+			}
 			if !change {
 				if time.Since(lastProgress) > maxStalledTime {
 					err := fmt.Errorf("Cancelling due to no progress for %s in %v; size %d/%d",
@@ -0,0 +1,46 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestPartsThrottle(t *testing.T) {
+	start := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	parts := func(sizes ...int64) []types.DownloadPartStatus {
+		var parts []types.DownloadPartStatus
+		for i, size := range sizes {
+			parts = append(parts, types.DownloadPartStatus{
+				Index:       int64(i),
+				CurrentSize: size,
+				TotalSize:   100,
+			})
+		}
+		return parts
+	}
+	calls := []struct {
+		parts  []types.DownloadPartStatus
+		after  time.Duration
+		expDue bool
+	}{
+		{parts: parts(10, 10), expDue: true},
+		{parts: parts(20, 20), after: 100 * time.Millisecond, expDue: false},
+		{parts: parts(30, 30), after: 200 * time.Millisecond, expDue: false},
+		// a part completed
+		{parts: parts(100, 40), after: 300 * time.Millisecond, expDue: true},
+		{parts: parts(100, 50), after: 400 * time.Millisecond, expDue: false},
+		{parts: parts(100, 60), after: 1300 * time.Millisecond, expDue: true},
+		{parts: parts(100, 100), after: 1400 * time.Millisecond, expDue: true},
+	}
+	var throttle partsThrottle
+	for i, call := range calls {
+		if due := throttle.due(call.parts, start.Add(call.after)); due != call.expDue {
+			t.Errorf("call %d: due %v instead of %v", i, due, call.expDue)
+		}
+	}
+}
@@ -40,6 +40,11 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 			maxStalledTime = time.Duration(gcp.GlobalValueInt(types.DownloadStalledTime)) * time.Second
 		}
 		ctx.downloadMaxPortCost = uint8(gcp.GlobalValueInt(types.DownloadMaxPortCost))
+		ctx.downloadParallelParts = int(gcp.GlobalValueInt(types.DownloadParallelParts))
+		ctx.peerSharing = gcp.GlobalValueBool(types.DownloadPeerSharing)
+		ctx.peerServe = gcp.GlobalValueBool(types.DownloadPeerServe)
+		ctx.peerKey = gcp.GlobalValueString(types.DownloadPeerKey)
+		updatePeerSharing(ctx)
 		ctx.GCInitialized = true
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
@@ -0,0 +1,466 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Sharing of blobs between EVE devices on the local network.
+// When serving is enabled the downloader advertises the peerService over
+// mDNS on the management ports and serves the blobs in CAS over HTTP to
+// peers on the subnet of the port. When downloading from peers is enabled
+// it looks for a peer which has the blob before going to the datastore.
+// Both sides share a key configured by the controller; a blob is only
+// served to a requester presenting the HMAC of the blob hash under the
+// key. A peer need not be trusted since the verifier checks the sha256 of
+// what was downloaded, but a blob is downloaded from a peer at most once
+// so that a peer which fails or serves a bad blob does not hold up the
+// download.
+
+package downloader
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grandcat/zeroconf"
+	"github.com/lf-edge/eve/libs/zedUpload"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	peerService   = "_eve-blobs._tcp"
+	peerDomain    = "local."
+	peerPort      = 8085
+	peerBlobsPath = "/v1/blobs/"
+	peerTokenArg  = "token"
+	casClientType = "containerd"
+	// how long we collect mDNS responses
+	peerBrowseTime = 3 * time.Second
+	// timeout for asking a peer whether it has a blob
+	peerProbeTimeout = 2 * time.Second
+)
+
+var peerBlobRegexp = regexp.MustCompile("^sha256:[0-9a-f]{64}$")
+
+// peerShare is the state of the advertisement and of the blob server
+type peerShare struct {
+	sync.Mutex
+	casClient  cas.CAS
+	httpServer *http.Server
+	mdnsServer *zeroconf.Server
+	ifnames    []string // where mdnsServer advertises
+	key        string
+	// only requests to these ports from their subnet are served,
+	// not e.g. from apps
+	ports []types.NetworkPortStatus
+}
+
+func (peers *peerShare) setPorts(ports []types.NetworkPortStatus, key string) {
+	peers.Lock()
+	peers.ports = ports
+	peers.key = key
+	peers.Unlock()
+}
+
+// isPeerAddr checks that the request came in on a port we advertise on
+// from the subnet of that port
+func (peers *peerShare) isPeerAddr(r *http.Request) bool {
+	addr, ok := r.Context().Value(http.LocalAddrContextKey).(net.Addr)
+	if !ok {
+		return false
+	}
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	remoteHost, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	remoteIP := net.ParseIP(remoteHost)
+	if remoteIP == nil {
+		return false
+	}
+	peers.Lock()
+	defer peers.Unlock()
+	for _, port := range peers.ports {
+		if portIPv4(port).Equal(tcpAddr.IP) {
+			return port.Subnet.Contains(remoteIP)
+		}
+	}
+	return false
+}
+
+// serveHTTP checks that the request comes from a peer which knows the key
+func (peers *peerShare) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !peers.isPeerAddr(r) {
+		http.NotFound(w, r)
+		return
+	}
+	peers.Lock()
+	key := peers.key
+	peers.Unlock()
+	blobHash := strings.TrimPrefix(r.URL.Path, peerBlobsPath)
+	token := r.URL.Query().Get(peerTokenArg)
+	if !checkPeerToken(key, blobHash, token) {
+		log.Warnf("serveHTTP(%s): bad token from %s", blobHash, r.RemoteAddr)
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+	servePeerBlob(peers.casClient, w, r)
+}
+
+// peerToken proves to the peer that we know the key
+func peerToken(key, blobHash string) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(blobHash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func checkPeerToken(key, blobHash, token string) bool {
+	if key == "" {
+		return false
+	}
+	return hmac.Equal([]byte(peerToken(key, blobHash)), []byte(token))
+}
+
+// peerAttempts records, per blob, the peer it was downloaded from.
+// A blob which is downloaded again after that either failed to download
+// from the peer or failed verification hence the datastore is used.
+type peerAttempts struct {
+	sync.Mutex
+	peers map[string]string // key is the sha256, value the peer URL
+}
+
+// record notes that the blob is downloaded from the peer
+func (attempts *peerAttempts) record(sha256, serverURL string) {
+	attempts.Lock()
+	defer attempts.Unlock()
+	if attempts.peers == nil {
+		attempts.peers = make(map[string]string)
+	}
+	attempts.peers[strings.ToLower(sha256)] = serverURL
+}
+
+// tried returns the peer the blob was downloaded from if any
+func (attempts *peerAttempts) tried(sha256 string) (string, bool) {
+	attempts.Lock()
+	defer attempts.Unlock()
+	serverURL, ok := attempts.peers[strings.ToLower(sha256)]
+	return serverURL, ok
+}
+
+// peerBlob is a peer which has a blob
+type peerBlob struct {
+	serverURL   string
+	ifname      string
+	ipSrc       net.IP
+	contentType string
+}
+
+// peerPorts returns the management ports which can be used to reach peers
+func peerPorts(ctx *downloaderContext) []types.NetworkPortStatus {
+	var ports []types.NetworkPortStatus
+	for _, port := range ctx.deviceNetworkStatus.Ports {
+		if !port.IsMgmt || port.Cost > ctx.downloadMaxPortCost {
+			continue
+		}
+		if portIPv4(port) == nil {
+			continue
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
+
+func portIPv4(port types.NetworkPortStatus) net.IP {
+	for _, ai := range port.AddrInfoList {
+		if ai.Addr.To4() != nil && !ai.Addr.IsLinkLocalUnicast() {
+			return ai.Addr
+		}
+	}
+	return nil
+}
+
+func peerInterfaces(ports []types.NetworkPortStatus) ([]net.Interface, []string) {
+	var ifs []net.Interface
+	var ifnames []string
+	for _, port := range ports {
+		intf, err := net.InterfaceByName(port.IfName)
+		if err != nil {
+			log.Warnf("peerInterfaces: %s: %v", port.IfName, err)
+			continue
+		}
+		ifs = append(ifs, *intf)
+		ifnames = append(ifnames, port.IfName)
+	}
+	return ifs, ifnames
+}
+
+// updatePeerSharing starts, stops or moves the advertisement and the blob
+// server after a change to the global config or the management ports
+func updatePeerSharing(ctx *downloaderContext) {
+	if !ctx.peerServe || ctx.peerKey == "" {
+		stopPeerSharing(ctx)
+		return
+	}
+	if ctx.peers == nil {
+		casClient, err := cas.NewCAS(casClientType)
+		if err != nil {
+			log.Errorf("updatePeerSharing: cannot create CAS client: %v", err)
+			return
+		}
+		ctx.peers = &peerShare{casClient: casClient}
+		startPeerServer(ctx.peers)
+	}
+	ports := peerPorts(ctx)
+	ctx.peers.setPorts(ports, ctx.peerKey)
+	ifs, ifnames := peerInterfaces(ports)
+	if ctx.peers.mdnsServer != nil && reflect.DeepEqual(ifnames, ctx.peers.ifnames) {
+		return
+	}
+	if ctx.peers.mdnsServer != nil {
+		ctx.peers.mdnsServer.Shutdown()
+		ctx.peers.mdnsServer = nil
+	}
+	ctx.peers.ifnames = ifnames
+	if len(ifs) == 0 {
+		log.Functionf("updatePeerSharing: no ports to advertise on")
+		return
+	}
+	instance, err := os.Hostname()
+	if err != nil {
+		log.Errorf("updatePeerSharing: %v", err)
+		return
+	}
+	server, err := zeroconf.Register(instance, peerService, peerDomain,
+		peerPort, []string{"path=" + peerBlobsPath}, ifs)
+	if err != nil {
+		log.Errorf("updatePeerSharing: advertising on %v failed: %v",
+			ifnames, err)
+		return
+	}
+	ctx.peers.mdnsServer = server
+	log.Noticef("updatePeerSharing: advertising blobs on %v", ifnames)
+}
+
+func stopPeerSharing(ctx *downloaderContext) {
+	if ctx.peers == nil {
+		return
+	}
+	if ctx.peers.mdnsServer != nil {
+		ctx.peers.mdnsServer.Shutdown()
+	}
+	if err := ctx.peers.httpServer.Close(); err != nil {
+		log.Errorf("stopPeerSharing: %v", err)
+	}
+	if err := ctx.peers.casClient.CloseClient(); err != nil {
+		log.Errorf("stopPeerSharing: %v", err)
+	}
+	ctx.peers = nil
+	log.Noticef("stopPeerSharing: stopped")
+}
+
+func startPeerServer(peers *peerShare) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(peerBlobsPath, peers.serveHTTP)
+	peers.httpServer = &http.Server{
+		Addr:              fmt.Sprintf(":%d", peerPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		err := peers.httpServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("startPeerServer: %v", err)
+		}
+	}()
+}
+
+// servePeerBlob serves GET and HEAD for /v1/blobs/sha256:<hash>.
+// The Content-Type is the media type of the blob if known.
+func servePeerBlob(casClient cas.CAS, w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	blobHash := strings.TrimPrefix(r.URL.Path, peerBlobsPath)
+	if !peerBlobRegexp.MatchString(blobHash) {
+		http.Error(w, "bad blob hash", http.StatusBadRequest)
+		return
+	}
+	info, err := casClient.GetBlobInfo(blobHash)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	// do not let net/http guess a content type
+	w.Header()["Content-Type"] = nil
+	mediaTypes, err := casClient.ListBlobsMediaTypes()
+	if err != nil {
+		log.Warnf("servePeerBlob(%s): %v", blobHash, err)
+	} else if mediaType, ok := mediaTypes[blobHash]; ok {
+		w.Header().Set("Content-Type", mediaType)
+	}
+	w.Header().Set("Content-Length", fmt.Sprintf("%d", info.Size))
+	if r.Method == http.MethodHead {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	ctrdCtx, done := casClient.CtrNewUserServicesCtx()
+	defer done()
+	reader, err := casClient.ReadBlob(ctrdCtx, blobHash)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	log.Functionf("servePeerBlob(%s) to %s", blobHash, r.RemoteAddr)
+	if _, err := io.Copy(w, reader); err != nil {
+		log.Warnf("servePeerBlob(%s) to %s: %v", blobHash, r.RemoteAddr, err)
+	}
+}
+
+// findPeerBlob looks for a peer which has the blob
+func findPeerBlob(ctx *downloaderContext, sha256 string, size uint64) (*peerBlob, error) {
+	ports := peerPorts(ctx)
+	ifs, _ := peerInterfaces(ports)
+	if len(ifs) == 0 {
+		return nil, fmt.Errorf("no ports to look for peers")
+	}
+	resolver, err := zeroconf.NewResolver(zeroconf.SelectIfaces(ifs),
+		zeroconf.SelectIPTraffic(zeroconf.IPv4))
+	if err != nil {
+		return nil, err
+	}
+	instance, _ := os.Hostname()
+
+	mctx, cancel := context.WithTimeout(context.Background(), peerBrowseTime)
+	defer cancel()
+	entries := make(chan *zeroconf.ServiceEntry)
+	found := make(chan []*zeroconf.ServiceEntry, 1)
+	go func() {
+		var peers []*zeroconf.ServiceEntry
+		for entry := range entries {
+			if entry.Instance != instance {
+				peers = append(peers, entry)
+			}
+		}
+		found <- peers
+	}()
+	if err := resolver.Browse(mctx, peerService, peerDomain, entries); err != nil {
+		return nil, err
+	}
+	<-mctx.Done()
+	peers := <-found
+	return selectPeerBlob(ports, peers, sha256, size, ctx.peerKey)
+}
+
+// selectPeerBlob returns the first peer on the subnet of one of the ports
+// which has the blob
+func selectPeerBlob(ports []types.NetworkPortStatus,
+	peers []*zeroconf.ServiceEntry, sha256 string, size uint64,
+	key string) (*peerBlob, error) {
+
+	blobHash := "sha256:" + strings.ToLower(sha256)
+	blobPath := peerBlobsPath + blobHash + "?" + peerTokenArg + "=" +
+		peerToken(key, blobHash)
+	for _, entry := range peers {
+		for _, ip := range entry.AddrIPv4 {
+			ifname, ipSrc := peerSource(ports, ip)
+			if ipSrc == nil {
+				continue
+			}
+			serverURL := fmt.Sprintf("http://%s", net.JoinHostPort(ip.String(),
+				fmt.Sprintf("%d", entry.Port)))
+			contentType, err := probePeerBlob(serverURL+blobPath, ipSrc, size)
+			if err != nil {
+				log.Functionf("findPeerBlob(%s): %s: %v", sha256, serverURL, err)
+				continue
+			}
+			return &peerBlob{
+				serverURL:   serverURL,
+				ifname:      ifname,
+				ipSrc:       ipSrc,
+				contentType: contentType,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no peer has %s among %d peers", sha256, len(peers))
+}
+
+// peerSource returns the port on the subnet of the peer
+func peerSource(ports []types.NetworkPortStatus, ip net.IP) (string, net.IP) {
+	for _, port := range ports {
+		if port.Subnet.Contains(ip) {
+			return port.IfName, portIPv4(port)
+		}
+	}
+	return "", nil
+}
+
+// probePeerBlob checks that the peer has the blob and returns its media type
+func probePeerBlob(blobURL string, ipSrc net.IP, size uint64) (string, error) {
+	dialer := &net.Dialer{
+		Timeout:   peerProbeTimeout,
+		LocalAddr: &net.TCPAddr{IP: ipSrc},
+	}
+	client := &http.Client{
+		Timeout:   peerProbeTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+	resp, err := client.Head(blobURL)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("response code %d", resp.StatusCode)
+	}
+	if size != 0 && resp.ContentLength != int64(size) {
+		return "", fmt.Errorf("size %d instead of %d", resp.ContentLength, size)
+	}
+	return resp.Header.Get("Content-Type"), nil
+}
+
+// downloadFromPeer downloads the blob from a peer if one has it.
+// Returns the peer, whether the download was cancelled and an error if
+// no peer could provide the blob.
+func downloadFromPeer(ctx *downloaderContext, st Status,
+	config types.DownloaderConfig, trType zedUpload.SyncTransportType,
+	locFilename string, receiveChan chan<- CancelChannel) (*peerBlob, bool, error) {
+
+	peer, err := findPeerBlob(ctx, config.ImageSha256, config.Size)
+	if err != nil {
+		return nil, false, err
+	}
+	// The media type of a root blob comes from the registry
+	if trType == zedUpload.SyncOCIRegistryTr && peer.contentType == "" {
+		return nil, false, fmt.Errorf("peer %s does not know the media type",
+			peer.serverURL)
+	}
+	log.Noticef("downloadFromPeer(%s): downloading from %s",
+		config.ImageSha256, peer.serverURL)
+	ctx.peerTried.record(config.ImageSha256, peer.serverURL)
+	blobHash := "sha256:" + strings.ToLower(config.ImageSha256)
+	auth := &zedUpload.AuthInput{AuthType: "http"}
+	_, cancelled, err := download(ctx, zedUpload.SyncHttpTr, st,
+		zedUpload.SyncOpDownload, peer.serverURL, auth,
+		strings.Trim(peerBlobsPath, "/"), "", config.Size, peer.ifname,
+		peer.ipSrc, blobHash+"?"+peerTokenArg+"="+peerToken(ctx.peerKey, blobHash),
+		locFilename, nil, receiveChan)
+	if err != nil {
+		return nil, cancelled, fmt.Errorf("download from peer %s failed: %v",
+			peer.serverURL, err)
+	}
+	return peer, false, nil
+}
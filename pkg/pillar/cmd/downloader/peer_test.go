@@ -0,0 +1,312 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grandcat/zeroconf"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	testPeerKey  = "secret"
+	testBlobSha  = "8b0e1d6a1b2bbd0c8b6a0f2a4d3e6e5b2f0a9b3d4c5e6f708192a3b4c5d6e7f8"
+	testBlobHash = "sha256:" + testBlobSha
+	testBlobType = "application/vnd.oci.image.manifest.v1+json"
+)
+
+var testBlob = []byte("the content of the blob")
+
+// fakeCAS implements the parts of cas.CAS used by servePeerBlob
+type fakeCAS struct {
+	cas.CAS
+	blobs      map[string][]byte
+	mediaTypes map[string]string
+}
+
+func (c *fakeCAS) GetBlobInfo(blobHash string) (*cas.BlobInfo, error) {
+	blob, ok := c.blobs[blobHash]
+	if !ok {
+		return nil, fmt.Errorf("no blob %s", blobHash)
+	}
+	return &cas.BlobInfo{Digest: blobHash, Size: int64(len(blob))}, nil
+}
+
+func (c *fakeCAS) ListBlobsMediaTypes() (map[string]string, error) {
+	return c.mediaTypes, nil
+}
+
+func (c *fakeCAS) CtrNewUserServicesCtx() (context.Context, context.CancelFunc) {
+	return context.WithCancel(context.Background())
+}
+
+func (c *fakeCAS) ReadBlob(ctx context.Context, blobHash string) (io.Reader, error) {
+	blob, ok := c.blobs[blobHash]
+	if !ok {
+		return nil, fmt.Errorf("no blob %s", blobHash)
+	}
+	return bytes.NewReader(blob), nil
+}
+
+func testPeerPort(ip string, subnet string) types.NetworkPortStatus {
+	_, ipnet, _ := net.ParseCIDR(subnet)
+	return types.NetworkPortStatus{
+		IfName:       "eth0",
+		IsMgmt:       true,
+		Subnet:       *ipnet,
+		AddrInfoList: []types.AddrInfo{{Addr: net.ParseIP(ip)}},
+	}
+}
+
+func testPeerShare(hasBlob bool) *peerShare {
+	casClient := &fakeCAS{
+		blobs:      map[string][]byte{},
+		mediaTypes: map[string]string{},
+	}
+	if hasBlob {
+		casClient.blobs[testBlobHash] = testBlob
+		casClient.mediaTypes[testBlobHash] = testBlobType
+	}
+	peers := &peerShare{casClient: casClient}
+	peers.setPorts([]types.NetworkPortStatus{
+		testPeerPort("127.0.0.1", "127.0.0.0/8"),
+	}, testPeerKey)
+	return peers
+}
+
+func TestServePeerBlob(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "downloader", 0)
+	token := peerToken(testPeerKey, testBlobHash)
+	testMatrix := map[string]struct {
+		method      string
+		path        string
+		remoteAddr  string
+		hasBlob     bool
+		expCode     int
+		expBody     string
+		expType     string
+		expLength   string
+		noLocalAddr bool
+	}{
+		"get": {
+			method:    http.MethodGet,
+			path:      peerBlobsPath + testBlobHash + "?token=" + token,
+			hasBlob:   true,
+			expCode:   http.StatusOK,
+			expBody:   string(testBlob),
+			expType:   testBlobType,
+			expLength: fmt.Sprintf("%d", len(testBlob)),
+		},
+		"head": {
+			method:    http.MethodHead,
+			path:      peerBlobsPath + testBlobHash + "?token=" + token,
+			hasBlob:   true,
+			expCode:   http.StatusOK,
+			expType:   testBlobType,
+			expLength: fmt.Sprintf("%d", len(testBlob)),
+		},
+		"missing blob": {
+			method:  http.MethodHead,
+			path:    peerBlobsPath + testBlobHash + "?token=" + token,
+			expCode: http.StatusNotFound,
+		},
+		"no token": {
+			method:  http.MethodGet,
+			path:    peerBlobsPath + testBlobHash,
+			hasBlob: true,
+			expCode: http.StatusForbidden,
+		},
+		"token of another blob": {
+			method: http.MethodGet,
+			path: peerBlobsPath + testBlobHash + "?token=" +
+				peerToken(testPeerKey, "sha256:"+strings.Repeat("0", 64)),
+			hasBlob: true,
+			expCode: http.StatusForbidden,
+		},
+		"token with another key": {
+			method: http.MethodGet,
+			path: peerBlobsPath + testBlobHash + "?token=" +
+				peerToken("other", testBlobHash),
+			hasBlob: true,
+			expCode: http.StatusForbidden,
+		},
+		"not from the subnet": {
+			method:     http.MethodGet,
+			path:       peerBlobsPath + testBlobHash + "?token=" + token,
+			remoteAddr: "10.1.0.5:4000",
+			hasBlob:    true,
+			expCode:    http.StatusNotFound,
+		},
+		"not on a management port": {
+			method:      http.MethodGet,
+			path:        peerBlobsPath + testBlobHash + "?token=" + token,
+			hasBlob:     true,
+			noLocalAddr: true,
+			expCode:     http.StatusNotFound,
+		},
+		"bad hash": {
+			method: http.MethodGet,
+			path: peerBlobsPath + "sha256:XYZ?token=" +
+				peerToken(testPeerKey, "sha256:XYZ"),
+			hasBlob: true,
+			expCode: http.StatusBadRequest,
+		},
+		"post": {
+			method:  http.MethodPost,
+			path:    peerBlobsPath + testBlobHash + "?token=" + token,
+			hasBlob: true,
+			expCode: http.StatusMethodNotAllowed,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		peers := testPeerShare(test.hasBlob)
+		r := httptest.NewRequest(test.method, test.path, nil)
+		r.RemoteAddr = "127.0.0.2:4000"
+		if test.remoteAddr != "" {
+			r.RemoteAddr = test.remoteAddr
+		}
+		if !test.noLocalAddr {
+			localAddr := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: peerPort}
+			r = r.WithContext(context.WithValue(r.Context(),
+				http.LocalAddrContextKey, localAddr))
+		}
+		w := httptest.NewRecorder()
+		peers.serveHTTP(w, r)
+		if w.Code != test.expCode {
+			t.Errorf("%s: code %d instead of %d", testname, w.Code, test.expCode)
+			continue
+		}
+		if test.expCode != http.StatusOK {
+			continue
+		}
+		if w.Body.String() != test.expBody {
+			t.Errorf("%s: body %q instead of %q", testname, w.Body.String(),
+				test.expBody)
+		}
+		if got := w.Header().Get("Content-Type"); got != test.expType {
+			t.Errorf("%s: Content-Type %q instead of %q", testname, got,
+				test.expType)
+		}
+		if got := w.Header().Get("Content-Length"); got != test.expLength {
+			t.Errorf("%s: Content-Length %q instead of %q", testname, got,
+				test.expLength)
+		}
+	}
+}
+
+// startTestPeer serves the blobs of a peer on an address on the loopback
+func startTestPeer(hasBlob bool) (*httptest.Server, *zeroconf.ServiceEntry) {
+	peers := testPeerShare(hasBlob)
+	server := httptest.NewServer(http.HandlerFunc(peers.serveHTTP))
+	addr := server.Listener.Addr().(*net.TCPAddr)
+	entry := zeroconf.NewServiceEntry("peer", peerService, peerDomain)
+	entry.AddrIPv4 = []net.IP{addr.IP}
+	entry.Port = addr.Port
+	return server, entry
+}
+
+func TestSelectPeerBlob(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "downloader", 0)
+	withBlob, withBlobEntry := startTestPeer(true)
+	defer withBlob.Close()
+	withoutBlob, withoutBlobEntry := startTestPeer(false)
+	defer withoutBlob.Close()
+	offSubnetEntry := zeroconf.NewServiceEntry("far", peerService, peerDomain)
+	offSubnetEntry.AddrIPv4 = []net.IP{net.ParseIP("10.1.0.5")}
+	offSubnetEntry.Port = peerPort
+
+	ports := []types.NetworkPortStatus{testPeerPort("127.0.0.1", "127.0.0.0/8")}
+	testMatrix := map[string]struct {
+		peers   []*zeroconf.ServiceEntry
+		size    uint64
+		key     string
+		expPeer string
+	}{
+		"peer has the blob": {
+			peers:   []*zeroconf.ServiceEntry{withBlobEntry},
+			size:    uint64(len(testBlob)),
+			key:     testPeerKey,
+			expPeer: withBlob.URL,
+		},
+		"second peer has the blob": {
+			peers:   []*zeroconf.ServiceEntry{withoutBlobEntry, withBlobEntry},
+			key:     testPeerKey,
+			expPeer: withBlob.URL,
+		},
+		"no peer has the blob": {
+			peers: []*zeroconf.ServiceEntry{withoutBlobEntry},
+			key:   testPeerKey,
+		},
+		"peer not on the subnet": {
+			peers: []*zeroconf.ServiceEntry{offSubnetEntry},
+			key:   testPeerKey,
+		},
+		"wrong size": {
+			peers: []*zeroconf.ServiceEntry{withBlobEntry},
+			size:  uint64(len(testBlob)) + 1,
+			key:   testPeerKey,
+		},
+		"wrong key": {
+			peers: []*zeroconf.ServiceEntry{withBlobEntry},
+			key:   "other",
+		},
+		"no peers": {
+			key: testPeerKey,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		peer, err := selectPeerBlob(ports, test.peers, strings.ToUpper(testBlobSha),
+			test.size, test.key)
+		if test.expPeer == "" {
+			if err == nil {
+				t.Errorf("%s: selected %s", testname, peer.serverURL)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", testname, err)
+			continue
+		}
+		if peer.serverURL != test.expPeer {
+			t.Errorf("%s: selected %s instead of %s", testname,
+				peer.serverURL, test.expPeer)
+		}
+		if peer.contentType != testBlobType {
+			t.Errorf("%s: content type %s", testname, peer.contentType)
+		}
+		if peer.ifname != "eth0" || !peer.ipSrc.Equal(net.ParseIP("127.0.0.1")) {
+			t.Errorf("%s: source %s %v", testname, peer.ifname, peer.ipSrc)
+		}
+	}
+}
+
+func TestPeerAttempts(t *testing.T) {
+	var attempts peerAttempts
+	if _, tried := attempts.tried(testBlobSha); tried {
+		t.Errorf("blob tried before any download")
+	}
+	attempts.record(strings.ToUpper(testBlobSha), "http://127.0.0.1:8085")
+	peerURL, tried := attempts.tried(testBlobSha)
+	if !tried || peerURL != "http://127.0.0.1:8085" {
+		t.Errorf("blob not tried after a download from a peer: %v %s",
+			tried, peerURL)
+	}
+	if _, tried := attempts.tried(strings.Repeat("0", 64)); tried {
+		t.Errorf("another blob tried")
+	}
+}
@@ -4,6 +4,8 @@
 package downloader
 
 import (
+	"reflect"
+
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
@@ -11,6 +13,9 @@ import (
 type Status interface {
 	// Progress report progress; returns false if no change
 	Progress(uint, int64, int64) bool
+	// PartsProgress report progress of the parts of a ranged download;
+	// returns false if no change
+	PartsProgress([]types.DownloadPartStatus) bool
 }
 
 // PublishStatus practical implementation of Status
@@ -34,3 +39,14 @@ func (d *PublishStatus) Progress(p uint, currentSize, totalSize int64) bool {
 	publishDownloaderStatus(d.ctx, d.status)
 	return true
 }
+
+// PartsProgress report the progress of the parts of a ranged download
+// Returns true if there was a change to the recorded values
+func (d *PublishStatus) PartsProgress(parts []types.DownloadPartStatus) bool {
+	if reflect.DeepEqual(d.status.Parts, parts) {
+		return false
+	}
+	d.status.Parts = parts
+	publishDownloaderStatus(d.ctx, d.status)
+	return true
+}
@@ -171,6 +171,38 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		return
 	}
 
+	// try a peer on the local network before the datastore unless the
+	// blob was already downloaded from one
+	peerURL, peerTried := ctx.peerTried.tried(config.ImageSha256)
+	if peerTried {
+		log.Noticef("Not downloading %s from a peer again after %s",
+			config.ImageSha256, peerURL)
+	}
+	if ctx.peerSharing && ctx.peerKey != "" && config.ImageSha256 != "" &&
+		!peerTried {
+		st := &PublishStatus{
+			ctx:    ctx,
+			status: status,
+		}
+		downloadStartTime := time.Now()
+		peer, cancelled, err := downloadFromPeer(ctx, st, config,
+			trType, locFilename, receiveChan)
+		if err == nil {
+			handleSyncOpSuccess(ctx, config, status, st, locFilename, key,
+				peer.contentType, peer.ifname, peer.serverURL,
+				downloadStartTime, cleanOnError)
+			return
+		}
+		if cancelled {
+			log.Errorf("download %s from peer cancelled", config.ImageSha256)
+			handleSyncOpResponse(ctx, config, status, locFilename,
+				key, "download cancelled by user", cancelled, cleanOnError)
+			return
+		}
+		log.Functionf("Not downloading %s from a peer: %v",
+			config.ImageSha256, err)
+	}
+
 	// if the server URL ends with '.local', it is considered to be local data store
 	dsLocal := strings.HasSuffix(serverURL, ".local") || strings.HasSuffix(serverURL, ".local.")
 	if dsLocal {
@@ -240,25 +272,8 @@ func handleSyncOp(ctx *downloaderContext, key string,
 			}
 			continue
 		}
-		// Record how much we downloaded
-		size := int64(0)
-		info, err := os.Stat(locFilename)
-		if err != nil {
-			log.Error(err)
-		} else {
-			size = info.Size()
-		}
-		downloadTime := int64(time.Since(downloadStartTime) / time.Millisecond)
-		status.Size = uint64(size)
-		status.ContentType = contentType
-		ctx.zedcloudMetrics.RecordSuccess(log, ifname,
-			metricsURL, 1024, size, downloadTime, false)
-		if st.Progress(100, size, size) {
-			log.Noticef("updated sizes at end to %d/%d",
-				size, size)
-		}
-		handleSyncOpResponse(ctx, config, status,
-			locFilename, key, "", cancelled, cleanOnError)
+		handleSyncOpSuccess(ctx, config, status, st, locFilename, key,
+			contentType, ifname, metricsURL, downloadStartTime, cleanOnError)
 		return
 
 	}
@@ -270,6 +285,34 @@ func handleSyncOp(ctx *downloaderContext, key string,
 		key, errStr, cancelled, cleanOnError)
 }
 
+// handleSyncOpSuccess records the metrics and the size of a completed
+// download and publishes the status
+func handleSyncOpSuccess(ctx *downloaderContext, config types.DownloaderConfig,
+	status *types.DownloaderStatus, st *PublishStatus, locFilename, key,
+	contentType, ifname, metricsURL string, downloadStartTime time.Time,
+	cleanOnError bool) {
+
+	// Record how much we downloaded
+	size := int64(0)
+	info, err := os.Stat(locFilename)
+	if err != nil {
+		log.Error(err)
+	} else {
+		size = info.Size()
+	}
+	downloadTime := int64(time.Since(downloadStartTime) / time.Millisecond)
+	status.Size = uint64(size)
+	status.ContentType = contentType
+	ctx.zedcloudMetrics.RecordSuccess(log, ifname,
+		metricsURL, 1024, size, downloadTime, false)
+	if st.Progress(100, size, size) {
+		log.Noticef("updated sizes at end to %d/%d",
+			size, size)
+	}
+	handleSyncOpResponse(ctx, config, status,
+		locFilename, key, "", false, cleanOnError)
+}
+
 // DownloadURL format : http://<serverURL>/dpath/filename
 func getServerURL(dsCtx *types.DatastoreContext) (string, error) {
 	u, err := url.Parse(dsCtx.DownloadURL)
@@ -328,6 +371,7 @@ func handleSyncOpResponse(ctx *downloaderContext, config types.DownloaderConfig,
 	status.ModTime = time.Now()
 	status.State = types.DOWNLOADED
 	status.Progress = 100 // Just in case
+	status.Parts = nil
 	status.ClearPendingStatus()
 	publishDownloaderStatus(ctx, status)
 }
@@ -12,6 +12,7 @@ import (
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/faultinject"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -31,6 +32,13 @@ type faultContext struct {
 	subGlobalConfig pubsub.Subscription
 	GCInitialized   bool
 
+	// Scenario from FaultInjectionScenarioFile when the
+	// debug.enable.fault.injection global setting is set
+	scenarioEnabled bool
+	scenarioModTime time.Time
+	scenario        *faultinject.Scenario
+	filler          *filler
+
 	// CLI args
 	debug         bool
 	debugOverride bool // From command line arg
@@ -116,6 +124,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			subGlobalConfig.ProcessChange(change)
 
 		case <-stillRunning.C:
+			updateScenario(&ctx)
 			// Fault injection
 			if fatalFlag {
 				log.Fatal("Requested fault injection to cause watchdog")
@@ -162,7 +171,9 @@ func handleGlobalConfigImpl(ctxArg interface{}, key string,
 	ctx.debug, gcp = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
 		ctx.debugOverride, logger)
 	if gcp != nil {
+		ctx.scenarioEnabled = gcp.GlobalValueBool(types.FaultInjection)
 		ctx.GCInitialized = true
+		updateScenario(ctx)
 	}
 	log.Functionf("handleGlobalConfigImpl done for %s", key)
 }
@@ -178,5 +189,7 @@ func handleGlobalConfigDelete(ctxArg interface{}, key string,
 	log.Functionf("handleGlobalConfigDelete for %s", key)
 	ctx.debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
 		ctx.debugOverride, logger)
+	ctx.scenarioEnabled = false
+	updateScenario(ctx)
 	log.Functionf("handleGlobalConfigDelete done for %s", key)
 }
@@ -0,0 +1,119 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinjection
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/faultinject"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// fillFile is grown to fill /persist
+var fillFile = filepath.Join(types.FaultInjectionDir, "fill")
+
+// filler grows fillFile in the background since filling /persist can take
+// longer than the watchdog allows us to not report that we are running
+type filler struct {
+	stop chan struct{}
+	done chan struct{}
+}
+
+func startFiller(percent uint8) *filler {
+	f := &filler{
+		stop: make(chan struct{}),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(f.done)
+		written, err := faultinject.FillDisk(fillFile, percent, f.stop)
+		if err != nil {
+			log.Errorf("filler: %v", err)
+		} else if written != 0 {
+			log.Warnf("filler: wrote %d bytes to %s to fill %d%% of %s",
+				written, fillFile, percent, types.PersistDir)
+		}
+	}()
+	return f
+}
+
+func (f *filler) running() bool {
+	select {
+	case <-f.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// cancel stops the filler and waits for it, which takes at most one write
+func (f *filler) cancel() {
+	close(f.stop)
+	<-f.done
+}
+
+// updateScenario (re)loads the scenario file when it changes and applies
+// the persist fault. Everything is undone when the scenario is disabled
+// or the file is removed.
+func updateScenario(ctx *faultContext) {
+	if !ctx.scenarioEnabled {
+		clearScenario(ctx)
+		return
+	}
+	st, err := os.Stat(types.FaultInjectionScenarioFile)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Errorf("updateScenario: %v", err)
+		}
+		clearScenario(ctx)
+		return
+	}
+	if ctx.scenario == nil || !st.ModTime().Equal(ctx.scenarioModTime) {
+		scenario, err := faultinject.LoadScenario(types.FaultInjectionScenarioFile)
+		if err != nil {
+			log.Errorf("updateScenario: %s: %v",
+				types.FaultInjectionScenarioFile, err)
+			clearScenario(ctx)
+			return
+		}
+		log.Warnf("updateScenario: injecting faults from %s: %+v",
+			types.FaultInjectionScenarioFile, *scenario)
+		ctx.scenario = scenario
+		ctx.scenarioModTime = st.ModTime()
+		faultinject.SetScenario(scenario)
+	}
+	if ctx.scenario.Persist == nil || ctx.scenario.Persist.FillPercent == 0 {
+		removeFillFile(ctx)
+		return
+	}
+	// Start again once the previous fill is done, in case the percentage
+	// changed or space was freed
+	if ctx.filler == nil || !ctx.filler.running() {
+		ctx.filler = startFiller(ctx.scenario.Persist.FillPercent)
+	}
+}
+
+func clearScenario(ctx *faultContext) {
+	if ctx.scenario != nil {
+		log.Warnf("clearScenario: no longer injecting faults")
+		faultinject.SetScenario(nil)
+	}
+	ctx.scenario = nil
+	ctx.scenarioModTime = time.Time{}
+	removeFillFile(ctx)
+}
+
+func removeFillFile(ctx *faultContext) {
+	if ctx.filler != nil {
+		ctx.filler.cancel()
+		ctx.filler = nil
+	}
+	if err := os.Remove(fillFile); err == nil {
+		log.Noticef("removeFillFile: removed %s", fillFile)
+	} else if !os.IsNotExist(err) {
+		log.Errorf("removeFillFile: %v", err)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Query the device and app logs kept by newlogd in /persist/newlog.
+// Usage:
+//	logquery [-s start] [-e end] [-a agent,...] [-l level] [-A app-uuid|all]
+//	         [-r regex] [-n count] [-j] [-f]
+// start and end are RFC3339 times or durations before now, e.g. 2h.
+// The query is sent to newlogd unless -f is given, in which case the
+// files are read directly.
+
+package logquery
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	query "github.com/lf-edge/eve/pkg/pillar/logquery"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+const agentName = "logquery"
+
+var logger *logrus.Logger
+var log *base.LogObject
+
+// Run is the main aka only entrypoint
+func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) int {
+	logger = loggerArg
+	log = logArg
+	startPtr := flag.String("s", "", "Start time (RFC3339) or duration before now")
+	endPtr := flag.String("e", "", "End time (RFC3339) or duration before now")
+	sourcePtr := flag.String("a", "", "Comma-separated source agents")
+	levelPtr := flag.String("l", "", "Least severe level, e.g. warning")
+	appPtr := flag.String("A", "", "App UUID, or all, instead of the device logs")
+	regexPtr := flag.String("r", "", "Regular expression for the content")
+	limitPtr := flag.Int("n", 0, "Maximum number of entries")
+	jsonPtr := flag.Bool("j", false, "Print the entries as JSON")
+	filesPtr := flag.Bool("f", false, "Read the files instead of asking newlogd")
+	socketPtr := flag.String("socket", query.SocketPath, "newlogd query socket")
+	flag.Parse()
+
+	q := query.Query{
+		Level:   *levelPtr,
+		AppUUID: *appPtr,
+		Regex:   *regexPtr,
+		Limit:   *limitPtr,
+	}
+	var err error
+	now := time.Now()
+	if q.Start, err = parseTime(*startPtr, now); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: bad start: %v\n", agentName, err)
+		return 1
+	}
+	if q.End, err = parseTime(*endPtr, now); err != nil {
+		fmt.Fprintf(os.Stderr, "%s: bad end: %v\n", agentName, err)
+		return 1
+	}
+	if *sourcePtr != "" {
+		q.Sources = strings.Split(*sourcePtr, ",")
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	output := func(r query.Result) error {
+		if *jsonPtr {
+			return enc.Encode(r)
+		}
+		_, err := fmt.Println(formatResult(r))
+		return err
+	}
+	if *filesPtr {
+		err = query.Run(q, types.NewlogDir, output)
+	} else {
+		err = query.Send(*socketPtr, q, output)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", agentName, err)
+		return 1
+	}
+	return 0
+}
+
+// parseTime accepts RFC3339 or a duration before now
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+func formatResult(r query.Result) string {
+	entry := r.Entry
+	var t time.Time
+	if entry.Timestamp != nil {
+		t = time.Unix(entry.Timestamp.Seconds, int64(entry.Timestamp.Nanos)).UTC()
+	}
+	app := ""
+	if r.AppUUID != "" {
+		app = " " + r.AppUUID
+	}
+	return fmt.Sprintf("%s %s %s%s: %s",
+		t.Format("2006-01-02T15:04:05.000000Z07:00"), entry.Severity,
+		entry.Source, app, entry.Content)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Dump or replay the pubsub journal recorded by zedbox when
+// /persist/pubsub-journal exists. Only the topics in
+// journaldriver.DefaultTopics are recorded.
+// Usage:
+//	pubsubjournal [-j dir] [-a agent] [-t topic] dump
+//	pubsubjournal [-j dir] [-a agent] [-t topic] [-r rootdir] [-s speed] [-w] replay
+// replay publishes the recorded events using the socket driver under
+// rootdir so that test agents/subscribers using the same rootdir see them.
+
+package pubsubjournal
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/journaldriver"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+const agentName = "pubsubjournal"
+
+var logger *logrus.Logger
+var log *base.LogObject
+
+// Run is the main aka only entrypoint
+func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) int {
+	logger = loggerArg
+	log = logArg
+	debugPtr := flag.Bool("d", false, "Debug flag")
+	journalPtr := flag.String("j", types.PubsubJournalDir, "Journal directory")
+	agentPtr := flag.String("a", "", "Only events published by agent")
+	topicPtr := flag.String("t", "", "Only events for topic")
+	rootPtr := flag.String("r", "/tmp/pubsub-replay", "Root directory for replay")
+	speedPtr := flag.Float64("s", 0, "Replay speed relative to recording; 0 is no delays")
+	waitPtr := flag.Bool("w", false, "Keep publishing after replay until interrupted")
+	flag.Parse()
+	if *debugPtr {
+		logger.SetLevel(logrus.TraceLevel)
+	} else {
+		logger.SetLevel(logrus.InfoLevel)
+	}
+	args := flag.Args()
+	if len(args) != 1 {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] dump|replay\n", agentName)
+		flag.PrintDefaults()
+		return 1
+	}
+	files, err := journaldriver.Files(*journalPtr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "No journal in %s: %v\n", *journalPtr, err)
+		return 1
+	}
+	filter := func(event journaldriver.Event) bool {
+		if *agentPtr != "" && event.Agent != *agentPtr {
+			return false
+		}
+		if *topicPtr != "" && event.Topic != *topicPtr {
+			return false
+		}
+		return true
+	}
+	switch args[0] {
+	case "dump":
+		err = journaldriver.ReadFiles(files, func(event journaldriver.Event) error {
+			if !filter(event) {
+				return nil
+			}
+			fmt.Printf("%s %s %s %s %s %s\n",
+				event.Time.Format("2006-01-02T15:04:05.000000Z07:00"),
+				event.Op, event.Name, event.Key, event.Value,
+				restartString(event))
+			return nil
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "dump failed: %v\n", err)
+			return 1
+		}
+	case "replay":
+		replayPs := pubsub.New(
+			&socketdriver.SocketDriver{
+				Logger:  logger,
+				Log:     log,
+				RootDir: *rootPtr,
+			},
+			logger, log)
+		replayer := journaldriver.NewReplayer(replayPs)
+		count, err := replayer.Replay(files, journaldriver.ReplayOptions{
+			Speed:  *speedPtr,
+			Filter: filter,
+		})
+		fmt.Printf("Replayed %d events into %s\n", count, *rootPtr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "replay failed: %v\n", err)
+			replayer.Close()
+			return 1
+		}
+		if *waitPtr {
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+			<-sigs
+		}
+		replayer.Close()
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %s\n", args[0])
+		return 1
+	}
+	return 0
+}
+
+func restartString(event journaldriver.Event) string {
+	if event.Op != journaldriver.OpRestart {
+		return ""
+	}
+	return fmt.Sprintf("%d", event.RestartCounter)
+}
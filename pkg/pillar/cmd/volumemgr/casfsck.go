@@ -0,0 +1,224 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+// Periodically check the CAS for orphaned and corrupt content.
+// The check runs in the background worker. Orphans are only removed if
+// they were also orphaned in the previous check to avoid racing with
+// content which is being added. Corrupt blobs which are in use are
+// downloaded again.
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	zconfig "github.com/lf-edge/eve/api/go/config"
+	"github.com/lf-edge/eve/pkg/pillar/cas"
+	"github.com/lf-edge/eve/pkg/pillar/containerd"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/worker"
+)
+
+const (
+	workCASFsck = "casfsck"
+	casFsckKey  = "casfsck"
+)
+
+// casFsckWorkDescription the check we feed into the worker go routine
+type casFsckWorkDescription struct {
+	opts cas.FsckOptions
+	// used for results
+	report *cas.FsckReport
+}
+
+// updateCASFsckTicker (re)starts the ticker; zero interval stops it
+func updateCASFsckTicker(ctx *volumemgrContext, interval uint32) {
+	if ctx.casFsckTicker != nil {
+		ctx.casFsckTicker.Stop()
+	}
+	if interval == 0 {
+		ctx.casFsckTicker = nil
+		ctx.casFsckChan = nil
+		return
+	}
+	ctx.casFsckTicker = time.NewTicker(time.Duration(interval) * time.Second)
+	ctx.casFsckChan = ctx.casFsckTicker.C
+}
+
+// startCASFsck submits a check unless one is already running or we do
+// not yet know which content is in use
+func startCASFsck(ctx *volumemgrContext) {
+	if !ctx.usingConfig {
+		log.Functionf("startCASFsck: not yet using config")
+		return
+	}
+	if ctx.casFsckRunning {
+		log.Warnf("startCASFsck: previous check still running")
+		return
+	}
+	var roots, snapshots []string
+	for _, item := range ctx.pubBlobStatus.GetAll() {
+		blob := item.(types.BlobStatus)
+		roots = append(roots, checkAndCorrectBlobHash(blob.Sha256))
+	}
+	for _, status := range getAllContentTreeStatus(ctx) {
+		for _, blob := range status.Blobs {
+			roots = append(roots, checkAndCorrectBlobHash(blob))
+		}
+	}
+	for _, item := range ctx.pubVolumeStatus.GetAll() {
+		status := item.(types.VolumeStatus)
+		if status.ContentFormat == zconfig.Format_CONTAINER &&
+			status.FileLocation != "" {
+			snapshots = append(snapshots,
+				containerd.GetSnapshotID(status.FileLocation))
+		}
+	}
+	prevOrphans := ctx.casFsckOrphans
+	d := casFsckWorkDescription{
+		opts: cas.FsckOptions{
+			Roots:     roots,
+			Snapshots: snapshots,
+			Verify:    ctx.globalConfig.GlobalValueBool(types.CASFsckVerify),
+			Remove:    ctx.globalConfig.GlobalValueBool(types.CASFsckRemove),
+			CanRemove: func(id string) bool { return prevOrphans[id] },
+		},
+	}
+	w := worker.Work{Kind: workCASFsck, Key: casFsckKey, Description: d}
+	done, err := ctx.worker.TrySubmit(w)
+	if err != nil {
+		log.Errorf("TrySubmit %s failed: %s", casFsckKey, err)
+	} else if !done {
+		log.Errorf("Failed to submit work due to queue length for %s",
+			casFsckKey)
+	} else {
+		ctx.casFsckRunning = true
+	}
+}
+
+// casFsckWorker implementation of work.WorkFunction that checks the CAS
+func casFsckWorker(ctxPtr interface{}, w worker.Work) worker.WorkResult {
+	ctx := ctxPtr.(*volumemgrContext)
+	d := w.Description.(casFsckWorkDescription)
+	report, err := cas.Fsck(ctx.casClient, d.opts)
+	d.report = report
+	result := worker.WorkResult{
+		Key:         w.Key,
+		Description: d,
+	}
+	if err != nil {
+		result.Error = err
+		result.ErrorTime = time.Now()
+	}
+	return result
+}
+
+// processCASFsckResult updates and publishes the metrics
+func processCASFsckResult(ctxPtr interface{}, res worker.WorkResult) error {
+	ctx := ctxPtr.(*volumemgrContext)
+	ctx.worker.Pop(res.Key)
+	ctx.worker.Cancel(res.Key)
+	ctx.casFsckRunning = false
+	d := res.Description.(casFsckWorkDescription)
+	report := d.report
+
+	metrics := &ctx.casFsckMetrics
+	metrics.RunCount++
+	metrics.LastRun = report.StartTime
+	metrics.LastDuration = report.Duration
+	if res.Error != nil {
+		log.Errorf("CAS fsck failed: %v", res.Error)
+		metrics.FailureCount++
+		metrics.LastError = res.Error.Error()
+		ctx.pubCASFsckMetrics.Publish(metrics.Key(), *metrics)
+		return nil
+	}
+	log.Noticef("CAS fsck: %s", report)
+	for _, errStr := range report.Errors {
+		log.Warnf("CAS fsck: %s", errStr)
+	}
+	for _, hash := range report.CorruptBlobs {
+		log.Errorf("CAS fsck: blob %s is corrupt", hash)
+	}
+	metrics.LastError = ""
+	metrics.LastBlobs = uint64(report.Blobs)
+	metrics.LastBlobBytes = uint64(report.BlobBytes)
+	metrics.LastMissingBlobs = uint64(len(report.MissingBlobs))
+	metrics.LastCorruptBlobs = uint64(len(report.CorruptBlobs))
+	metrics.LastOrphanBlobs = uint64(len(report.OrphanBlobs))
+	metrics.LastOrphanBytes = uint64(report.OrphanBytes)
+	metrics.LastOrphanSnapshots = uint64(len(report.OrphanSnapshots))
+	metrics.CorruptBlobs += uint64(len(report.CorruptBlobs))
+	metrics.RemovedBlobs += uint64(len(report.RemovedBlobs))
+	metrics.RemovedBytes += uint64(report.RemovedBytes)
+	metrics.RemovedSnapshots += uint64(len(report.RemovedSnaps))
+	ctx.pubCASFsckMetrics.Publish(metrics.Key(), *metrics)
+
+	// Remember the orphans which are still around for the next check
+	removed := make(map[string]bool)
+	for _, ids := range [][]string{report.RemovedBlobs, report.RemovedSnaps} {
+		for _, id := range ids {
+			removed[id] = true
+		}
+	}
+	if d.opts.Remove {
+		for _, hash := range report.CorruptBlobs {
+			if !removed[hash] {
+				downloadCorruptBlob(ctx, hash)
+			}
+		}
+	}
+	ctx.casFsckOrphans = make(map[string]bool)
+	for _, ids := range [][]string{report.OrphanBlobs, report.OrphanSnapshots} {
+		for _, id := range ids {
+			if !removed[id] {
+				ctx.casFsckOrphans[id] = true
+			}
+		}
+	}
+	return nil
+}
+
+// downloadCorruptBlob sets an error on the BlobStatus of a corrupt blob
+// which is in use and downloads it again through the content trees which
+// use it. The blob is removed from the CAS first since the CAS would keep
+// the corrupt content when the blob is loaded again.
+func downloadCorruptBlob(ctx *volumemgrContext, hash string) {
+	blob := lookupBlobStatus(ctx, strings.TrimPrefix(hash, "sha256:"))
+	if blob == nil || blob.State != types.LOADED {
+		log.Warnf("downloadCorruptBlob(%s): no loaded BlobStatus", hash)
+		return
+	}
+	log.Noticef("downloadCorruptBlob(%s): downloading again", hash)
+	if err := ctx.casClient.RemoveBlob(hash); err != nil {
+		log.Errorf("downloadCorruptBlob(%s): %v", hash, err)
+		return
+	}
+	// The error is cleared by downloadBlob once the new download succeeds
+	errStr := fmt.Sprintf("blob %s is corrupt in the content store; downloading it again",
+		hash)
+	blob.SetErrorWithSource(errStr, types.DownloaderStatus{}, time.Now())
+	blob.State = types.INITIAL
+	blob.HasDownloaderRef = false
+	blob.HasVerifierRef = false
+	blob.Path = ""
+	blob.CurrentSize = 0
+	blob.Progress = 0
+	publishBlobStatus(ctx, blob)
+	// Make the content trees look at their blobs again
+	for _, status := range getAllContentTreeStatus(ctx) {
+		if status.State < types.VERIFIED {
+			continue
+		}
+		for _, sha := range status.Blobs {
+			if sha == blob.Sha256 {
+				status.State = types.DOWNLOADING
+				publishContentTreeStatus(ctx, status)
+				break
+			}
+		}
+	}
+	updateStatusByBlob(ctx, blob.Sha256)
+}
@@ -251,6 +251,12 @@ func destroyVdiskVolume(ctx *volumemgrContext, status types.VolumeStatus) (bool,
 		}
 		//Assume this is zfs device
 		zVolName := status.ZVolName()
+		if err := destroyVolumeSnapshots(zVolName); err != nil {
+			errStr := fmt.Sprintf("Error destroying zfs zvol at %s, error=%v",
+				zVolName, err)
+			log.Error(errStr)
+			return created, "", errors.New(errStr)
+		}
 		if stdoutStderr, err := zfs.DestroyDataset(log, zVolName); err != nil {
 			errStr := fmt.Sprintf("Error destroying zfs zvol at %s, error=%v, output=%s",
 				zVolName, err, stdoutStderr)
@@ -64,6 +64,7 @@ func handleVolumeModify(ctxArg interface{}, key string,
 			status.LastRefCountChangeTime = time.Now()
 		}
 		updateVolumeStatusRefCount(ctx, status)
+		updateVolumeSnapshots(ctx, status)
 		publishVolumeStatus(ctx, status)
 		updateVolumeRefStatus(ctx, status)
 		if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
@@ -114,6 +115,9 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 		LastRefCountChangeTime:  time.Now(),
 		LastUse:                 time.Now(),
 		State:                   types.INITIAL,
+		SourceVolumeID:          config.SourceVolumeID,
+		SourceSnapshot:          config.SourceSnapshot,
+		RollbackCounter:         config.RollbackCounter,
 	}
 	updateVolumeStatusRefCount(ctx, status)
 	status.ContentFormat = volumeFormat[status.Key()]
@@ -181,6 +185,7 @@ func handleDeferredVolumeCreate(ctx *volumemgrContext, key string, config *types
 			status.CurrentSize = int64(actualSize)
 		}
 		updateStatusByPersistType(status, persistFsType)
+		updateVolumeSnapshots(ctx, status)
 		publishVolumeStatus(ctx, status)
 		updateVolumeRefStatus(ctx, status)
 		if err := createOrUpdateAppDiskMetrics(ctx, status); err != nil {
@@ -414,6 +419,15 @@ func quantifyChanges(config types.VolumeConfig,
 		needRegeneration = true
 		regenerationReason += str + "\n"
 	}
+	if config.SourceVolumeID != status.SourceVolumeID ||
+		config.SourceSnapshot != status.SourceSnapshot {
+		str := fmt.Sprintf("Clone source changed from %s@%s to %s@%s for %s",
+			status.SourceVolumeID, status.SourceSnapshot,
+			config.SourceVolumeID, config.SourceSnapshot, config.DisplayName)
+		log.Functionf(str)
+		needRegeneration = true
+		regenerationReason += str + "\n"
+	}
 
 	log.Functionf("quantifyChanges for %s %s returns %v, %s",
 		config.Key(), config.DisplayName, needRegeneration, regenerationReason)
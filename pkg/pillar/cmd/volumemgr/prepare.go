@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/lf-edge/edge-containers/pkg/registry"
+	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/cas"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
@@ -57,6 +58,9 @@ func getVolumeFilePath(ctx *volumemgrContext, status types.VolumeStatus) (string
 }
 
 func prepareZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
+	if status.VolumeContentOriginType == zconfig.VolumeContentOriginType_VCOT_CLONE {
+		return cloneZVol(ctx, status)
+	}
 	size := status.MaxVolSize
 	if status.ReferenceName != "" {
 		pathToFile, err := getVolumeFilePath(ctx, status)
@@ -84,6 +88,26 @@ func prepareZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
 	return nil
 }
 
+// cloneZVol creates the zvol as a clone of the snapshot of the source volume
+func cloneZVol(ctx *volumemgrContext, status types.VolumeStatus) error {
+	source := lookupCreatedVolumeStatusByUUID(ctx, status.SourceVolumeID.String())
+	if source == nil {
+		errStr := fmt.Sprintf("Error cloning zfs zvol for %s: source volume %s not found",
+			status.Key(), status.SourceVolumeID)
+		log.Error(errStr)
+		return errors.New(errStr)
+	}
+	zVolName := status.ZVolName()
+	if stdoutStderr, err := zfs.CloneSnapshot(log, source.ZVolName(),
+		status.SourceSnapshot, zVolName); err != nil {
+		errStr := fmt.Sprintf("Error cloning zfs zvol %s@%s to %s, error=%v, output=%s",
+			source.ZVolName(), status.SourceSnapshot, zVolName, err, stdoutStderr)
+		log.Error(errStr)
+		return errors.New(errStr)
+	}
+	return nil
+}
+
 func prepareVolume(ctx *volumemgrContext, status types.VolumeStatus) error {
 	log.Tracef("prepareVolume: %s", status.Key())
 	if ctx.persistType != types.PersistZFS || status.IsContainer() {
@@ -0,0 +1,302 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// supportsSnapshots returns true if the volume is a zvol
+func supportsSnapshots(ctx *volumemgrContext, status *types.VolumeStatus) bool {
+	return ctx.persistType == types.PersistZFS && !status.IsContainer()
+}
+
+// updateVolumeSnapshots destroys the snapshots of the volume which are no
+// longer in the config, applies a requested rollback, and creates the
+// new snapshots within the retention limits. Snapshots are only handled
+// once the volume is created. Returns true if the status changed.
+func updateVolumeSnapshots(ctx *volumemgrContext, status *types.VolumeStatus) bool {
+
+	if status.State != types.CREATED_VOLUME {
+		return false
+	}
+	config := lookupVolumeConfig(ctx, status.Key())
+	if config == nil {
+		log.Functionf("updateVolumeSnapshots(%s): no config", status.Key())
+		return false
+	}
+	oldSnapshots := status.Snapshots
+	oldRollbackCounter := status.RollbackCounter
+	oldError := status.ErrorDescription
+	if !supportsSnapshots(ctx, status) {
+		var snapshots []types.VolumeSnapshotStatus
+		for _, name := range config.Snapshots {
+			snapshot := types.VolumeSnapshotStatus{Name: name}
+			snapshot.ErrorAndTime = snapshotError(oldSnapshots, name,
+				"snapshots are only supported for zvols")
+			snapshots = append(snapshots, snapshot)
+		}
+		status.Snapshots = snapshots
+		if config.RollbackCounter != status.RollbackCounter {
+			status.RollbackCounter = config.RollbackCounter
+			errStr := fmt.Sprintf("cannot roll back volume %s to %s: snapshots are only supported for zvols",
+				status.DisplayName, config.RollbackSnapshot)
+			log.Error(errStr)
+			status.SetErrorWithSource(errStr, types.VolumeSnapshotStatus{},
+				time.Now())
+		}
+		return !reflect.DeepEqual(oldSnapshots, status.Snapshots) ||
+			oldRollbackCounter != status.RollbackCounter ||
+			!reflect.DeepEqual(oldError, status.ErrorDescription)
+	}
+	dataset := status.ZVolName()
+	existing, err := zfs.ListSnapshots(log, dataset)
+	if err != nil {
+		log.Errorf("updateVolumeSnapshots(%s): %v", status.Key(), err)
+		return false
+	}
+	wanted := make(map[string]bool)
+	for _, name := range config.Snapshots {
+		wanted[name] = true
+	}
+	// Errors from destroying are only logged; the snapshot is reported
+	// until it is gone.
+	for _, snapshot := range existing {
+		if wanted[snapshot.Name] {
+			continue
+		}
+		log.Noticef("updateVolumeSnapshots(%s): destroying snapshot %s",
+			status.Key(), snapshot.Name)
+		if output, err := zfs.DestroySnapshot(log, dataset, snapshot.Name); err != nil {
+			log.Errorf("updateVolumeSnapshots(%s): destroy snapshot %s failed: %v, output=%s",
+				status.Key(), snapshot.Name, err, output)
+		}
+	}
+	if config.RollbackCounter != status.RollbackCounter {
+		status.RollbackCounter = config.RollbackCounter
+		log.Noticef("updateVolumeSnapshots(%s): rolling back to snapshot %s",
+			status.Key(), config.RollbackSnapshot)
+		if err := rollbackVolumeSnapshot(ctx, status,
+			config.RollbackSnapshot, wanted); err != nil {
+			errStr := fmt.Sprintf("cannot roll back volume %s to %s: %v",
+				status.DisplayName, config.RollbackSnapshot, err)
+			log.Error(errStr)
+			status.SetErrorWithSource(errStr, types.VolumeSnapshotStatus{},
+				time.Now())
+		} else if status.IsErrorSource(types.VolumeSnapshotStatus{}) {
+			log.Functionf("updateVolumeSnapshots: Clearing volume error %s",
+				status.Error)
+			status.ClearErrorWithSource()
+		}
+	}
+	existing, err = zfs.ListSnapshots(log, dataset)
+	if err != nil {
+		log.Errorf("updateVolumeSnapshots(%s): %v", status.Key(), err)
+		return oldRollbackCounter != status.RollbackCounter ||
+			!reflect.DeepEqual(oldError, status.ErrorDescription)
+	}
+	var snapshots []types.VolumeSnapshotStatus
+	for _, name := range config.Snapshots {
+		snapshot := types.VolumeSnapshotStatus{Name: name}
+		if info := findSnapshot(existing, name); info != nil {
+			snapshot.CreateTime = info.CreateTime
+			snapshot.UsedBytes = info.Used
+			snapshot.ReferencedBytes = info.Referenced
+			snapshots = append(snapshots, snapshot)
+			continue
+		}
+		if errStr := createVolumeSnapshot(ctx, dataset, name, len(existing)); errStr != "" {
+			log.Errorf("updateVolumeSnapshots(%s): %s", status.Key(), errStr)
+			snapshot.ErrorAndTime = snapshotError(oldSnapshots, name, errStr)
+			snapshots = append(snapshots, snapshot)
+			continue
+		}
+		log.Noticef("updateVolumeSnapshots(%s): created snapshot %s",
+			status.Key(), name)
+		existing, err = zfs.ListSnapshots(log, dataset)
+		if err != nil {
+			log.Errorf("updateVolumeSnapshots(%s): %v", status.Key(), err)
+		} else if info := findSnapshot(existing, name); info != nil {
+			snapshot.CreateTime = info.CreateTime
+			snapshot.UsedBytes = info.Used
+			snapshot.ReferencedBytes = info.Referenced
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	status.Snapshots = snapshots
+	return !reflect.DeepEqual(oldSnapshots, status.Snapshots) ||
+		oldRollbackCounter != status.RollbackCounter ||
+		!reflect.DeepEqual(oldError, status.ErrorDescription)
+}
+
+// rollbackVolumeSnapshot rolls the zvol back to the snapshot. The domain
+// using the zvol must not be running since its disk would change under it.
+// The rollback destroys the snapshots taken after the one rolled back to,
+// hence those must no longer be wanted, or they would be created again
+// from the rolled back data under their old names, and must not have
+// clones.
+func rollbackVolumeSnapshot(ctx *volumemgrContext, status *types.VolumeStatus,
+	name string, wanted map[string]bool) error {
+
+	if domainName := volumeInUseBy(ctx, status.Key()); domainName != "" {
+		return fmt.Errorf("volume is in use by the running domain %s", domainName)
+	}
+	dataset := status.ZVolName()
+	existing, err := zfs.ListSnapshots(log, dataset)
+	if err != nil {
+		return err
+	}
+	newerSnapshots, err := snapshotsToRollBack(existing, name, wanted)
+	if err != nil {
+		return err
+	}
+	for _, newer := range newerSnapshots {
+		clones, err := zfs.GetSnapshotClones(log, dataset, newer.Name)
+		if err != nil {
+			return err
+		}
+		if len(clones) != 0 {
+			return fmt.Errorf("the newer snapshot %s has the clones %s",
+				newer.Name, strings.Join(clones, ", "))
+		}
+	}
+	if output, err := zfs.RollbackSnapshot(log, dataset, name); err != nil {
+		return fmt.Errorf("%v, output=%s", err, output)
+	}
+	return nil
+}
+
+// snapshotsToRollBack returns the snapshots which a rollback to the
+// snapshot would destroy, or an error if one of them is still wanted
+func snapshotsToRollBack(existing []zfs.SnapshotInfo, name string,
+	wanted map[string]bool) ([]zfs.SnapshotInfo, error) {
+
+	index := -1
+	for i := range existing {
+		if existing[i].Name == name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("no snapshot %s", name)
+	}
+	newer := existing[index+1:]
+	for _, snapshot := range newer {
+		if wanted[snapshot.Name] {
+			return nil, fmt.Errorf("the newer snapshot %s would be destroyed but is still in the config",
+				snapshot.Name)
+		}
+	}
+	return newer, nil
+}
+
+// volumeInUseBy returns the name of a domain which uses the volume and
+// may be running
+func volumeInUseBy(ctx *volumemgrContext, volumeKey string) string {
+	for _, item := range ctx.subDomainStatus.GetAll() {
+		ds := item.(types.DomainStatus)
+		if !ds.Activated &&
+			(ds.State < types.BOOTING || ds.State == types.HALTED) {
+			continue
+		}
+		for _, disk := range ds.DiskStatusList {
+			if disk.VolumeKey == volumeKey {
+				return ds.DomainName
+			}
+		}
+	}
+	return ""
+}
+
+// createVolumeSnapshot checks the retention limits and creates the
+// snapshot. Returns an error string if the snapshot was not created.
+func createVolumeSnapshot(ctx *volumemgrContext, dataset string,
+	name string, count int) string {
+
+	if !zfs.ValidSnapshotName(name) {
+		return fmt.Sprintf("invalid snapshot name %q", name)
+	}
+	maxCount := int(ctx.globalConfig.GlobalValueInt(types.ZFSSnapshotMaxCount))
+	if count >= maxCount {
+		return fmt.Sprintf("cannot create snapshot %s: limit of %d snapshots reached",
+			name, maxCount)
+	}
+	minFree := uint64(ctx.globalConfig.GlobalValueInt(types.ZFSSnapshotMinFreePercent))
+	free, err := zfs.GetPoolFreePercent(log, dataset)
+	if err != nil {
+		return fmt.Sprintf("cannot create snapshot %s: %v", name, err)
+	}
+	if free < minFree {
+		return fmt.Sprintf("cannot create snapshot %s: %d%% of the pool is free which is less than %d%%",
+			name, free, minFree)
+	}
+	if output, err := zfs.CreateSnapshot(log, dataset, name); err != nil {
+		return fmt.Sprintf("cannot create snapshot %s: %v, output=%s",
+			name, err, output)
+	}
+	return ""
+}
+
+func findSnapshot(snapshots []zfs.SnapshotInfo, name string) *zfs.SnapshotInfo {
+	for i := range snapshots {
+		if snapshots[i].Name == name {
+			return &snapshots[i]
+		}
+	}
+	return nil
+}
+
+// snapshotError keeps the time of an unchanged error so the status does
+// not change on every retry
+func snapshotError(oldSnapshots []types.VolumeSnapshotStatus, name string,
+	errStr string) types.ErrorAndTime {
+
+	for _, old := range oldSnapshots {
+		if old.Name == name && old.Error == errStr {
+			return old.ErrorAndTime
+		}
+	}
+	var et types.ErrorAndTime
+	et.SetErrorNow(errStr)
+	return et
+}
+
+// destroyVolumeSnapshots destroys all snapshots of the zvol before the
+// zvol itself is destroyed
+func destroyVolumeSnapshots(dataset string) error {
+	snapshots, err := zfs.ListSnapshots(log, dataset)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if output, err := zfs.DestroySnapshot(log, dataset, snapshot.Name); err != nil {
+			return fmt.Errorf("cannot destroy snapshot %s of %s: %v, output=%s",
+				snapshot.Name, dataset, err, output)
+		}
+	}
+	return nil
+}
+
+// lookupCreatedVolumeStatusByUUID returns the created volume with the
+// UUID; the latest generation if there are several
+func lookupCreatedVolumeStatusByUUID(ctx *volumemgrContext, volumeID string) *types.VolumeStatus {
+	var found *types.VolumeStatus
+	for _, status := range getAllVolumeStatus(ctx) {
+		if status.VolumeID.String() != volumeID ||
+			status.State != types.CREATED_VOLUME {
+			continue
+		}
+		if found == nil || status.GenerationCounter > found.GenerationCounter {
+			found = status
+		}
+	}
+	return found
+}
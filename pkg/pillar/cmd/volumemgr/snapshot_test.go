@@ -0,0 +1,72 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package volumemgr
+
+import (
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+func TestSnapshotsToRollBack(t *testing.T) {
+	existing := []zfs.SnapshotInfo{{Name: "s1"}, {Name: "s2"}, {Name: "s3"}}
+	testMatrix := map[string]struct {
+		name       string
+		wanted     []string
+		expNewer   []string
+		expFailure bool
+	}{
+		"newer snapshots removed from the config": {
+			name:     "s1",
+			wanted:   []string{"s1"},
+			expNewer: []string{"s2", "s3"},
+		},
+		"latest snapshot": {
+			name:   "s3",
+			wanted: []string{"s1", "s2", "s3"},
+		},
+		"newer snapshot still in the config": {
+			name:       "s1",
+			wanted:     []string{"s1", "s3"},
+			expFailure: true,
+		},
+		"no such snapshot": {
+			name:       "s4",
+			wanted:     []string{"s1", "s2", "s3"},
+			expFailure: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		wanted := make(map[string]bool)
+		for _, name := range test.wanted {
+			wanted[name] = true
+		}
+		newer, err := snapshotsToRollBack(existing, test.name, wanted)
+		if test.expFailure {
+			if err == nil {
+				t.Errorf("%s: no error", testname)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", testname, err)
+			continue
+		}
+		var names []string
+		for _, snapshot := range newer {
+			names = append(names, snapshot.Name)
+		}
+		if len(names) != len(test.expNewer) {
+			t.Errorf("%s: %v instead of %v", testname, names, test.expNewer)
+			continue
+		}
+		for i := range names {
+			if names[i] != test.expNewer[i] {
+				t.Errorf("%s: %v instead of %v", testname, names, test.expNewer)
+				break
+			}
+		}
+	}
+}
@@ -531,6 +531,44 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 			status.ClearErrorWithSource()
 			changed = true
 		}
+	case zconfig.VolumeContentOriginType_VCOT_CLONE:
+		if status.State < types.CREATING_VOLUME &&
+			status.SubState == types.VolumeSubStateInitial {
+			source := lookupCreatedVolumeStatusByUUID(ctx,
+				status.SourceVolumeID.String())
+			if source == nil {
+				log.Functionf("doUpdateVol(%s) name %s: waiting for source volume %s",
+					status.Key(), status.DisplayName, status.SourceVolumeID)
+				return changed, false
+			}
+			if !supportsSnapshots(ctx, source) {
+				errStr := fmt.Sprintf("doUpdateVol(%s) name %s: cannot clone volume %s: snapshots are only supported for zvols",
+					status.Key(), status.DisplayName, source.DisplayName)
+				log.Error(errStr)
+				status.SetErrorWithSource(errStr,
+					types.VolumeStatus{}, time.Now())
+				changed = true
+				return changed, false
+			}
+			if source.Encrypted != status.Encrypted {
+				errStr := fmt.Sprintf("doUpdateVol(%s) name %s: cannot clone volume %s with different encryption",
+					status.Key(), status.DisplayName, source.DisplayName)
+				log.Error(errStr)
+				status.SetErrorWithSource(errStr,
+					types.VolumeStatus{}, time.Now())
+				changed = true
+				return changed, false
+			}
+			status.State = types.CREATING_VOLUME
+			status.ReferenceName = "" //the zvol is created by the clone
+			status.ContentFormat = source.ContentFormat
+			status.TotalSize = source.TotalSize
+			status.CurrentSize = source.CurrentSize
+			changed = true
+			// Asynch preparation; ensure we have requested it
+			AddWorkPrepare(ctx, status)
+			return changed, false
+		}
 	default:
 		// Unsupported volume content origin type
 		errStr := fmt.Sprintf("doUpdateVol(%s) name %s: Volume content origin type %v not supported",
@@ -649,6 +687,7 @@ func doUpdateVol(ctx *volumemgrContext, status *types.VolumeStatus) (bool, bool)
 		}
 		persistFsType := vault.ReadPersistType()
 		updateStatusByPersistType(status, persistFsType)
+		updateVolumeSnapshots(ctx, status)
 	}
 	return changed, false
 }
@@ -70,9 +70,16 @@ type volumemgrContext struct {
 	pubAppDiskMetric        pubsub.Publication
 	subDatastoreConfig      pubsub.Subscription
 	subZVolStatus           pubsub.Subscription
+	subDomainStatus         pubsub.Subscription
 	diskMetricsTickerHandle interface{}
 	gc                      *time.Ticker
 	deferDelete             *time.Ticker
+	pubCASFsckMetrics       pubsub.Publication
+	casFsckTicker           *time.Ticker
+	casFsckChan             <-chan time.Time // nil if disabled
+	casFsckRunning          bool
+	casFsckOrphans          map[string]bool // Orphans found by the previous check
+	casFsckMetrics          types.CASFsckMetrics
 
 	worker worker.Worker // For background work
 
@@ -161,6 +168,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		workCreate:  {Request: volumeWorker, Response: processVolumeWorkResult},
 		workIngest:  {Request: casIngestWorker, Response: processCasIngestWorkResult},
 		workPrepare: {Request: volumePrepareWorker, Response: processVolumePrepareResult},
+		workCASFsck: {Request: casFsckWorker, Response: processCASFsckResult},
 	})
 
 	// Set up our publications before the subscriptions so ctx is set
@@ -263,6 +271,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	ctx.pubAppDiskMetric = pubAppDiskMetric
 
+	pubCASFsckMetrics, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName: agentName,
+			TopicType: types.CASFsckMetrics{},
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.pubCASFsckMetrics = pubCASFsckMetrics
+
 	// Look for global config such as log levels
 	subZedAgentStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "zedagent",
@@ -440,6 +458,21 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.subZVolStatus = subZVolStatus
 	subZVolStatus.Activate()
 
+	// Look for the domains using a volume before rolling it back
+	subDomainStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:   "domainmgr",
+		MyAgentName: agentName,
+		TopicImpl:   types.DomainStatus{},
+		Activate:    true,
+		Ctx:         &ctx,
+		WarningTime: warningTime,
+		ErrorTime:   errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctx.subDomainStatus = subDomainStatus
+
 	// Pick up debug aka log level before we start real work
 	for !ctx.GCInitialized {
 		log.Functionf("waiting for GCInitialized")
@@ -522,6 +555,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	ctx.deferDelete = time.NewTicker(time.Hour)
 	ctx.deferDelete.Stop()
 
+	// Periodic check of the CAS; restarted when the global config changes
+	updateCASFsckTicker(&ctx,
+		ctx.globalConfig.GlobalValueInt(types.CASFsckInterval))
+
 	// start the metrics reporting task
 	diskMetricsTickerHandle := make(chan interface{})
 	log.Functionf("Creating %s at %s", "diskMetricsTimerTask", agentlog.GetMyStack())
@@ -564,6 +601,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-ctx.subZVolStatus.MsgChan():
 			ctx.subZVolStatus.ProcessChange(change)
 
+		case change := <-ctx.subDomainStatus.MsgChan():
+			ctx.subDomainStatus.ProcessChange(change)
+
 		case <-ctx.gc.C:
 			start := time.Now()
 			gcObjects(&ctx, volumeEncryptedDirName)
@@ -583,6 +623,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			ps.CheckMaxTimeTopic(agentName, "deferDelete", start,
 				warningTime, errorTime)
 
+		case <-ctx.casFsckChan:
+			start := time.Now()
+			startCASFsck(&ctx)
+			ps.CheckMaxTimeTopic(agentName, "casFsck", start,
+				warningTime, errorTime)
+
 		case res := <-ctx.worker.MsgChan():
 			res.Process(&ctx, true)
 
@@ -727,4 +773,11 @@ func maybeUpdateConfigItems(ctx *volumemgrContext, newConfigItemValueMap *types.
 			ctx.deferDelete = time.NewTicker(duration * time.Second)
 		}
 	}
+	newFsck := newConfigItemValueMap.GlobalValueInt(types.CASFsckInterval)
+	oldFsck := oldConfigItemValueMap.GlobalValueInt(types.CASFsckInterval)
+	if newFsck != oldFsck {
+		log.Noticef("maybeUpdateConfigItems: Updating CASFsckInterval from %d to %d",
+			oldFsck, newFsck)
+		updateCASFsckTicker(ctx, newFsck)
+	}
 }
@@ -34,7 +34,10 @@ const (
 type TpmAgentImpl struct{}
 
 //VerifierImpl implements zattest.Verifier interface
-type VerifierImpl struct{}
+type VerifierImpl struct {
+	//local is set when attesting to a local verifier instead of the Controller
+	local *localVerifier
+}
 
 //WatchdogImpl implements zattest.Watchdog interface
 type WatchdogImpl struct{}
@@ -81,6 +84,44 @@ func trySendToController(attestReq *attest.ZAttestReq, iteration int) (*http.Res
 		size, buf, iteration, true)
 }
 
+//available returns false if there is no verifier to attest to
+func (server *VerifierImpl) available() bool {
+	return server.local != nil || zedcloud.UseV2API()
+}
+
+//sendAttestReq sends the request to the local verifier or the Controller
+//and returns the contents of the response
+func (server *VerifierImpl) sendAttestReq(attestReq *attest.ZAttestReq, iteration int) ([]byte, error) {
+	if server.local != nil {
+		return server.local.sendAttestReq(attestReq)
+	}
+	_, contents, senderStatus, err := trySendToController(attestReq, iteration)
+	if err == nil && senderStatus != types.SenderStatusNone {
+		err = fmt.Errorf("senderStatus %v", senderStatus)
+	}
+	return contents, err
+}
+
+//setIntegrityToken keeps the token given by the verifier
+func (server *VerifierImpl) setIntegrityToken(token []byte) {
+	if server.local != nil {
+		server.local.integrityToken = token
+		return
+	}
+	storeIntegrityToken(token)
+}
+
+//getIntegrityToken returns the token given by the verifier
+func (server *VerifierImpl) getIntegrityToken() ([]byte, error) {
+	if server.local != nil {
+		if server.local.integrityToken == nil {
+			return nil, fmt.Errorf("no integrity token")
+		}
+		return server.local.integrityToken, nil
+	}
+	return readIntegrityToken()
+}
+
 //SendNonceRequest implements SendNonceRequest method of zattest.Verifier
 func (server *VerifierImpl) SendNonceRequest(ctx *zattest.Context) error {
 	if ctx.OpaqueCtx == nil {
@@ -98,21 +139,27 @@ func (server *VerifierImpl) SendNonceRequest(ctx *zattest.Context) error {
 	}
 	var attestReq = &attest.ZAttestReq{}
 
-	// bail if V2API is not supported
-	if !zedcloud.UseV2API() {
+	// bail if V2API is not supported and there is no local verifier
+	if !server.available() {
 		return zattest.ErrNoVerifier
 	}
 
+	if server.local != nil {
+		//The local verifier needs the attestation certificate
+		if err := server.local.sendCerts(attestCtx.zedagentCtx); err != nil {
+			log.Errorf("[ATTEST] Sending certs to local verifier failed: %v", err)
+			return zattest.ErrControllerReqFailed
+		}
+	}
 	attestReq.ReqType = attest.ZAttestReqType_ATTEST_REQ_NONCE
 
 	//Increment Iteration for interface rotation
 	attestCtx.Iteration++
 	log.Tracef("Sending Nonce request %v", attestReq)
 
-	_, contents, senderStatus, err := trySendToController(attestReq, attestCtx.Iteration)
-	if err != nil || senderStatus != types.SenderStatusNone {
-		log.Errorf("[ATTEST] Error %v, senderStatus %v",
-			err, senderStatus)
+	contents, err := server.sendAttestReq(attestReq, attestCtx.Iteration)
+	if err != nil {
+		log.Errorf("[ATTEST] Error %v", err)
 		return zattest.ErrControllerReqFailed
 	}
 
@@ -224,8 +271,8 @@ func (server *VerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
 	}
 	var attestReq = &attest.ZAttestReq{}
 
-	// bail if V2API is not supported
-	if !zedcloud.UseV2API() {
+	// bail if V2API is not supported and there is no local verifier
+	if !server.available() {
 		return zattest.ErrNoVerifier
 	}
 
@@ -264,10 +311,9 @@ func (server *VerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
 	attestCtx.Iteration++
 	log.Tracef("Sending Quote request")
 
-	_, contents, senderStatus, err := trySendToController(attestReq, attestCtx.Iteration)
-	if err != nil || senderStatus != types.SenderStatusNone {
-		log.Errorf("[ATTEST] Error %v, senderStatus %v",
-			err, senderStatus)
+	contents, err := server.sendAttestReq(attestReq, attestCtx.Iteration)
+	if err != nil {
+		log.Errorf("[ATTEST] Error %v", err)
 		return zattest.ErrControllerReqFailed
 	}
 
@@ -296,7 +342,7 @@ func (server *VerifierImpl) SendAttestQuote(ctx *zattest.Context) error {
 		return zattest.ErrControllerReqFailed
 	case attest.ZAttestResponseCode_Z_ATTEST_RESPONSE_CODE_SUCCESS:
 		//Retrieve integrity token
-		storeIntegrityToken(quoteResp.GetIntegrityToken())
+		server.setIntegrityToken(quoteResp.GetIntegrityToken())
 		log.Notice("[ATTEST] Attestation successful, processing keys given by Controller")
 		if encryptedKeys := quoteResp.GetKeys(); encryptedKeys != nil {
 			for _, sk := range encryptedKeys {
@@ -334,8 +380,8 @@ func (server *VerifierImpl) SendAttestEscrow(ctx *zattest.Context) error {
 		log.Fatalf("[ATTEST] Unexpected type from opaque ctx: %T",
 			ctx.OpaqueCtx)
 	}
-	// bail if V2API is not supported
-	if !zedcloud.UseV2API() {
+	// bail if V2API is not supported and there is no local verifier
+	if !server.available() {
 		return zattest.ErrNoVerifier
 	}
 	if attestCtx.EscrowData == nil {
@@ -348,7 +394,7 @@ func (server *VerifierImpl) SendAttestEscrow(ctx *zattest.Context) error {
 	key.KeyType = attest.AttestVolumeKeyType_ATTEST_VOLUME_KEY_TYPE_VSK
 	key.Key = attestCtx.EscrowData
 	escrowMsg.Keys = append(escrowMsg.Keys, key)
-	if b, err := readIntegrityToken(); err == nil {
+	if b, err := server.getIntegrityToken(); err == nil {
 		escrowMsg.IntegrityToken = b
 	}
 	var attestReq = &attest.ZAttestReq{}
@@ -359,10 +405,9 @@ func (server *VerifierImpl) SendAttestEscrow(ctx *zattest.Context) error {
 	attestCtx.Iteration++
 	log.Tracef("Sending Escrow data")
 
-	_, contents, senderStatus, err := trySendToController(attestReq, attestCtx.Iteration)
-	if err != nil || senderStatus != types.SenderStatusNone {
-		log.Errorf("[ATTEST] Error %v, senderStatus %v",
-			err, senderStatus)
+	contents, err := server.sendAttestReq(attestReq, attestCtx.Iteration)
+	if err != nil {
+		log.Errorf("[ATTEST] Error %v", err)
 		return zattest.ErrControllerReqFailed
 	}
 	attestResp := &attest.ZAttestResponse{}
@@ -468,7 +513,8 @@ func cleanupEventLog(quoteMsg *attest.ZAttestQuote) {
 
 // initialize attest pubsub trigger handlers and channels
 func attestModuleInitialize(ctx *zedagentContext, ps *pubsub.PubSub) error {
-	zattest.RegisterExternalIntf(&TpmAgentImpl{}, &VerifierImpl{}, &WatchdogImpl{})
+	zattest.RegisterExternalIntf(&TpmAgentImpl{},
+		&VerifierImpl{local: newLocalVerifier()}, &WatchdogImpl{})
 
 	if ctx.attestCtx == nil {
 		ctx.attestCtx = &attestContext{}
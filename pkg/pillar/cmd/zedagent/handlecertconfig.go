@@ -294,16 +294,35 @@ func publishEdgeNodeCertsToController(ctx *zedagentContext) {
 		return
 	}
 
-	attestReq = new(attest.ZAttestReq)
 	startPubTime := time.Now()
+	attestReq = edgeNodeCertsAttestReq(ctx)
+	if attestReq == nil {
+		//Nothing to be sent
+		return
+	}
+
+	log.Tracef("publishEdgeNodeCertsToController, sending %s", attestReq)
+	sendAttestReqProtobuf(attestReq, ctx.cipherCtx.iteration)
+	log.Tracef("publishEdgeNodeCertsToController: after send, total elapse sec %v",
+		time.Since(startPubTime).Seconds())
+	ctx.cipherCtx.iteration++
+	// XXX remove log?
+	log.Noticef("Maybe sent EdgeNodeCerts")
+	// The getDeferredSentHandlerFunction will set ctx.publishedEdgeNodeCerts
+	// when the message has been sent.
+}
+
+// edgeNodeCertsAttestReq returns the ATTEST_REQ_CERT message with the edge
+// node certs, or nil if there are none
+func edgeNodeCertsAttestReq(ctx *zedagentContext) *attest.ZAttestReq {
+	attestReq := new(attest.ZAttestReq)
 	attestReq.ReqType = attest.ZAttestReqType_ATTEST_REQ_CERT
 	// no quotes
 
 	sub := ctx.subEdgeNodeCert
 	items := sub.GetAll()
 	if len(items) == 0 {
-		//Nothing to be sent
-		return
+		return nil
 	}
 
 	ecdhCertExists := false
@@ -330,18 +349,9 @@ func publishEdgeNodeCertsToController(ctx *zedagentContext) {
 
 	if !ecdhCertExists {
 		//we expect it to be published first
-		log.Warn("publishEdgeNodeCertsToController: no ecdh")
+		log.Warn("edgeNodeCertsAttestReq: no ecdh")
 	}
-
-	log.Tracef("publishEdgeNodeCertsToController, sending %s", attestReq)
-	sendAttestReqProtobuf(attestReq, ctx.cipherCtx.iteration)
-	log.Tracef("publishEdgeNodeCertsToController: after send, total elapse sec %v",
-		time.Since(startPubTime).Seconds())
-	ctx.cipherCtx.iteration++
-	// XXX remove log?
-	log.Noticef("Maybe sent EdgeNodeCerts")
-	// The getDeferredSentHandlerFunction will set ctx.publishedEdgeNodeCerts
-	// when the message has been sent.
+	return attestReq
 }
 
 // Try all (first free, then rest) until it gets through.
@@ -79,6 +79,7 @@ type getconfigContext struct {
 	subAppNetworkStatus      pubsub.Subscription
 	pubBaseOsConfig          pubsub.Publication
 	pubBaseOs                pubsub.Publication
+	pubZFSDiskReplaceConfig  pubsub.Publication
 	pubDatastoreConfig       pubsub.Publication
 	pubNetworkInstanceConfig pubsub.Publication
 	pubControllerCert        pubsub.Publication
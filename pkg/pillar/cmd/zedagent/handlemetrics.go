@@ -13,6 +13,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -93,6 +94,58 @@ func lookupAppDiskMetric(ctx *zedagentContext, diskPath string) *types.AppDiskMe
 	return &metric
 }
 
+// getZfsPoolMetrics returns the error counters of the vdevs of the zpools
+func getZfsPoolMetrics(ctx *zedagentContext) []*metrics.ZfsPoolMetric {
+	var pools []*metrics.ZfsPoolMetric
+	for _, item := range ctx.subZFSPoolStatus.GetAll() {
+		status := item.(types.ZFSPoolStatus)
+		pool := &metrics.ZfsPoolMetric{PoolName: status.PoolName}
+		for _, vdev := range status.Vdevs {
+			pool.Vdevs = append(pool.Vdevs, &metrics.ZfsVdevMetric{
+				Name:           vdev.Name,
+				ReadErrors:     vdev.ReadErrors,
+				WriteErrors:    vdev.WriteErrors,
+				ChecksumErrors: vdev.ChecksumErrors,
+			})
+		}
+		pools = append(pools, pool)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].PoolName < pools[j].PoolName
+	})
+	return pools
+}
+
+// getCASFsckMetric returns nil until volumemgr has checked the CAS
+func getCASFsckMetric(ctx *zedagentContext) *metrics.CASFsckMetric {
+	m, err := ctx.subCASFsckMetrics.Get("global")
+	if err != nil {
+		return nil
+	}
+	fsck := m.(types.CASFsckMetrics)
+	metric := &metrics.CASFsckMetric{
+		RunCount:            fsck.RunCount,
+		FailureCount:        fsck.FailureCount,
+		LastDurationMs:      uint64(fsck.LastDuration / time.Millisecond),
+		LastError:           fsck.LastError,
+		LastBlobs:           fsck.LastBlobs,
+		LastBlobBytes:       fsck.LastBlobBytes,
+		LastMissingBlobs:    fsck.LastMissingBlobs,
+		LastCorruptBlobs:    fsck.LastCorruptBlobs,
+		LastOrphanBlobs:     fsck.LastOrphanBlobs,
+		LastOrphanBytes:     fsck.LastOrphanBytes,
+		LastOrphanSnapshots: fsck.LastOrphanSnapshots,
+		CorruptBlobs:        fsck.CorruptBlobs,
+		RemovedBlobs:        fsck.RemovedBlobs,
+		RemovedBytes:        fsck.RemovedBytes,
+		RemovedSnapshots:    fsck.RemovedSnapshots,
+	}
+	if !fsck.LastRun.IsZero() {
+		metric.LastRun, _ = ptypes.TimestampProto(fsck.LastRun)
+	}
+	return metric
+}
+
 func encodeErrorInfo(et types.ErrorDescription) *info.ErrorInfo {
 	if et.ErrorTime.IsZero() {
 		// No Success / Error to report
@@ -240,9 +293,12 @@ func lookupDomainMetric(ctx *zedagentContext, uuidStr string) *types.DomainMetri
 	return &metric
 }
 
+// Native containers are reported by domainmgr and containers inside VMs by zedrouter
 func lookupAppContainerMetric(ctx *zedagentContext, uuidStr string) *types.AppContainerMetrics {
-	sub := ctx.subAppContainerMetrics
-	m, _ := sub.Get(uuidStr)
+	m, _ := ctx.subAppContainerMetrics.Get(uuidStr)
+	if m == nil {
+		m, _ = ctx.subCtrContainerMetrics.Get(uuidStr)
+	}
 	if m == nil {
 		return nil
 	}
@@ -347,6 +403,12 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 		networkDetails.RxAclDrops = metric.RxAclDrops
 		networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
 		networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+		networkDetails.TxShapingDrops = metric.TxShapingDrops
+		networkDetails.RxShapingDrops = metric.RxShapingDrops
+		networkDetails.TxShapingOverlimits = metric.TxShapingOverlimits
+		networkDetails.RxShapingOverlimits = metric.RxShapingOverlimits
+		networkDetails.TxShapingBacklog = metric.TxShapingBacklog
+		networkDetails.RxShapingBacklog = metric.RxShapingBacklog
 		ReportDeviceMetric.Network = append(ReportDeviceMetric.Network,
 			networkDetails)
 	}
@@ -435,6 +497,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 		NumInputEvent:        newlogMetrics.DevMetrics.NumInputEvent,
 		NumGzipFileRetry:     newlogMetrics.DevMetrics.NumGZipFileRetry,
 		NumGzipFileKeptLocal: newlogMetrics.DevMetrics.NumGZipFileKeptLocal,
+		NumRateLimitDrop:     newlogMetrics.DevMetrics.NumRateLimitDrop,
+		NumRateLimitKept:     newlogMetrics.DevMetrics.NumRateLimitKept,
 	}
 	if !newlogMetrics.DevMetrics.RecentUploadTimestamp.IsZero() {
 		devM.RecentGzipFileTime, _ = ptypes.TimestampProto(newlogMetrics.DevMetrics.RecentUploadTimestamp)
@@ -452,6 +516,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 		NumInputEvent:        newlogMetrics.AppMetrics.NumInputEvent,
 		NumGzipFileRetry:     newlogMetrics.AppMetrics.NumGZipFileRetry,
 		NumGzipFileKeptLocal: newlogMetrics.AppMetrics.NumGZipFileKeptLocal,
+		NumRateLimitDrop:     newlogMetrics.AppMetrics.NumRateLimitDrop,
+		NumRateLimitKept:     newlogMetrics.AppMetrics.NumRateLimitKept,
 	}
 	if !newlogMetrics.AppMetrics.RecentUploadTimestamp.IsZero() {
 		appM.RecentGzipFileTime, _ = ptypes.TimestampProto(newlogMetrics.AppMetrics.RecentUploadTimestamp)
@@ -465,6 +531,10 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	for source, val := range newlogMetrics.DevTop10InputBytesPCT {
 		nlm.Top10InputSources[source] = val
 	}
+	nlm.Top10RateLimitDrop = make(map[string]uint64)
+	for source, val := range newlogMetrics.DevTop10RateLimitDrop {
+		nlm.Top10RateLimitDrop[source] = val
+	}
 	ReportDeviceMetric.Newlog = nlm
 	log.Tracef("publishMetrics: newlog-metrics %+v", nlm)
 
@@ -629,6 +699,9 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 	}
 	ReportDeviceMetric.DormantTimeInSeconds = getDormantTime(ctx)
 
+	ReportDeviceMetric.ZfsPools = getZfsPoolMetrics(ctx)
+	ReportDeviceMetric.CasFsck = getCASFsckMetric(ctx)
+
 	// Report flowlog metrics.
 	ctx.flowLogMetrics.Lock()
 	ReportDeviceMetric.Flowlog = &metrics.FlowlogMetric{
@@ -725,6 +798,12 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				networkDetails.RxAclDrops = metric.RxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.TxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.RxAclRateLimitDrops
+				networkDetails.TxShapingDrops = metric.TxShapingDrops
+				networkDetails.RxShapingDrops = metric.RxShapingDrops
+				networkDetails.TxShapingOverlimits = metric.TxShapingOverlimits
+				networkDetails.RxShapingOverlimits = metric.RxShapingOverlimits
+				networkDetails.TxShapingBacklog = metric.TxShapingBacklog
+				networkDetails.RxShapingBacklog = metric.RxShapingBacklog
 			} else {
 				// Note that the packets received on bu* and bo* where sent
 				// by the domU and vice versa, hence we swap here
@@ -740,6 +819,12 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				networkDetails.RxAclDrops = metric.TxAclDrops
 				networkDetails.TxAclRateLimitDrops = metric.RxAclRateLimitDrops
 				networkDetails.RxAclRateLimitDrops = metric.TxAclRateLimitDrops
+				networkDetails.TxShapingDrops = metric.RxShapingDrops
+				networkDetails.RxShapingDrops = metric.TxShapingDrops
+				networkDetails.TxShapingOverlimits = metric.RxShapingOverlimits
+				networkDetails.RxShapingOverlimits = metric.TxShapingOverlimits
+				networkDetails.TxShapingBacklog = metric.RxShapingBacklog
+				networkDetails.RxShapingBacklog = metric.TxShapingBacklog
 			}
 			ReportAppMetric.Network = append(ReportAppMetric.Network,
 				networkDetails)
@@ -766,7 +851,8 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				appDiskDetails)
 		}
 
-		acMetric := lookupAppContainerMetric(ctx, aiStatus.UUIDandVersion.UUID.String())
+		appUUIDStr := aiStatus.UUIDandVersion.UUID.String()
+		acMetric := lookupAppContainerMetric(ctx, appUUIDStr)
 		// the new protocol is always fill in at least the module name to indicate
 		// it has not disappeared yet, even we don't have new info on metrics
 		if acMetric != nil {
@@ -775,7 +861,7 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 				appContainerMetric.AppContainerName = stats.ContainerName
 
 				// fill in the new metrics info for each module
-				if acMetric.CollectTime.Sub(ctx.appContainerStatsTime) > 0 {
+				if acMetric.CollectTime.Sub(ctx.appContainerStatsTime[appUUIDStr]) > 0 {
 					appContainerMetric.Status = stats.Status
 					appContainerMetric.PIDs = stats.Pids
 
@@ -808,11 +894,24 @@ func publishMetrics(ctx *zedagentContext, iteration int) {
 					appContainerMetric.Disk = new(metrics.DiskMetric)
 					appContainerMetric.Disk.ReadBytes = stats.ReadBytes
 					appContainerMetric.Disk.WriteBytes = stats.WriteBytes
+					appContainerMetric.Disk.ReadCount = stats.ReadOps
+					appContainerMetric.Disk.WriteCount = stats.WriteOps
+
+					appContainerMetric.PidsLimit = stats.PidsLimit
+					for _, h := range stats.Hugepages {
+						appContainerMetric.Hugepages = append(appContainerMetric.Hugepages,
+							&metrics.HugepageMetric{
+								PageSize:  h.PageSize,
+								Usage:     h.Usage,
+								MaxUsage:  h.MaxUsage,
+								FailCount: h.Failcnt,
+							})
+					}
 				}
 
 				ReportAppMetric.Container = append(ReportAppMetric.Container, appContainerMetric)
 			}
-			ctx.appContainerStatsTime = acMetric.CollectTime
+			ctx.appContainerStatsTime[appUUIDStr] = acMetric.CollectTime
 		}
 
 		ReportMetrics.Am = append(ReportMetrics.Am, ReportAppMetric)
@@ -1150,6 +1249,18 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 			ReportAppInfo.VolumeRefs = append(ReportAppInfo.VolumeRefs,
 				vr.VolumeID.String())
 		}
+
+		if aiStatus.Health.State != types.HealthStateNone {
+			since, _ := ptypes.TimestampProto(aiStatus.Health.Since)
+			ReportAppInfo.Health = &info.ZInfoAppHealth{
+				State:         info.ZAppHealthState(aiStatus.Health.State),
+				Since:         since,
+				FailingStreak: aiStatus.Health.FailingStreak,
+				LastError:     aiStatus.Health.LastError,
+			}
+		}
+		ReportAppInfo.RestartCount = aiStatus.RestartCount
+		ReportAppInfo.Snapshots = encodeAppSnapshots(aiStatus.Snapshot)
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Ainfo)
@@ -1179,6 +1290,34 @@ func PublishAppInfoToZedCloud(ctx *zedagentContext, uuid string,
 	zedcloud.HandleDeferred(zedcloudCtx, time.Now(), 0, true)
 }
 
+// encodeAppSnapshots returns nil unless the app has snapshots or has
+// handled a snapshot or restore command
+func encodeAppSnapshots(snapStatus types.DomainSnapshotStatus) *info.ZInfoAppSnapshots {
+	if len(snapStatus.Snapshots) == 0 && snapStatus.SnapshotCounter == 0 &&
+		snapStatus.RestoreCounter == 0 {
+		return nil
+	}
+	snapshots := &info.ZInfoAppSnapshots{
+		SnapshotCounter: snapStatus.SnapshotCounter,
+		RestoreCounter:  snapStatus.RestoreCounter,
+		InProgress:      snapStatus.InProgress,
+		RestoredFrom:    snapStatus.RestoredFrom,
+		LastError:       snapStatus.Error,
+	}
+	for _, snapshot := range snapStatus.Snapshots {
+		createdAt, _ := ptypes.TimestampProto(snapshot.CreatedAt)
+		snapshots.Snapshots = append(snapshots.Snapshots,
+			&info.ZInfoAppSnapshot{Name: snapshot.Name, CreatedAt: createdAt})
+	}
+	if !snapStatus.RestoredAt.IsZero() {
+		snapshots.RestoredAt, _ = ptypes.TimestampProto(snapStatus.RestoredAt)
+	}
+	if !snapStatus.ErrorTime.IsZero() {
+		snapshots.LastErrorTime, _ = ptypes.TimestampProto(snapStatus.ErrorTime)
+	}
+	return snapshots
+}
+
 // PublishContentInfoToZedCloud is called per change, hence needs to try over all management ports
 // When content tree Status is nil it means a delete and we send a message
 // containing only the UUID to inform zedcloud about the delete.
@@ -1298,6 +1437,21 @@ func PublishVolumeToZedCloud(ctx *zedagentContext, uuid string,
 
 		ReportVolumeInfo.ProgressPercentage = uint32(volStatus.Progress)
 		ReportVolumeInfo.GenerationCount = volStatus.GenerationCounter
+		ReportVolumeInfo.RollbackCounter = volStatus.RollbackCounter
+		for _, snapshot := range volStatus.Snapshots {
+			snapshotInfo := &info.ZInfoVolumeSnapshot{
+				Name:            snapshot.Name,
+				UsedBytes:       snapshot.UsedBytes,
+				ReferencedBytes: snapshot.ReferencedBytes,
+			}
+			if !snapshot.CreateTime.IsZero() {
+				snapshotInfo.CreateTime, _ = ptypes.TimestampProto(snapshot.CreateTime)
+			}
+			if snapshot.HasError() {
+				snapshotInfo.Err = encodeErrorInfo(snapshot.ErrorDescription)
+			}
+			ReportVolumeInfo.Snapshots = append(ReportVolumeInfo.Snapshots, snapshotInfo)
+		}
 	}
 
 	ReportInfo.InfoContent = new(info.ZInfoMsg_Vinfo)
@@ -1573,6 +1727,8 @@ func protoEncodeNetworkInstanceMetricProto(status types.NetworkInstanceMetrics)
 	vlanInfo := new(zmet.VlanInfo)
 	vlanInfo.NumTrunkPorts = status.VlanMetrics.NumTrunkPorts
 	vlanInfo.VlanCounts = status.VlanMetrics.VlanCounts
+	vlanInfo.TrunkVlanCounts = status.VlanMetrics.TrunkVlanCounts
+	metric.VlanInfo = vlanInfo
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
 		protoEncodeVpnInstanceMetric(status, metric)
@@ -1580,6 +1736,12 @@ func protoEncodeNetworkInstanceMetricProto(status types.NetworkInstanceMetrics)
 	default:
 		protoEncodeGenericInstanceMetric(status, metric)
 	}
+	metric.ProbeMetric = protoEncodeProbeMetric(status.ProbeMetrics)
+	metric.FlowExport = &zmet.ZMetricFlowExport{
+		ExportedRecords: status.FlowExport.ExportedRecords,
+		DroppedRecords:  status.FlowExport.DroppedRecords,
+		SendErrors:      status.FlowExport.SendErrors,
+	}
 
 	return metric
 }
@@ -250,6 +250,7 @@ func protoEncodeGenericInstanceMetric(status types.NetworkInstanceMetrics,
 	drops += netMetric.RxDrops
 	drops += netMetric.RxAclDrops
 	drops += netMetric.RxAclRateLimitDrops
+	drops += netMetric.RxShapingDrops
 	rxStats.Drops = drops
 
 	txStats.TotalPackets = netMetric.TxPkts
@@ -260,6 +261,7 @@ func protoEncodeGenericInstanceMetric(status types.NetworkInstanceMetrics,
 	drops += netMetric.TxDrops
 	drops += netMetric.TxAclDrops
 	drops += netMetric.TxAclRateLimitDrops
+	drops += netMetric.TxShapingDrops
 	txStats.Drops = drops
 
 	networkStats.Rx = rxStats
@@ -267,6 +269,36 @@ func protoEncodeGenericInstanceMetric(status types.NetworkInstanceMetrics,
 	metric.NetworkStats = networkStats
 }
 
+func protoEncodeProbeMetric(probeMetrics types.ProbeMetrics) *zmet.ZProbeNIMetrics {
+	metric := &zmet.ZProbeNIMetrics{
+		CurrentIntf:    probeMetrics.CurrUplinkIntf,
+		RemoteEndpoint: probeMetrics.RemoteEndpoint,
+		PingIntv:       probeMetrics.LocalPingIntvl,
+		RemotePingIntv: probeMetrics.RemotePingIntvl,
+		UplinkCnt:      probeMetrics.UplinkNumber,
+	}
+	for _, intfStats := range probeMetrics.IntfProbeStats {
+		intfMetric := &zmet.ZProbeNIMetrics_ZProbeIntfMetric{
+			IntfName:           intfStats.IntfName,
+			GatewayUP:          intfStats.GatewayUP,
+			RemoteHostUP:       intfStats.RmoteStatusUP,
+			NexthopUpCount:     intfStats.GatewayUPCnt,
+			NexthopDownCount:   intfStats.GatewayDownCnt,
+			RemoteUpCount:      intfStats.RemoteUPCnt,
+			RemoteDownCount:    intfStats.RemoteDownCnt,
+			RemoteProbeLatency: intfStats.LatencyToRemote,
+			LoadBalanced:       intfStats.LoadBalanced,
+			Weight:             intfStats.Weight,
+			FlowCount:          intfStats.FlowCount,
+		}
+		if intfStats.NexthopGw != nil {
+			intfMetric.GatewayNexhtop = intfStats.NexthopGw.String()
+		}
+		metric.IntfMetric = append(metric.IntfMetric, intfMetric)
+	}
+	return metric
+}
+
 func protoEncodeVpnInstanceMetric(metrics types.NetworkInstanceMetrics,
 	instanceMetrics *zmet.ZMetricNetworkInstance) {
 
@@ -375,6 +407,9 @@ func publishVpnConnection(vpnInfo *zinfo.ZInfoVpn,
 	vpnConnInfo.Ikes = vpnConn.Ikes
 	vpnConnInfo.EstTime = vpnConn.EstTime
 	vpnConnInfo.Version = vpnConn.Version
+	if !vpnConn.LastHandshake.IsZero() {
+		vpnConnInfo.LastHandshake, _ = ptypes.TimestampProto(vpnConn.LastHandshake)
+	}
 
 	lEndPointInfo := new(zinfo.ZInfoVpnEndPoint)
 	lEndPointInfo.Id = vpnConn.LInfo.Id
@@ -509,6 +544,7 @@ func protoEncodeAppFlowMonitorProto(ipflow types.IPFlow) *flowlog.FlowMessage {
 		prec.TxPkts = rec.TxPkts
 		prec.RxBytes = rec.RxBytes
 		prec.RxPkts = rec.RxPkts
+		prec.VlanId = rec.VlanID
 		pflows.Flows = append(pflows.Flows, prec)
 	}
 
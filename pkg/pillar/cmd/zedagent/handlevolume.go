@@ -75,6 +75,17 @@ func parseVolumeConfig(ctx *getconfigContext,
 		if volumeOrigin != nil {
 			volumeConfig.VolumeContentOriginType = volumeOrigin.GetType()
 			volumeConfig.ContentID, _ = uuid.FromString(volumeOrigin.GetDownloadContentTreeID())
+			if volumeOrigin.GetType() == zconfig.VolumeContentOriginType_VCOT_CLONE {
+				volumeConfig.SourceVolumeID, _ = uuid.FromString(volumeOrigin.GetSourceVolumeID())
+				volumeConfig.SourceSnapshot = volumeOrigin.GetSourceSnapshot()
+			}
+		}
+		for _, snapshot := range cfgVolume.GetSnapshots() {
+			volumeConfig.Snapshots = append(volumeConfig.Snapshots, snapshot.GetName())
+		}
+		if rollback := cfgVolume.GetRollback(); rollback != nil {
+			volumeConfig.RollbackSnapshot = rollback.GetSnapshot()
+			volumeConfig.RollbackCounter = rollback.GetCounter()
 		}
 		volumeConfig.MaxVolSize = uint64(cfgVolume.GetMaxsizebytes())
 		volumeConfig.GenerationCounter = cfgVolume.GetGenerationCount()
@@ -0,0 +1,105 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedagent
+
+// Attest to a verifier on an adjacent server instead of the Controller,
+// for sites which can not reach the Controller. If
+// types.AttestVerifierURLFile exists the requests are sent to the
+// attestverifier at that URL. The quotes are never verified on the device
+// itself, since whoever can change the boot of the device can also change
+// a policy and escrowed keys stored on it.
+
+import (
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lf-edge/eve/api/go/attest"
+	"github.com/lf-edge/eve/pkg/pillar/attest/localverifier"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+type localVerifier struct {
+	send func(b []byte) ([]byte, error)
+	// integrityToken is not shared with the Controller hence we do not
+	// use types.ITokenFile
+	integrityToken []byte
+}
+
+// newLocalVerifier returns nil if no local verifier is configured.
+// A configuration which can not be used results in all requests failing
+// rather than falling back to the Controller.
+func newLocalVerifier() *localVerifier {
+	if b, err := ioutil.ReadFile(types.AttestVerifierURLFile); err == nil {
+		url := strings.TrimSpace(string(b))
+		if url == "" {
+			err := fmt.Errorf("%s is empty", types.AttestVerifierURLFile)
+			log.Errorf("[ATTEST] %v", err)
+			return failingLocalVerifier(err)
+		}
+		var rootCAs *x509.CertPool
+		if caPEM, err := ioutil.ReadFile(types.AttestVerifierCAFile); err == nil {
+			rootCAs = x509.NewCertPool()
+			if !rootCAs.AppendCertsFromPEM(caPEM) {
+				err := fmt.Errorf("no certificates in %s",
+					types.AttestVerifierCAFile)
+				log.Errorf("[ATTEST] %v", err)
+				return failingLocalVerifier(err)
+			}
+		}
+		client, err := localverifier.NewClient(url, "", rootCAs)
+		if err != nil {
+			log.Errorf("[ATTEST] %s: %v", types.AttestVerifierURLFile, err)
+			return failingLocalVerifier(err)
+		}
+		log.Noticef("[ATTEST] Using verifier at %s", url)
+		return &localVerifier{
+			send: func(b []byte) ([]byte, error) {
+				client.DeviceID = devUUID.String()
+				return client.Send(b)
+			},
+		}
+	}
+	return nil
+}
+
+func failingLocalVerifier(err error) *localVerifier {
+	return &localVerifier{
+		send: func(b []byte) ([]byte, error) {
+			return nil, err
+		},
+	}
+}
+
+func (lv *localVerifier) sendAttestReq(attestReq *attest.ZAttestReq) ([]byte, error) {
+	data, err := proto.Marshal(attestReq)
+	if err != nil {
+		log.Fatal("sendAttestReq proto marshaling error: ", err)
+	}
+	return lv.send(data)
+}
+
+// sendCerts sends the edge node certificates since the local verifier
+// does not get them through publishEdgeNodeCertsToController
+func (lv *localVerifier) sendCerts(ctx *zedagentContext) error {
+	attestReq := edgeNodeCertsAttestReq(ctx)
+	if attestReq == nil {
+		return fmt.Errorf("no edge node certificates")
+	}
+	contents, err := lv.sendAttestReq(attestReq)
+	if err != nil {
+		return err
+	}
+	attestResp := &attest.ZAttestResponse{}
+	if err := proto.Unmarshal(contents, attestResp); err != nil {
+		return err
+	}
+	if attestResp.GetRespType() != attest.ZAttestRespType_ATTEST_RESP_CERT {
+		return fmt.Errorf("got %v, but want %v", attestResp.GetRespType(),
+			attest.ZAttestRespType_ATTEST_RESP_CERT)
+	}
+	return nil
+}
@@ -35,6 +35,9 @@ const (
 	// range of valid VLAN IDs
 	minVlanID = 1
 	maxVlanID = 4094
+	// range of VLAN IDs valid on app interfaces, vlan 1 is used by linux bridges
+	minAppVlanID = 2
+	maxAppVlanID = 4093
 )
 
 // Returns a rebootFlag
@@ -101,6 +104,7 @@ func parseConfig(config *zconfig.EdgeDevConfig, getconfigCtx *getconfigContext,
 		parseNetworkInstanceConfig(config, getconfigCtx)
 		parseContentInfoConfig(getconfigCtx, config)
 		parseVolumeConfig(getconfigCtx, config)
+		parseDiskReplace(getconfigCtx, config)
 
 		// parseProfile must be called before processing of app instances from config
 		parseProfile(getconfigCtx, config)
@@ -323,7 +327,8 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 	log.Functionf("Publish NetworkInstance Config: %+v", networkInstances)
 
 	unpublishDeletedNetworkInstanceConfig(ctx, networkInstances)
-	// check we do not have more than one VPN network instance
+	// check we do not have more than one strongSwan VPN network instance,
+	// there can be any number of WireGuard ones
 	vpnCount := 0
 	for _, netInstApiCfg := range networkInstances {
 		if oCfg := netInstApiCfg.Cfg; oCfg != nil {
@@ -368,6 +373,22 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 		if apiConfigEntry.Port != nil {
 			networkInstanceConfig.Logicallabel = apiConfigEntry.Port.Name
 		}
+		networkInstanceConfig.Shaping = parseBandwidthShaping(apiConfigEntry.Shaping)
+		if flowExport := apiConfigEntry.GetFlowExport(); flowExport != nil {
+			networkInstanceConfig.FlowExport = types.FlowExportConfig{
+				Collector:        flowExport.Collector,
+				Protocol:         types.FlowExportProtocol(flowExport.Protocol),
+				EnterpriseNumber: flowExport.EnterpriseNumber,
+			}
+		}
+		networkInstanceConfig.UplinkLoadBalancing =
+			types.UplinkLoadBalancing(apiConfigEntry.UplinkLoadBalancing)
+		for _, weight := range apiConfigEntry.UplinkWeights {
+			if networkInstanceConfig.UplinkWeights == nil {
+				networkInstanceConfig.UplinkWeights = make(map[string]uint32)
+			}
+			networkInstanceConfig.UplinkWeights[weight.Logicallabel] = weight.Weight
+		}
 		networkInstanceConfig.IpType = types.AddressType(apiConfigEntry.IpType)
 
 		switch networkInstanceConfig.Type {
@@ -383,6 +404,12 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 				// Let's relax the requirement until cloud side update the right IpType
 				networkInstanceConfig.IpType = types.AddressTypeNone
 			}
+			if networkInstanceConfig.Shaping.IsSet() {
+				errStr := fmt.Sprintf("Network Instance %s: bandwidth shaping is not supported for switch network instances",
+					networkInstanceConfig.Key())
+				log.Error(errStr)
+				networkInstanceConfig.SetErrorNow(errStr)
+			}
 			ctx.pubNetworkInstanceConfig.Publish(networkInstanceConfig.UUID.String(),
 				networkInstanceConfig)
 
@@ -397,13 +424,20 @@ func publishNetworkInstanceConfig(ctx *getconfigContext,
 					networkInstanceConfig.IpType)
 			} else {
 				ocfg := apiConfigEntry.Cfg
-				if ocfg.Type != zconfig.ZNetworkOpaqueConfigType_ZNetOConfigVPN {
+				switch ocfg.Type {
+				case zconfig.ZNetworkOpaqueConfigType_ZNetOConfigVPN:
+					networkInstanceConfig.VpnType = types.VpnTypeStrongSwan
+				case zconfig.ZNetworkOpaqueConfigType_ZNetOConfigWireGuard:
+					networkInstanceConfig.VpnType = types.VpnTypeWireGuard
+				default:
 					log.Errorf("Network instance %s %s, %v invalid config",
 						networkInstanceConfig.UUID.String(),
 						networkInstanceConfig.DisplayName,
 						networkInstanceConfig.IpType)
 				}
 				networkInstanceConfig.OpaqueConfig = ocfg.Oconfig
+				networkInstanceConfig.CipherBlockStatus = parseCipherBlock(ctx,
+					networkInstanceConfig.Key(), ocfg.GetCipherData())
 			}
 			// if not IPv4 type, flag it
 			if networkInstanceConfig.IpType != types.AddressTypeIPV4 {
@@ -517,12 +551,17 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 		appInstance.FixedResources.Memory = int(cfgApp.Fixedresources.Memory)
 		appInstance.FixedResources.RootDev = cfgApp.Fixedresources.Rootdev
 		appInstance.FixedResources.VCpus = int(cfgApp.Fixedresources.Vcpus)
+		appInstance.FixedResources.CPUs = cfgApp.Fixedresources.Cpus
 		appInstance.FixedResources.VirtualizationMode = types.VmMode(cfgApp.Fixedresources.VirtualizationMode)
 		appInstance.FixedResources.EnableVnc = cfgApp.Fixedresources.EnableVnc
 		appInstance.FixedResources.VncDisplay = cfgApp.Fixedresources.VncDisplay
 		appInstance.FixedResources.VncPasswd = cfgApp.Fixedresources.VncPasswd
 		appInstance.FixedResources.DisableLogs = cfgApp.Fixedresources.DisableLogs
+		parseContainerResources(&appInstance.FixedResources,
+			cfgApp.Fixedresources.GetContainerResources())
 		appInstance.MetaDataType = types.MetaDataType(cfgApp.MetaDataType)
+		appInstance.HealthCheck = parseAppHealthCheck(cfgApp.GetHealthCheck())
+		appInstance.RestartPolicy = types.RestartPolicy(cfgApp.RestartPolicy)
 
 		appInstance.VolumeRefConfigList = make([]types.VolumeRefConfig,
 			len(cfgApp.VolumeRefList))
@@ -556,6 +595,16 @@ func parseAppInstanceConfig(config *zconfig.EdgeDevConfig,
 			appInstance.PurgeCmd.Counter = cmd.Counter
 			appInstance.PurgeCmd.ApplyTime = cmd.OpsTime
 		}
+		snapCmd := cfgApp.GetSnapshot()
+		if snapCmd != nil {
+			appInstance.SnapshotCmd.Counter = snapCmd.Counter
+			appInstance.SnapshotCmd.Name = snapCmd.Name
+		}
+		snapCmd = cfgApp.GetRestore()
+		if snapCmd != nil {
+			appInstance.RestoreCmd.Counter = snapCmd.Counter
+			appInstance.RestoreCmd.Name = snapCmd.Name
+		}
 		userData := cfgApp.GetUserData()
 		if userData != "" {
 			appInstance.CloudInitUserData = &userData
@@ -1530,6 +1579,57 @@ func parseVolumeRefList(volumeRefConfigList []types.VolumeRefConfig,
 	}
 }
 
+func parseAppHealthCheck(cfgHealthCheck *zconfig.AppHealthCheck) *types.HealthCheckConfig {
+	if cfgHealthCheck == nil || len(cfgHealthCheck.Test) == 0 {
+		return nil
+	}
+	return &types.HealthCheckConfig{
+		Test:        cfgHealthCheck.Test,
+		Interval:    time.Duration(cfgHealthCheck.IntervalSeconds) * time.Second,
+		Timeout:     time.Duration(cfgHealthCheck.TimeoutSeconds) * time.Second,
+		StartPeriod: time.Duration(cfgHealthCheck.StartPeriodSeconds) * time.Second,
+		Retries:     cfgHealthCheck.Retries,
+	}
+}
+
+func parseContainerResources(vmConfig *types.VmConfig,
+	cfgResources *zconfig.ContainerResources) {
+
+	if cfgResources == nil {
+		return
+	}
+	weight := cfgResources.BlkioWeight
+	if weight != 0 && (weight < 10 || weight > 1000) {
+		log.Errorf("parseContainerResources: ignoring blkio weight %d outside 10-1000",
+			weight)
+		weight = 0
+	}
+	vmConfig.BlkioWeight = uint16(weight)
+	for _, t := range cfgResources.BlkioThrottles {
+		if t.Device == "" {
+			continue
+		}
+		vmConfig.BlkioThrottles = append(vmConfig.BlkioThrottles,
+			types.BlkioThrottle{
+				Device:    t.Device,
+				ReadBps:   t.ReadBps,
+				WriteBps:  t.WriteBps,
+				ReadIops:  t.ReadIops,
+				WriteIops: t.WriteIops,
+			})
+	}
+	if cfgResources.PidsLimit > 0 {
+		vmConfig.PidsLimit = cfgResources.PidsLimit
+	}
+	for _, h := range cfgResources.HugepageLimits {
+		if h.PageSize == "" {
+			continue
+		}
+		vmConfig.HugepageLimits = append(vmConfig.HugepageLimits,
+			types.HugepageLimit{PageSize: h.PageSize, Limit: h.Limit})
+	}
+}
+
 // XXX Remove when systemAdapter embeds the NetworkXObject
 func lookupNetworkId(id string, cfgNetworks []*zconfig.NetworkConfig) *zconfig.NetworkConfig {
 	for _, netEnt := range cfgNetworks {
@@ -2082,8 +2182,23 @@ func parseUnderlayNetworkConfigEntry(
 		}
 	}
 
+	// A load balanced network instance keeps the uplink of a flow in
+	// some of the bits of the ACE id in the connmark
+	maxACERuleID := int32(types.MaxACERuleID)
+	if networkInstanceEntry.InstType == zconfig.ZNetworkInstType_ZnetInstLocal &&
+		networkInstanceEntry.UplinkLoadBalancing !=
+			zconfig.UplinkLoadBalancing_UPLINK_LOAD_BALANCING_NONE {
+		maxACERuleID = types.MaxLBACERuleID
+	}
 	ulCfg.ACLs = make([]types.ACE, len(intfEnt.Acls))
 	for aclIdx, acl := range intfEnt.Acls {
+		if acl.Id < 0 || acl.Id > maxACERuleID {
+			ulCfg.Error = fmt.Sprintf("App %s-%s: ACE %s id %d out of range 0-%d\n",
+				cfgApp.Displayname, cfgApp.Uuidandversion.Uuid, acl.Name,
+				acl.Id, maxACERuleID)
+			log.Errorf("%s", ulCfg.Error)
+			return ulCfg
+		}
 		aclCfg := new(types.ACE)
 		aclCfg.Matches = make([]types.ACEMatch,
 			len(acl.Matches))
@@ -2119,9 +2234,37 @@ func parseUnderlayNetworkConfigEntry(
 	// XXX set ulCfg.IntfOrder from API once available
 	ulCfg.IntfOrder = intfOrder
 	ulCfg.AccessVlanID = intfEnt.AccessVlanId
+	for _, vlanRange := range intfEnt.GetTrunkVlans() {
+		start, end := vlanRange.GetStart(), vlanRange.GetEnd()
+		if end == 0 {
+			end = start
+		}
+		if start < minAppVlanID || end > maxAppVlanID || start > end {
+			ulCfg.Error = fmt.Sprintf("App %s-%s: Invalid trunk vlan range %d-%d for %s, valid vlan ids are %d-%d\n",
+				cfgApp.Displayname, cfgApp.Uuidandversion.Uuid,
+				vlanRange.GetStart(), vlanRange.GetEnd(), intfEnt.Name,
+				minAppVlanID, maxAppVlanID)
+			log.Errorf("%s", ulCfg.Error)
+			return ulCfg
+		}
+		ulCfg.TrunkVlans = append(ulCfg.TrunkVlans,
+			types.VlanRange{Start: start, End: end})
+	}
+	ulCfg.Shaping = parseBandwidthShaping(intfEnt.Shaping)
 	return ulCfg
 }
 
+// parseBandwidthShaping returns the zero value, meaning no shaping,
+// if shaping is not set
+func parseBandwidthShaping(shaping *zconfig.BandwidthShaping) types.BandwidthShaping {
+	return types.BandwidthShaping{
+		EgressRate:  shaping.GetEgressRate(),
+		EgressCeil:  shaping.GetEgressCeil(),
+		IngressRate: shaping.GetIngressRate(),
+		IngressCeil: shaping.GetIngressCeil(),
+	}
+}
+
 var itemsPrevConfigHash []byte
 
 func parseConfigItems(config *zconfig.EdgeDevConfig, ctx *getconfigContext) {
@@ -2310,6 +2453,28 @@ func publishBaseOsConfig(getconfigCtx *getconfigContext,
 	pub.Publish(key, *config)
 }
 
+func parseDiskReplace(getconfigCtx *getconfigContext,
+	config *zconfig.EdgeDevConfig) {
+
+	pub := getconfigCtx.pubZFSDiskReplaceConfig
+	diskReplace := config.GetDiskReplace()
+	if diskReplace == nil {
+		if _, err := pub.Get("global"); err == nil {
+			log.Functionf("parseDiskReplace: unpublishing")
+			pub.Unpublish("global")
+		}
+		return
+	}
+	replaceConfig := types.ZFSDiskReplaceConfig{
+		PoolName: diskReplace.GetPoolName(),
+		OldDisk:  diskReplace.GetOldDisk(),
+		NewDisk:  diskReplace.GetNewDisk(),
+		Counter:  diskReplace.GetCounter(),
+	}
+	log.Functionf("parseDiskReplace: %+v", replaceConfig)
+	pub.Publish(replaceConfig.Key(), replaceConfig)
+}
+
 func publishBaseOs(getconfigCtx *getconfigContext,
 	config *types.BaseOs) {
 
@@ -1126,3 +1126,95 @@ func TestInvalidLowerLayerReferences(t *testing.T) {
 	g.Expect(dpc.HasError()).To(BeFalse())
 	g.Expect(dpc.Ports).To(HaveLen(2))
 }
+
+func TestParseACEIDOutOfRange(t *testing.T) {
+	g := NewGomegaWithT(t)
+	initGetConfigCtx(g)
+
+	networkInstanceUUID := "572cd3bc-ade6-42ad-97a0-22cd24fed1a0"
+	cfgNetworkInstances := []*zconfig.NetworkInstanceConfig{
+		{
+			Uuidandversion: &zconfig.UUIDandVersion{
+				Uuid: networkInstanceUUID,
+			},
+			InstType: zconfig.ZNetworkInstType_ZnetInstLocal,
+		},
+	}
+	cfgApp := &zconfig.AppInstanceConfig{
+		Uuidandversion: &zconfig.UUIDandVersion{
+			Uuid: "dc1c3e7c-37f1-4e6f-95c2-d0c1e6fba8d2",
+		},
+		Displayname: "app1",
+	}
+	intfEnt := &zconfig.NetworkAdapter{
+		Name:      "eth0",
+		NetworkId: networkInstanceUUID,
+		Acls: []*zconfig.ACE{
+			{Id: 1, Name: "allow-all"},
+			{Id: types.MaxACERuleID + 1, Name: "too-big"},
+		},
+	}
+	ulCfg := parseUnderlayNetworkConfigEntry(cfgApp, nil, cfgNetworkInstances,
+		intfEnt)
+	g.Expect(ulCfg.Error).To(ContainSubstring(
+		"ACE too-big id 8388607 out of range 0-8388606"))
+
+	// fix:
+	intfEnt.Acls[1].Id = types.MaxACERuleID
+	ulCfg = parseUnderlayNetworkConfigEntry(cfgApp, nil, cfgNetworkInstances,
+		intfEnt)
+	g.Expect(ulCfg.Error).To(BeEmpty())
+	g.Expect(ulCfg.ACLs).To(HaveLen(2))
+	g.Expect(ulCfg.ACLs[1].RuleID).To(BeEquivalentTo(types.MaxACERuleID))
+
+	// the uplink takes some of the bits on a load balanced network instance
+	cfgNetworkInstances[0].UplinkLoadBalancing =
+		zconfig.UplinkLoadBalancing_UPLINK_LOAD_BALANCING_PER_FLOW
+	ulCfg = parseUnderlayNetworkConfigEntry(cfgApp, nil, cfgNetworkInstances,
+		intfEnt)
+	g.Expect(ulCfg.Error).To(ContainSubstring(
+		"ACE too-big id 8388606 out of range 0-524286"))
+
+	intfEnt.Acls[1].Id = types.MaxLBACERuleID
+	ulCfg = parseUnderlayNetworkConfigEntry(cfgApp, nil, cfgNetworkInstances,
+		intfEnt)
+	g.Expect(ulCfg.Error).To(BeEmpty())
+	g.Expect(ulCfg.ACLs[1].RuleID).To(BeEquivalentTo(types.MaxLBACERuleID))
+}
+
+func TestParseSwitchNetworkInstanceShaping(t *testing.T) {
+	g := NewGomegaWithT(t)
+	getconfigCtx := initGetConfigCtx(g)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+	pubNetworkInstanceConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.NetworkInstanceConfig{},
+	})
+	g.Expect(err).To(BeNil())
+	getconfigCtx.pubNetworkInstanceConfig = pubNetworkInstanceConfig
+
+	networkInstanceUUID := "572cd3bc-ade6-42ad-97a0-22cd24fed1a0"
+	cfgNetworkInstance := &zconfig.NetworkInstanceConfig{
+		Uuidandversion: &zconfig.UUIDandVersion{
+			Uuid: networkInstanceUUID,
+		},
+		InstType: zconfig.ZNetworkInstType_ZnetInstSwitch,
+		Shaping:  &zconfig.BandwidthShaping{EgressCeil: 1000},
+	}
+	publishNetworkInstanceConfig(getconfigCtx,
+		[]*zconfig.NetworkInstanceConfig{cfgNetworkInstance})
+	item, err := pubNetworkInstanceConfig.Get(networkInstanceUUID)
+	g.Expect(err).To(BeNil())
+	niConfig := item.(types.NetworkInstanceConfig)
+	g.Expect(niConfig.Error).To(ContainSubstring(
+		"bandwidth shaping is not supported for switch network instances"))
+
+	// fix:
+	cfgNetworkInstance.Shaping = nil
+	publishNetworkInstanceConfig(getconfigCtx,
+		[]*zconfig.NetworkInstanceConfig{cfgNetworkInstance})
+	item, err = pubNetworkInstanceConfig.Get(networkInstanceUUID)
+	g.Expect(err).To(BeNil())
+	niConfig = item.(types.NetworkInstanceConfig)
+	g.Expect(niConfig.HasError()).To(BeFalse())
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -505,6 +506,8 @@ func PublishDeviceInfoToZedCloud(ctx *zedagentContext) {
 
 	ReportDeviceInfo.Capabilities = getCapabilities(ctx)
 
+	ReportDeviceInfo.ZfsPools = getZfsPools(ctx)
+
 	ReportDeviceInfo.State = getState(ctx)
 
 	// Report if there is a local override of profile
@@ -954,6 +957,57 @@ func getCapabilities(ctx *zedagentContext) *info.Capabilities {
 	}
 }
 
+// getZfsPools returns the status of the zpools sorted by name
+func getZfsPools(ctx *zedagentContext) []*info.ZInfoZfsPool {
+	var pools []*info.ZInfoZfsPool
+	for _, item := range ctx.subZFSPoolStatus.GetAll() {
+		status := item.(types.ZFSPoolStatus)
+		pool := &info.ZInfoZfsPool{
+			PoolName:    status.PoolName,
+			State:       info.ZfsState(status.State),
+			Status:      status.Status,
+			Resilvering: status.Resilvering,
+			Scrub: &info.ZInfoZfsScrub{
+				State:         info.ZfsScrubState(status.Scrub.State),
+				RepairedBytes: status.Scrub.RepairedBytes,
+				Errors:        status.Scrub.Errors,
+			},
+		}
+		if !status.Scrub.StartTime.IsZero() {
+			pool.Scrub.StartTime, _ = ptypes.TimestampProto(status.Scrub.StartTime)
+		}
+		if !status.Scrub.EndTime.IsZero() {
+			pool.Scrub.EndTime, _ = ptypes.TimestampProto(status.Scrub.EndTime)
+		}
+		for _, vdev := range status.Vdevs {
+			pool.Vdevs = append(pool.Vdevs, &info.ZInfoZfsVdev{
+				Name:           vdev.Name,
+				Parent:         vdev.Parent,
+				State:          info.ZfsState(vdev.State),
+				ReadErrors:     vdev.ReadErrors,
+				WriteErrors:    vdev.WriteErrors,
+				ChecksumErrors: vdev.ChecksumErrors,
+			})
+		}
+		if status.DiskReplace.Counter != 0 {
+			pool.DiskReplace = &info.ZInfoZfsDiskReplace{
+				OldDisk: status.DiskReplace.OldDisk,
+				NewDisk: status.DiskReplace.NewDisk,
+				Counter: status.DiskReplace.Counter,
+			}
+			if status.DiskReplace.HasError() {
+				pool.DiskReplace.Err = encodeErrorInfo(
+					status.DiskReplace.ErrorDescription)
+			}
+		}
+		pools = append(pools, pool)
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].PoolName < pools[j].PoolName
+	})
+	return pools
+}
+
 func getBaseosUpdateCounter(ctx *zedagentContext) uint32 {
 	m, err := ctx.subBaseOsMgrStatus.Get("global")
 	if err != nil {
@@ -30,6 +30,8 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/eriknordmark/ipinfo"
@@ -53,6 +55,10 @@ const (
 	restartCounterFile = types.PersistStatusDir + "/restartcounter"
 	// checkpointDirname - location of config checkpoint
 	checkpointDirname = types.PersistDir + "/checkpoint"
+	// deferredDirname - location of deferred info messages kept across reboots
+	deferredDirname = types.PersistDir + "/deferred"
+	// deferredMaxSize - limit for the size of messages in deferredDirname
+	deferredMaxSize = 10 * 1024 * 1024
 	// Time limits for event loop handlers
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
@@ -120,15 +126,18 @@ type zedagentContext struct {
 	subAttestQuote            pubsub.Subscription
 	subEncryptedKeyFromDevice pubsub.Subscription
 	subNewlogMetrics          pubsub.Subscription
+	subCASFsckMetrics         pubsub.Subscription
 	subBlobStatus             pubsub.Subscription
 	GCInitialized             bool // Received initial GlobalConfig
 	subZbootStatus            pubsub.Subscription
 	subAppContainerMetrics    pubsub.Subscription
+	subCtrContainerMetrics    pubsub.Subscription
 	subDiskMetric             pubsub.Subscription
 	subAppDiskMetric          pubsub.Subscription
 	subCapabilities           pubsub.Subscription
 	subAppInstMetaData        pubsub.Subscription
 	subWwanMetrics            pubsub.Subscription
+	subZFSPoolStatus          pubsub.Subscription
 	subDeviceNetworkStatus    pubsub.Subscription
 	zedcloudMetrics           *zedcloud.AgentMetrics
 	rebootCmd                 bool
@@ -155,7 +164,7 @@ type zedagentContext struct {
 	specMap                 types.ConfigItemSpecMap
 	globalStatus            types.GlobalStatus
 	flowLogMetrics          types.FlowlogMetrics
-	appContainerStatsTime   time.Time // last time the App Container stats uploaded
+	appContainerStatsTime   map[string]time.Time // per app, last time the App Container stats uploaded
 	// The MaintenanceMode can come from GlobalConfig and from the config
 	// API. Those are merged into maintenanceMode
 	// TBD will be also decide locally to go into maintenanceMode based
@@ -249,6 +258,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		TriggerObjectInfo: triggerObjectInfo,
 		zedcloudMetrics:   zedcloud.NewAgentMetrics(),
 	}
+	zedagentCtx.appContainerStatsTime = make(map[string]time.Time)
 	zedagentCtx.specMap = types.NewConfigItemSpecMap()
 	zedagentCtx.globalConfig = *types.DefaultConfigItemValueMap()
 	zedagentCtx.globalStatus.ConfigItems = make(
@@ -372,6 +382,17 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		zedagentCtx.zedcloudMetrics)
 	// Timer for deferred sends of info messages
 	deferredChan := zedcloud.GetDeferredChan(zedcloudCtx, getDeferredSentHandlerFunction(&zedagentCtx), getDeferredPriorityFunctions()...)
+	// Keep deferred info messages across reboots
+	err = zedcloud.EnableDeferredPersist(zedcloudCtx,
+		zedcloud.DeferredPersistOptions{
+			Dirname:        deferredDirname,
+			MaxSize:        deferredMaxSize,
+			EncodeItemType: encodeDeferredItemType,
+			DecodeItemType: decodeDeferredItemType,
+		})
+	if err != nil {
+		log.Errorf("EnableDeferredPersist failed: %v", err)
+	}
 
 	subAssignableAdapters, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "domainmgr",
@@ -472,6 +493,16 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	pubBaseOs.ClearRestarted()
 	getconfigCtx.pubBaseOs = pubBaseOs
 
+	pubZFSDiskReplaceConfig, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: agentName,
+		TopicType: types.ZFSDiskReplaceConfig{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	pubZFSDiskReplaceConfig.ClearRestarted()
+	getconfigCtx.pubZFSDiskReplaceConfig = pubZFSDiskReplaceConfig
+
 	pubDatastoreConfig, err := ps.NewPublication(pubsub.PublicationOptions{
 		AgentName: agentName,
 		TopicType: types.DatastoreConfig{},
@@ -757,6 +788,24 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subAppContainerMetrics = subAppContainerMetrics
 	subAppContainerMetrics.Activate()
 
+	// sub AppContainerMetrics from domainmgr for native containers
+	subCtrContainerMetrics, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "domainmgr",
+		MyAgentName:   agentName,
+		TopicImpl:     types.AppContainerMetrics{},
+		Activate:      false,
+		Ctx:           &zedagentCtx,
+		CreateHandler: handleAppContainerMetricsCreate,
+		ModifyHandler: handleAppContainerMetricsModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subCtrContainerMetrics = subCtrContainerMetrics
+	subCtrContainerMetrics.Activate()
+
 	subBaseOsStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "baseosmgr",
 		MyAgentName:   agentName,
@@ -937,6 +986,18 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	zedagentCtx.subNewlogMetrics = subNewlogMetrics
 
+	// Subscribe to the results of the CAS check from volumemgr
+	subCASFsckMetrics, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName: "volumemgr",
+		TopicImpl: types.CASFsckMetrics{},
+		Activate:  true,
+		Ctx:       &zedagentCtx,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subCASFsckMetrics = subCASFsckMetrics
+
 	subDiskMetric, err := ps.NewSubscription(pubsub.SubscriptionOptions{
 		AgentName:     "volumemgr",
 		MyAgentName:   agentName,
@@ -1031,6 +1092,24 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	zedagentCtx.subWwanMetrics = subWwanMetrics
 	subWwanMetrics.Activate()
 
+	subZFSPoolStatus, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zfsmanager",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ZFSPoolStatus{},
+		Activate:      false,
+		Ctx:           &zedagentCtx,
+		CreateHandler: handleZFSPoolStatusCreate,
+		ModifyHandler: handleZFSPoolStatusModify,
+		DeleteHandler: handleZFSPoolStatusDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	zedagentCtx.subZFSPoolStatus = subZFSPoolStatus
+	subZFSPoolStatus.Activate()
+
 	//initialize cipher processing block
 	cipherModuleInitialize(&zedagentCtx, ps)
 
@@ -1130,6 +1209,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subZFSPoolStatus.MsgChan():
+			subZFSPoolStatus.ProcessChange(change)
+
 		case change := <-deferredChan:
 			start := time.Now()
 			zedcloud.HandleDeferred(zedcloudCtx, change, 100*time.Millisecond, false)
@@ -1540,9 +1622,15 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subAppContainerMetrics.MsgChan():
 			subAppContainerMetrics.ProcessChange(change)
 
+		case change := <-subCtrContainerMetrics.MsgChan():
+			subCtrContainerMetrics.ProcessChange(change)
+
 		case change := <-subDiskMetric.MsgChan():
 			subDiskMetric.ProcessChange(change)
 
+		case change := <-subCASFsckMetrics.MsgChan():
+			subCASFsckMetrics.ProcessChange(change)
+
 		case change := <-subAppDiskMetric.MsgChan():
 			subAppDiskMetric.ProcessChange(change)
 
@@ -1558,6 +1646,9 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		case change := <-subWwanMetrics.MsgChan():
 			subWwanMetrics.ProcessChange(change)
 
+		case change := <-subZFSPoolStatus.MsgChan():
+			subZFSPoolStatus.ProcessChange(change)
+
 		case <-stillRunning.C:
 			// Fault injection
 			if fatalFlag {
@@ -2186,6 +2277,28 @@ func getDeferredPriorityFunctions() []zedcloud.TypePriorityCheckFunction {
 	return functions
 }
 
+// encodeDeferredItemType is used to persist deferred info messages.
+// Attestation requests are not persisted since they carry a nonce
+// which is not valid after a reboot.
+func encodeDeferredItemType(itemType interface{}) (string, bool) {
+	if el, ok := itemType.(info.ZInfoTypes); ok {
+		return "info:" + strconv.Itoa(int(el)), true
+	}
+	return "", false
+}
+
+// decodeDeferredItemType is the reverse of encodeDeferredItemType
+func decodeDeferredItemType(encoded string) (interface{}, error) {
+	if strings.HasPrefix(encoded, "info:") {
+		val, err := strconv.Atoi(strings.TrimPrefix(encoded, "info:"))
+		if err != nil {
+			return nil, err
+		}
+		return info.ZInfoTypes(val), nil
+	}
+	return nil, fmt.Errorf("unknown deferred item type %s", encoded)
+}
+
 // Track the DeviceUUID
 func handleOnboardStatusCreate(ctxArg interface{}, key string,
 	statusArg interface{}) {
@@ -2218,3 +2331,29 @@ func handleOnboardStatusImpl(ctxArg interface{}, key string,
 		triggerPublishAllInfo(ctx.getconfigCtx.zedagentCtx)
 	}
 }
+
+func handleZFSPoolStatusCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleZFSPoolStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleZFSPoolStatusModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleZFSPoolStatusImpl(ctxArg, key, statusArg)
+}
+
+func handleZFSPoolStatusImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zedagentContext)
+	log.Functionf("handleZFSPoolStatusImpl(%s)", key)
+	triggerPublishDevInfo(ctx)
+}
+
+func handleZFSPoolStatusDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zedagentContext)
+	log.Functionf("handleZFSPoolStatusDelete(%s)", key)
+	triggerPublishDevInfo(ctx)
+}
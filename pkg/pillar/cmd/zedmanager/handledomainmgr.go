@@ -47,6 +47,10 @@ func MaybeAddDomainConfig(ctx *zedmanagerContext,
 		CipherBlockStatus: aiConfig.CipherBlockStatus,
 		GPUConfig:         "legacy",
 		MetaDataType:      aiConfig.MetaDataType,
+		HealthCheck:       aiConfig.HealthCheck,
+		RestartPolicy:     aiConfig.RestartPolicy,
+		SnapshotCmd:       aiConfig.SnapshotCmd,
+		RestoreCmd:        aiConfig.RestoreCmd,
 	}
 
 	dc.DiskConfigList = make([]types.DiskConfig, 0, len(aiStatus.VolumeRefStatusList))
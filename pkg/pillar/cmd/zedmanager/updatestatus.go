@@ -407,6 +407,12 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 			if c {
 				changed = true
 			}
+			if updateHealth(status, *ds) {
+				changed = true
+			}
+			if updateSnapshot(status, *ds) {
+				changed = true
+			}
 			if !ds.Activated && !ds.HasError() {
 				log.Functionf("RestartInprogress(%s) came down - set bring up",
 					status.Key())
@@ -568,6 +574,12 @@ func doActivate(ctx *zedmanagerContext, uuidStr string,
 	if c {
 		changed = true
 	}
+	if updateHealth(status, *ds) {
+		changed = true
+	}
+	if updateSnapshot(status, *ds) {
+		changed = true
+	}
 	// Are we doing a restart?
 	if status.RestartInprogress == types.BringDown {
 		if dc.Activate {
@@ -684,6 +696,28 @@ func updateVifUsed(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bo
 	return changed
 }
 
+// Check if the health or restart count has changed and return true if it has
+func updateHealth(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if statusPtr.Health.Equal(ds.Health) && statusPtr.RestartCount == ds.RestartCount {
+		return false
+	}
+	log.Functionf("Health %s restart count %d for %s",
+		ds.Health.State, ds.RestartCount, statusPtr.Key())
+	statusPtr.Health = ds.Health
+	statusPtr.RestartCount = ds.RestartCount
+	return true
+}
+
+// updateSnapshot copies the snapshot status reported by domainmgr
+func updateSnapshot(statusPtr *types.AppInstanceStatus, ds types.DomainStatus) bool {
+	if cmp.Equal(statusPtr.Snapshot, ds.Snapshot) {
+		return false
+	}
+	log.Functionf("Snapshot status %+v for %s", ds.Snapshot, statusPtr.Key())
+	statusPtr.Snapshot = ds.Snapshot
+	return true
+}
+
 func purgeCmdDone(ctx *zedmanagerContext, config types.AppInstanceConfig,
 	status *types.AppInstanceStatus) bool {
 
@@ -802,6 +836,12 @@ func doInactivate(ctx *zedmanagerContext, appInstID uuid.UUID,
 		if c {
 			changed = true
 		}
+		if updateHealth(status, *ds) {
+			changed = true
+		}
+		if updateSnapshot(status, *ds) {
+			changed = true
+		}
 		// Look for errors
 		if ds.HasError() {
 			log.Errorf("Received error from domainmgr for %s: %s",
@@ -970,6 +1010,12 @@ func doInactivateHalt(ctx *zedmanagerContext,
 	if c {
 		changed = true
 	}
+	if updateHealth(status, *ds) {
+		changed = true
+	}
+	if updateSnapshot(status, *ds) {
+		changed = true
+	}
 	if ds.State != status.State {
 		switch status.State {
 		case types.RESTARTING, types.PURGING:
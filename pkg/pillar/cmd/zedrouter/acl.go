@@ -41,22 +41,31 @@ const dummyIntfName = "flow-mon-dummy"
 // Connection mark is used to remember for a given flow to which application it belongs
 // and which ACE was applied.
 // The 32 bits of a connmark are used as follows:
-//  +------------------------+---------------+------------------+
-//  | Application ID (8bits) | Action (1bit) | ACE ID (23 bits) |
-//  +------------------------+---------------+------------------+
+//  +------------------------+---------------+----------------------------------+
+//  | Application ID (8bits) | Action (1bit) |        ACE ID (23 bits)          |
+//  +------------------------+---------------+----------------------------------+
 // where: Drop action = 1; Allow action = 0
+// On a load balanced network instance the ACE ID is shorter and the
+// uplink picked for the flow (UplinkLBPort.Mark) is kept next to it:
+//  +------------------------+---------------+----------------+------------------+
+//  | Application ID (8bits) | Action (1bit) | Uplink (4bits) | ACE ID (19 bits) |
+//  +------------------------+---------------+----------------+------------------+
 const (
 	appIDMask     = 0xff << 24
 	aceActionMask = 0x1 << 23
 	aceDropAction = aceActionMask
-	aceIDMask     = 0x7fffff
+	aceIDMask     = 0x7fffff // types.MaxACERuleID + 1
+	uplinkShift   = 19
+	uplinkMask    = 0xf << uplinkShift
+	lbACEIDMask   = 0x7ffff // types.MaxLBACERuleID + 1
 	// By default, traffic not matched by any ACE is dropped.
 	// For this default rule we use the maximum integer value available for ACE ID.
-	defaultDropAceID = aceIDMask
+	defaultDropAceID   = aceIDMask
+	lbDefaultDropAceID = lbACEIDMask
 )
 
 func getConnmark(appID uint8, aceID uint32, drop bool) uint32 {
-	mark := uint32(appID)<<24 | aceID
+	mark := uint32(appID)<<24 | aceID&aceIDMask
 	if drop {
 		mark |= aceDropAction
 	}
@@ -70,6 +79,34 @@ func parseConnmark(mark uint32) (appID uint8, aceID uint32, drop bool) {
 	return
 }
 
+// maxACERuleID returns the largest RuleID which fits in the connmark
+// of the network instance
+func maxACERuleID(aclArgs types.AppNetworkACLArgs) int32 {
+	if aclArgs.LoadBalanced {
+		return types.MaxLBACERuleID
+	}
+	return types.MaxACERuleID
+}
+
+// dropAceID returns the ACE ID marking the default drop of the
+// network instance
+func dropAceID(aclArgs types.AppNetworkACLArgs) uint32 {
+	if aclArgs.LoadBalanced {
+		return lbDefaultDropAceID
+	}
+	return defaultDropAceID
+}
+
+// lbAceID removes the uplink from the ACE ID of a connmark set on a
+// load balanced network instance
+func lbAceID(aceID uint32) uint32 {
+	aceID &= lbACEIDMask
+	if aceID == lbDefaultDropAceID {
+		return defaultDropAceID
+	}
+	return aceID
+}
+
 var lastAllocatedAceID int32 = -1
 var numFreeAceIDs int32 = MAXACEID - MINACEID + 1
 
@@ -772,7 +809,7 @@ func aclDropRules(aclArgs types.AppNetworkACLArgs) (types.IPTablesRuleList, erro
 		chainName := fmt.Sprintf("drop-all-%s-%s",
 			aclArgs.BridgeName, aclArgs.VifName)
 		aclRule3.ActionChainName = chainName
-		marking := getConnmark(uint8(aclArgs.AppNum), defaultDropAceID, true)
+		marking := getConnmark(uint8(aclArgs.AppNum), dropAceID(aclArgs), true)
 		createMarkAndAcceptChain(aclArgs, chainName, marking)
 		aclRule3.Action = []string{"-j", chainName}
 		aclRule3.RuleID = defaultDropAceID
@@ -805,6 +842,13 @@ func aceToRules(ctx *zedrouterContext, aclArgs types.AppNetworkACLArgs,
 		log.Errorln(errStr)
 		return nil, nil, errors.New(errStr)
 	}
+	// The RuleID must fit in the connmark
+	if maxID := maxACERuleID(aclArgs); ace.RuleID < 0 || ace.RuleID > maxID {
+		errStr := fmt.Sprintf("ACE with RuleID %d out of range 0-%d: %+v",
+			ace.RuleID, maxID, ace)
+		log.Errorln(errStr)
+		return nil, nil, errors.New(errStr)
+	}
 	// Extract lport and protocol from the Matches to use for PortMap
 	// Keep others to make sure we put the protocol before the port
 	// number(s)
@@ -1684,6 +1728,10 @@ func executeIPTablesRule(operation string, rule types.IPTablesRule) error {
 	}
 	ruleStr = append(ruleStr, operation)
 	ruleStr = append(ruleStr, rule.Chain)
+	if operation == "-I" && rule.Table == "raw" && rule.Chain == "PREROUTING" {
+		// Below the jump to the VLAN conntrack zones
+		ruleStr = append(ruleStr, "2")
+	}
 	ruleStr = append(ruleStr, rule.Prefix...)
 	ruleStr = append(ruleStr, rule.Rule...)
 	if len(rule.Action) > 0 {
@@ -1710,25 +1758,6 @@ func executeIPTablesRule(operation string, rule types.IPTablesRule) error {
 	return err
 }
 
-// handle network instance level ACL rules
-// Network Instance Level ACL rule handling routines
-func handleNetworkInstanceACLConfiglet(op string, aclArgs types.AppNetworkACLArgs) error {
-
-	log.Functionf("bridge(%s, %v) iptables op: %v\n", aclArgs.BridgeName, aclArgs.BridgeIP, op)
-	rulesList := networkInstanceBridgeRules(aclArgs)
-	// For Network instance, we are going to do a "-A" operation
-	// so that, the rules, will at the end of the rule chain
-	// for the specific table
-	// For App Network ACLs, we are doing "-I" opration, they
-	// will be always above these Network Instance log/drop rules.
-	for _, rule := range rulesList {
-		if err := executeIPTablesRule(op, rule); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func networkInstanceBridgeRules(aclArgs types.AppNetworkACLArgs) types.IPTablesRuleList {
 	var rulesList types.IPTablesRuleList
 
@@ -1831,7 +1860,8 @@ func createFlowMatchRules(aclArgs types.AppNetworkACLArgs) types.IPTablesRuleLis
 		aclRule.Chain = "PREROUTING"
 		aclRule.Rule = []string{"-i", uplink}
 		// For connections originating from outside we use App ID = 0.
-		mark := getConnmark(0, defaultDropAceID, true)
+		mark := getConnmark(0, dropAceID(aclArgs), true) |
+			lbInboundConnmark(aclArgs, uplink)
 		aclRule.Action = []string{"-j", "MARK", "--set-mark", strconv.FormatUint(uint64(mark), 10)}
 		rulesList = append(rulesList, aclRule)
 
@@ -1881,6 +1911,11 @@ func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
 
 	rule3 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--set-mark",
 		strconv.FormatUint(uint64(marking), 10)}
+	// Pick the uplink of the flow for load balanced network instances
+	var lbRules [][]string
+	for _, rule := range lbMarkRules(aclArgs) {
+		lbRules = append(lbRules, append([]string{"-A", name, "-t", "mangle"}, rule...))
+	}
 	rule4 := []string{"-A", name, "-t", "mangle", "-j", "CONNMARK", "--restore-mark"}
 	rule5 := []string{"-A", name, "-t", "mangle", "-j", "ACCEPT"}
 
@@ -1910,6 +1945,16 @@ func createMarkAndAcceptChain(aclArgs types.AppNetworkACLArgs,
 		iptables.IptableCmd(log, chainDelete...)
 		return err
 	}
+	for _, lbRule := range lbRules {
+		err = iptables.IptableCmd(log, lbRule...)
+		if err != nil {
+			log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
+				lbRule, err)
+			iptables.IptableCmd(log, chainFlush...)
+			iptables.IptableCmd(log, chainDelete...)
+			return err
+		}
+	}
 	err = iptables.IptableCmd(log, rule4...)
 	if err != nil {
 		log.Errorf("createMarkAndAcceptChain: New rule (%s) creation failed: %s",
@@ -1950,7 +1995,8 @@ func appConfigContainerStatsACL(appIPAddr net.IP, isRemove bool) {
 		err = iptables.IptableCmd(log, "-t", "raw", action, "PREROUTING", "-d", appIPAddr.String(), "-p", "tcp",
 			"--dport", strconv.Itoa(DOCKERAPIPORT), "-j", "DROP")
 	} else {
-		err = iptables.IptableCmd(log, "-t", "raw", action, "PREROUTING", "1", "-d", appIPAddr.String(), "-p", "tcp",
+		// Below the jump to the VLAN conntrack zones
+		err = iptables.IptableCmd(log, "-t", "raw", action, "PREROUTING", "2", "-d", appIPAddr.String(), "-p", "tcp",
 			"--dport", strconv.Itoa(DOCKERAPIPORT), "-j", "DROP")
 	}
 	if err != nil {
@@ -85,3 +85,36 @@ func TestHostIpsetBasename(t *testing.T) {
 		})
 	}
 }
+
+// Test the ACE ID of the connmarks of load balanced network instances.
+func TestLBAceID(t *testing.T) {
+	tests := []struct {
+		testname string
+		mark     uint32
+		expAceID uint32
+	}{
+		{
+			testname: "no uplink",
+			mark:     getConnmark(3, 100, false),
+			expAceID: 100,
+		},
+		{
+			testname: "uplink",
+			mark:     getConnmark(3, 100, false) | 5<<uplinkShift,
+			expAceID: 100,
+		},
+		{
+			testname: "default drop",
+			mark:     getConnmark(3, lbDefaultDropAceID, true) | 5<<uplinkShift,
+			expAceID: defaultDropAceID,
+		},
+	}
+	for _, test := range tests {
+		t.Logf("Running test case %s", test.testname)
+		_, aceID, _ := parseConnmark(test.mark)
+		if aceID = lbAceID(aceID); aceID != test.expAceID {
+			t.Errorf("%s: ACE ID 0x%x instead of 0x%x", test.testname,
+				aceID, test.expAceID)
+		}
+	}
+}
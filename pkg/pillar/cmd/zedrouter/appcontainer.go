@@ -213,6 +213,14 @@ func processAppContainerStats(stats apitypes.ContainerStats, container apitypes.
 	}
 	acStats.ReadBytes = utils.RoundToMbytes(acStats.ReadBytes)
 	acStats.WriteBytes = utils.RoundToMbytes(acStats.WriteBytes)
+	for _, bioEntry := range blkioStats.IoServicedRecursive {
+		switch strings.ToLower(bioEntry.Op) {
+		case "read":
+			acStats.ReadOps += bioEntry.Value
+		case "write":
+			acStats.WriteOps += bioEntry.Value
+		}
+	}
 
 	return acStats, nil
 }
@@ -102,17 +102,19 @@ func dnsmasqDhcpHostDir(bridgeName string) string {
 }
 
 // createDnsmasqConfiglet
-// When we create a linux bridge we set this up
-// Also called when we need to update the ipsets
-func createDnsmasqConfiglet(
-	ctx *zedrouterContext,
-	bridgeName string, bridgeIPAddr string,
-	netstatus *types.NetworkInstanceStatus, hostsDir string,
-	ipsetHosts []string, uplink string,
-	dnsServers []net.IP, ntpServers []net.IP) {
-
-	log.Functionf("createDnsmasqConfiglet(%s, %s) netstatus %v, ipsetHosts %v uplink %s dnsServers %v ntpServers %v",
-		bridgeName, bridgeIPAddr, netstatus, ipsetHosts, uplink, dnsServers, ntpServers)
+// Called by dnsmasqConfigurator when the dnsmasq item for a linux bridge
+// is (re)created, e.g. when the ipsets or the upstream DNS servers change.
+func createDnsmasqConfiglet(dnsmasq dnsmasqItem) error {
+
+	bridgeName := dnsmasq.bridgeName
+	bridgeIPAddr := dnsmasq.bridgeIPAddr
+	ipsetHosts := dnsmasq.ipsets
+	uplink := dnsmasq.uplink
+	dnsServers := dnsmasq.dnsServers
+	ntpServers := dnsmasq.ntpServers
+	hostsDir := hostsDirpath(bridgeName)
+	log.Functionf("createDnsmasqConfiglet(%s, %s) ipsetHosts %v uplink %s dnsServers %v ntpServers %v",
+		bridgeName, bridgeIPAddr, ipsetHosts, uplink, dnsServers, ntpServers)
 
 	cfgPathname := dnsmasqConfigPath(bridgeName)
 	// Delete if it exists
@@ -125,7 +127,7 @@ func createDnsmasqConfiglet(
 	}
 	file, err := os.Create(cfgPathname)
 	if err != nil {
-		log.Fatal("createDnsmasqConfiglet failed ", err)
+		return fmt.Errorf("createDnsmasqConfiglet failed: %v", err)
 	}
 	defer file.Close()
 
@@ -174,7 +176,7 @@ func createDnsmasqConfiglet(
 	if bridgeIPAddr != "" {
 		ip := net.ParseIP(bridgeIPAddr)
 		if ip == nil {
-			log.Fatalf("createDnsmasqConfiglet failed to parse IP %s",
+			return fmt.Errorf("createDnsmasqConfiglet failed to parse IP %s",
 				bridgeIPAddr)
 		}
 		isIPv6 = (ip.To4() == nil)
@@ -198,38 +200,38 @@ func createDnsmasqConfiglet(
 	advertizeRouter := true
 	var router string
 
-	if netstatus.Logicallabel == "" {
+	if dnsmasq.logicallabel == "" {
 		log.Functionf("Internal switch without external port case, dnsmasq suppress router advertize\n")
 		advertizeRouter = false
-	} else if netstatus.Gateway != nil {
-		if netstatus.Gateway.IsUnspecified() {
+	} else if dnsmasq.gateway != nil {
+		if dnsmasq.gateway.IsUnspecified() {
 			advertizeRouter = false
 		} else {
-			router = netstatus.Gateway.String()
+			router = dnsmasq.gateway.String()
 		}
 	} else if bridgeIPAddr != "" {
 		router = bridgeIPAddr
 	} else {
 		advertizeRouter = false
 	}
-	if netstatus.DomainName != "" {
+	if dnsmasq.domainName != "" {
 		if isIPv6 {
 			file.WriteString(fmt.Sprintf("dhcp-option=option:domain-search,%s\n",
-				netstatus.DomainName))
+				dnsmasq.domainName))
 		} else {
 			file.WriteString(fmt.Sprintf("dhcp-option=option:domain-name,%s\n",
-				netstatus.DomainName))
+				dnsmasq.domainName))
 		}
 	}
 	advertizeDns := false
-	for _, ns := range netstatus.DnsServers {
+	for _, ns := range dnsmasq.niDNSServers {
 		advertizeDns = true
 		file.WriteString(fmt.Sprintf("dhcp-option=option:dns-server,%s\n",
 			ns.String()))
 	}
-	if netstatus.NtpServer != nil {
+	if dnsmasq.niNTPServer != nil {
 		file.WriteString(fmt.Sprintf("dhcp-option=option:ntp-server,%s\n",
-			netstatus.NtpServer.String()))
+			dnsmasq.niNTPServer.String()))
 	} else {
 		ntpStr := ""
 		for _, s := range ntpServers {
@@ -239,11 +241,11 @@ func createDnsmasqConfiglet(
 			file.WriteString("dhcp-option=option:ntp-server" + ntpStr + "\n")
 		}
 	}
-	if netstatus.Subnet.IP != nil {
-		ipv4Netmask = net.IP(netstatus.Subnet.Mask).String()
+	if dnsmasq.subnet.IP != nil {
+		ipv4Netmask = net.IP(dnsmasq.subnet.Mask).String()
 	}
-	if netstatus.Subnet.IP != nil {
-		if advertizeRouter && !ctx.disableDHCPAllOnesNetMask {
+	if dnsmasq.subnet.IP != nil {
+		if advertizeRouter && !dnsmasq.disableDHCPAllOnesNetMask {
 			// Network prefix "255.255.255.255" will force packets to go through
 			// dom0 virtual router that makes the packets pass through ACLs and flow log.
 			file.WriteString(fmt.Sprintf("dhcp-option=option:netmask,%s\n",
@@ -258,11 +260,11 @@ func createDnsmasqConfiglet(
 		if !isIPv6 {
 			file.WriteString(fmt.Sprintf("dhcp-option=option:router,%s\n",
 				router))
-			if !ctx.disableDHCPAllOnesNetMask {
+			if !dnsmasq.disableDHCPAllOnesNetMask {
 				file.WriteString(fmt.Sprintf("dhcp-option=option:classless-static-route,%s/32,%s,%s,%s,%s,%s\n",
 					router, "0.0.0.0",
 					"0.0.0.0/0", router,
-					netstatus.Subnet.String(), router))
+					dnsmasq.subnet.String(), router))
 			}
 		}
 	} else {
@@ -278,8 +280,8 @@ func createDnsmasqConfiglet(
 			file.WriteString(fmt.Sprintf("dhcp-option=option:dns-server\n"))
 		}
 	}
-	if netstatus.DhcpRange.Start != nil {
-		dhcpRange = netstatus.DhcpRange.Start.String()
+	if dnsmasq.dhcpRange.Start != nil {
+		dhcpRange = dnsmasq.dhcpRange.Start.String()
 	}
 	if isIPv6 {
 		file.WriteString(fmt.Sprintf("dhcp-range=::,static,0,60m\n"))
@@ -287,6 +289,7 @@ func createDnsmasqConfiglet(
 		file.WriteString(fmt.Sprintf("dhcp-range=%s,static,%s,60m\n",
 			dhcpRange, ipv4Netmask))
 	}
+	return nil
 }
 
 func addhostDnsmasq(bridgeName string, appMac string, appIPAddr string,
@@ -771,19 +774,3 @@ func lookupVifIPTrig(ctx *zedrouterContext, mac string) (net.IP, []net.IP, bool)
 	ipv4Up := !isEmptyIP(vifTrig.IPv4Addr)
 	return vifTrig.IPv4Addr, vifTrig.IPv6Addrs, ipv4Up
 }
-
-// When we restart dnsmasq with smaller changes like chaging DNS server
-// file configuration, we should not delete the hosts configuration for
-// that has the IP address allotment information.
-func deleteOnlyDnsmasqConfiglet(bridgeName string) {
-
-	log.Functionf("deleteOnlyDnsmasqConfiglet(%s)\n", bridgeName)
-	cfgPathname := dnsmasqConfigPath(bridgeName)
-	if _, err := os.Stat(cfgPathname); err == nil {
-		if err := os.Remove(cfgPathname); err != nil {
-			errStr := fmt.Sprintf("deleteOnlyDnsmasqConfiglet %v",
-				err)
-			log.Errorln(errStr)
-		}
-	}
-}
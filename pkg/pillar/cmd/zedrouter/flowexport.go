@@ -0,0 +1,657 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Export of the flow records collected in flowstats.go to a local
+// IPFIX (RFC 7011) or NetFlow v9 (RFC 3954) collector over UDP.
+// The records are queued in a bounded buffer per network instance and
+// sent by a goroutine, dropping them if the buffer is full.
+
+package zedrouter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	ipfixVersion     = 10
+	netflowV9Version = 9
+	ipfixHeaderLen   = 16
+	netflowHeaderLen = 20
+	ipfixTemplateSet = 2
+	netflowTemplate  = 0
+	ipfixVarLen      = 0xffff
+	// RFC 5103 reverse information elements for the bidirectional flows
+	ipfixReversePEN = 29305
+
+	flowExportQueueLen = 4096
+	// stay below the MTU
+	flowExportMaxMsgLen = 1400
+	// the templates are resent since the collector may have restarted
+	flowExportTemplateRefresh = time.Minute
+
+	flowExportTemplateFlow4 = 256
+	flowExportTemplateFlow6 = 257
+	flowExportTemplateDNS4  = 258
+	flowExportTemplateDNS6  = 259
+)
+
+// IANA information elements, the NetFlow v9 field types have the same ids
+const (
+	ieOctetDeltaCount          = 1
+	iePacketDeltaCount         = 2
+	ieProtocolIdentifier       = 4
+	ieSourceTransportPort      = 7
+	ieSourceIPv4Address        = 8
+	ieDestinationTransportPort = 11
+	ieDestinationIPv4Address   = 12
+	ieSourceIPv6Address        = 27
+	ieDestinationIPv6Address   = 28
+	ieFlowDirection            = 61
+	ieForwardingStatus         = 89
+	ieFlowStartMilliseconds    = 152
+	ieFlowEndMilliseconds      = 153
+	// NetFlow v9 only
+	nfLastSwitched  = 21
+	nfFirstSwitched = 22
+	nfOutBytes      = 23
+	nfOutPkts       = 24
+)
+
+// EVE information elements, in FlowExportConfig.EnterpriseNumber
+const (
+	ieEveAppUUID = 1 // octetArray
+	ieEveACLID   = 2 // unsigned32
+	ieEveDNSName = 3 // string, the DNS name of the remote address
+)
+
+// forwardingStatus values, RFC 7270
+const (
+	forwardingStatusForwarded = 0x40
+	forwardingStatusDropped   = 0x80
+)
+
+// flowExportRecord is a flow, or a DNS answer if flow is nil
+type flowExportRecord struct {
+	appUUID uuid.UUID
+	flow    *types.FlowRec
+	dnsName string
+	dnsTime int64 // ns
+	dnsAddr net.IP
+}
+
+type flowExportField struct {
+	id     uint16
+	pen    uint32 // 0 for IANA
+	length uint16 // ipfixVarLen for variable length
+	value  func(rec *flowExportRecord) []byte
+}
+
+type flowExportTemplate struct {
+	id     uint16
+	fields []flowExportField
+}
+
+// flowExportMsg is an encoded message and the number of data and
+// template records in it
+type flowExportMsg struct {
+	data      []byte
+	records   int
+	templates int
+}
+
+// flowExportEncoder encodes the records, it keeps the sequence number
+// and the last time the templates were sent
+type flowExportEncoder struct {
+	protocol      types.FlowExportProtocol
+	domainID      uint32
+	startTime     time.Time // for the NetFlow v9 sysUptime
+	sequence      uint32
+	lastTemplates time.Time
+	templates     []flowExportTemplate
+}
+
+// flowExporter sends the records of a network instance to its collector
+type flowExporter struct {
+	config   types.FlowExportConfig
+	domainID uint32
+	records  chan flowExportRecord
+	done     chan struct{}
+	// counters, updated with sync/atomic
+	exported   uint64
+	dropped    uint64
+	sendErrors uint64
+}
+
+func u8(v uint8) []byte {
+	return []byte{v}
+}
+
+func u16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func u32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func u64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func newFlowExportEncoder(config types.FlowExportConfig, domainID uint32,
+	startTime time.Time) *flowExportEncoder {
+
+	e := &flowExportEncoder{
+		protocol:  config.Protocol,
+		domainID:  domainID,
+		startTime: startTime,
+	}
+	ipv4Src := func(rec *flowExportRecord) []byte { return rec.flow.Flow.Src.To4() }
+	ipv4Dst := func(rec *flowExportRecord) []byte { return rec.flow.Flow.Dst.To4() }
+	ipv6Src := func(rec *flowExportRecord) []byte { return rec.flow.Flow.Src.To16() }
+	ipv6Dst := func(rec *flowExportRecord) []byte { return rec.flow.Flow.Dst.To16() }
+	common := []flowExportField{
+		{id: ieSourceTransportPort, length: 2, value: func(rec *flowExportRecord) []byte {
+			return u16(uint16(rec.flow.Flow.SrcPort))
+		}},
+		{id: ieDestinationTransportPort, length: 2, value: func(rec *flowExportRecord) []byte {
+			return u16(uint16(rec.flow.Flow.DstPort))
+		}},
+		{id: ieProtocolIdentifier, length: 1, value: func(rec *flowExportRecord) []byte {
+			return u8(uint8(rec.flow.Flow.Proto))
+		}},
+		{id: ieOctetDeltaCount, length: 8, value: func(rec *flowExportRecord) []byte {
+			return u64(uint64(rec.flow.TxBytes))
+		}},
+		{id: iePacketDeltaCount, length: 8, value: func(rec *flowExportRecord) []byte {
+			return u64(uint64(rec.flow.TxPkts))
+		}},
+		{id: ieFlowDirection, length: 1, value: func(rec *flowExportRecord) []byte {
+			if rec.flow.Inbound {
+				return u8(0) // ingress
+			}
+			return u8(1)
+		}},
+		{id: ieForwardingStatus, length: 1, value: func(rec *flowExportRecord) []byte {
+			if rec.flow.Action == types.ACLActionDrop {
+				return u8(forwardingStatusDropped)
+			}
+			return u8(forwardingStatusForwarded)
+		}},
+	}
+	if config.Protocol == types.FlowExportProtocolNetFlowV9 {
+		common = append(common,
+			flowExportField{id: nfOutBytes, length: 8, value: func(rec *flowExportRecord) []byte {
+				return u64(uint64(rec.flow.RxBytes))
+			}},
+			flowExportField{id: nfOutPkts, length: 8, value: func(rec *flowExportRecord) []byte {
+				return u64(uint64(rec.flow.RxPkts))
+			}},
+			flowExportField{id: nfFirstSwitched, length: 4, value: func(rec *flowExportRecord) []byte {
+				return u32(e.uptime(rec.flow.StartTime))
+			}},
+			flowExportField{id: nfLastSwitched, length: 4, value: func(rec *flowExportRecord) []byte {
+				return u32(e.uptime(rec.flow.StopTime))
+			}})
+		e.templates = []flowExportTemplate{
+			{id: flowExportTemplateFlow4, fields: append([]flowExportField{
+				{id: ieSourceIPv4Address, length: 4, value: ipv4Src},
+				{id: ieDestinationIPv4Address, length: 4, value: ipv4Dst},
+			}, common...)},
+			{id: flowExportTemplateFlow6, fields: append([]flowExportField{
+				{id: ieSourceIPv6Address, length: 16, value: ipv6Src},
+				{id: ieDestinationIPv6Address, length: 16, value: ipv6Dst},
+			}, common...)},
+		}
+		return e
+	}
+
+	common = append(common,
+		flowExportField{id: ieOctetDeltaCount, pen: ipfixReversePEN, length: 8,
+			value: func(rec *flowExportRecord) []byte {
+				return u64(uint64(rec.flow.RxBytes))
+			}},
+		flowExportField{id: iePacketDeltaCount, pen: ipfixReversePEN, length: 8,
+			value: func(rec *flowExportRecord) []byte {
+				return u64(uint64(rec.flow.RxPkts))
+			}},
+		flowExportField{id: ieFlowStartMilliseconds, length: 8, value: func(rec *flowExportRecord) []byte {
+			return u64(uint64(rec.flow.StartTime / int64(time.Millisecond)))
+		}},
+		flowExportField{id: ieFlowEndMilliseconds, length: 8, value: func(rec *flowExportRecord) []byte {
+			return u64(uint64(rec.flow.StopTime / int64(time.Millisecond)))
+		}})
+	pen := config.EnterpriseNumber
+	appUUID := flowExportField{id: ieEveAppUUID, pen: pen, length: 16,
+		value: func(rec *flowExportRecord) []byte {
+			return rec.appUUID.Bytes()
+		}}
+	dnsName := flowExportField{id: ieEveDNSName, pen: pen, length: ipfixVarLen,
+		value: func(rec *flowExportRecord) []byte {
+			return []byte(rec.dnsName)
+		}}
+	if pen != 0 {
+		common = append(common, appUUID,
+			flowExportField{id: ieEveACLID, pen: pen, length: 4,
+				value: func(rec *flowExportRecord) []byte {
+					return u32(uint32(rec.flow.ACLID))
+				}},
+			dnsName)
+	}
+	e.templates = []flowExportTemplate{
+		{id: flowExportTemplateFlow4, fields: append([]flowExportField{
+			{id: ieSourceIPv4Address, length: 4, value: ipv4Src},
+			{id: ieDestinationIPv4Address, length: 4, value: ipv4Dst},
+		}, common...)},
+		{id: flowExportTemplateFlow6, fields: append([]flowExportField{
+			{id: ieSourceIPv6Address, length: 16, value: ipv6Src},
+			{id: ieDestinationIPv6Address, length: 16, value: ipv6Dst},
+		}, common...)},
+	}
+	if pen != 0 {
+		dnsTime := flowExportField{id: ieFlowStartMilliseconds, length: 8,
+			value: func(rec *flowExportRecord) []byte {
+				return u64(uint64(rec.dnsTime / int64(time.Millisecond)))
+			}}
+		e.templates = append(e.templates,
+			flowExportTemplate{id: flowExportTemplateDNS4, fields: []flowExportField{
+				dnsTime, appUUID, dnsName,
+				{id: ieDestinationIPv4Address, length: 4, value: func(rec *flowExportRecord) []byte {
+					return rec.dnsAddr.To4()
+				}},
+			}},
+			flowExportTemplate{id: flowExportTemplateDNS6, fields: []flowExportField{
+				dnsTime, appUUID, dnsName,
+				{id: ieDestinationIPv6Address, length: 16, value: func(rec *flowExportRecord) []byte {
+					return rec.dnsAddr.To16()
+				}},
+			}})
+	}
+	return e
+}
+
+// uptime returns the NetFlow v9 sysUptime in ms of a time in ns
+func (e *flowExportEncoder) uptime(t int64) uint32 {
+	d := time.Unix(0, t).Sub(e.startTime)
+	if d < 0 {
+		return 0
+	}
+	return uint32(d / time.Millisecond)
+}
+
+func (e *flowExportEncoder) template(id uint16) *flowExportTemplate {
+	for i := range e.templates {
+		if e.templates[i].id == id {
+			return &e.templates[i]
+		}
+	}
+	return nil
+}
+
+// recordTemplate returns nil if rec can not be exported with the protocol
+func (e *flowExportEncoder) recordTemplate(rec *flowExportRecord) *flowExportTemplate {
+	if rec.flow == nil {
+		if rec.dnsAddr.To4() != nil {
+			return e.template(flowExportTemplateDNS4)
+		}
+		return e.template(flowExportTemplateDNS6)
+	}
+	if rec.flow.Flow.Src.To4() != nil {
+		return e.template(flowExportTemplateFlow4)
+	}
+	return e.template(flowExportTemplateFlow6)
+}
+
+func (e *flowExportEncoder) encodeRecord(tmpl *flowExportTemplate,
+	rec *flowExportRecord) []byte {
+
+	var data []byte
+	for _, field := range tmpl.fields {
+		value := field.value(rec)
+		if field.length != ipfixVarLen {
+			// e.g. a nil address
+			fixed := make([]byte, field.length)
+			copy(fixed, value)
+			data = append(data, fixed...)
+			continue
+		}
+		if len(value) < 255 {
+			data = append(data, uint8(len(value)))
+		} else {
+			if len(value) > 0xffff-3 {
+				value = value[:0xffff-3]
+			}
+			data = append(data, 255)
+			data = append(data, u16(uint16(len(value)))...)
+		}
+		data = append(data, value...)
+	}
+	return data
+}
+
+func (e *flowExportEncoder) headerLen() int {
+	if e.protocol == types.FlowExportProtocolNetFlowV9 {
+		return netflowHeaderLen
+	}
+	return ipfixHeaderLen
+}
+
+// appendTemplates adds a template set to msg
+func (e *flowExportEncoder) appendTemplates(msg *flowExportMsg) {
+	setStart := len(msg.data)
+	if e.protocol == types.FlowExportProtocolNetFlowV9 {
+		msg.data = append(msg.data, u16(netflowTemplate)...)
+	} else {
+		msg.data = append(msg.data, u16(ipfixTemplateSet)...)
+	}
+	msg.data = append(msg.data, 0, 0) // length
+	for _, tmpl := range e.templates {
+		msg.data = append(msg.data, u16(tmpl.id)...)
+		msg.data = append(msg.data, u16(uint16(len(tmpl.fields)))...)
+		for _, field := range tmpl.fields {
+			if field.pen != 0 {
+				msg.data = append(msg.data, u16(field.id|0x8000)...)
+				msg.data = append(msg.data, u16(field.length)...)
+				msg.data = append(msg.data, u32(field.pen)...)
+			} else {
+				msg.data = append(msg.data, u16(field.id)...)
+				msg.data = append(msg.data, u16(field.length)...)
+			}
+		}
+		msg.templates++
+	}
+	e.finishSet(msg, setStart)
+}
+
+// finishSet pads a NetFlow v9 flowset and sets the length of the set
+func (e *flowExportEncoder) finishSet(msg *flowExportMsg, setStart int) {
+	if e.protocol == types.FlowExportProtocolNetFlowV9 {
+		for (len(msg.data)-setStart)%4 != 0 {
+			msg.data = append(msg.data, 0)
+		}
+	}
+	binary.BigEndian.PutUint16(msg.data[setStart+2:],
+		uint16(len(msg.data)-setStart))
+}
+
+func (e *flowExportEncoder) finishMsg(msg *flowExportMsg, now time.Time) {
+	h := msg.data
+	if e.protocol == types.FlowExportProtocolNetFlowV9 {
+		binary.BigEndian.PutUint16(h[0:], netflowV9Version)
+		binary.BigEndian.PutUint16(h[2:], uint16(msg.records+msg.templates))
+		binary.BigEndian.PutUint32(h[4:], uint32(now.Sub(e.startTime)/time.Millisecond))
+		binary.BigEndian.PutUint32(h[8:], uint32(now.Unix()))
+		// NetFlow v9 counts the messages
+		binary.BigEndian.PutUint32(h[12:], e.sequence)
+		binary.BigEndian.PutUint32(h[16:], e.domainID)
+		e.sequence++
+		return
+	}
+	binary.BigEndian.PutUint16(h[0:], ipfixVersion)
+	binary.BigEndian.PutUint16(h[2:], uint16(len(msg.data)))
+	binary.BigEndian.PutUint32(h[4:], uint32(now.Unix()))
+	// IPFIX counts the data records sent before this message
+	binary.BigEndian.PutUint32(h[8:], e.sequence)
+	binary.BigEndian.PutUint32(h[12:], e.domainID)
+	e.sequence += uint32(msg.records)
+}
+
+// encode returns the messages, of at most flowExportMaxMsgLen bytes, with
+// the records which can be exported with the protocol
+func (e *flowExportEncoder) encode(records []flowExportRecord,
+	now time.Time) []flowExportMsg {
+
+	var msgs []flowExportMsg
+	var msg *flowExportMsg
+	var setID uint16
+	var setStart int
+	withTemplates := now.Sub(e.lastTemplates) >= flowExportTemplateRefresh
+	closeSet := func() {
+		if setID != 0 {
+			e.finishSet(msg, setStart)
+			setID = 0
+		}
+	}
+	for i := range records {
+		tmpl := e.recordTemplate(&records[i])
+		if tmpl == nil {
+			continue
+		}
+		data := e.encodeRecord(tmpl, &records[i])
+		if msg != nil {
+			// the set header and the padding
+			needed := len(data) + 3
+			if setID != tmpl.id {
+				needed += 4
+			}
+			if len(msg.data)+needed > flowExportMaxMsgLen {
+				closeSet()
+				e.finishMsg(msg, now)
+				msgs = append(msgs, *msg)
+				msg = nil
+			}
+		}
+		if msg == nil {
+			msg = &flowExportMsg{data: make([]byte, e.headerLen())}
+			if withTemplates {
+				e.appendTemplates(msg)
+				e.lastTemplates = now
+				withTemplates = false
+			}
+		}
+		if setID != tmpl.id {
+			closeSet()
+			setID = tmpl.id
+			setStart = len(msg.data)
+			msg.data = append(msg.data, u16(setID)...)
+			msg.data = append(msg.data, 0, 0) // length
+		}
+		msg.data = append(msg.data, data...)
+		msg.records++
+	}
+	if msg != nil {
+		closeSet()
+		e.finishMsg(msg, now)
+		msgs = append(msgs, *msg)
+	}
+	return msgs
+}
+
+func newFlowExporter(config types.FlowExportConfig, domainID uint32,
+	counters types.FlowExportMetrics) *flowExporter {
+
+	exp := &flowExporter{
+		config:     config,
+		domainID:   domainID,
+		records:    make(chan flowExportRecord, flowExportQueueLen),
+		done:       make(chan struct{}),
+		exported:   counters.ExportedRecords,
+		dropped:    counters.DroppedRecords,
+		sendErrors: counters.SendErrors,
+	}
+	go exp.run()
+	return exp
+}
+
+func (exp *flowExporter) stop() {
+	close(exp.done)
+}
+
+func (exp *flowExporter) metrics() types.FlowExportMetrics {
+	return types.FlowExportMetrics{
+		ExportedRecords: atomic.LoadUint64(&exp.exported),
+		DroppedRecords:  atomic.LoadUint64(&exp.dropped),
+		SendErrors:      atomic.LoadUint64(&exp.sendErrors),
+	}
+}
+
+func (exp *flowExporter) enqueue(rec flowExportRecord) {
+	select {
+	case exp.records <- rec:
+	default:
+		atomic.AddUint64(&exp.dropped, 1)
+	}
+}
+
+// exportFlow queues a flow of an app, with the DNS name of the remote
+// address if the app resolved it
+func (exp *flowExporter) exportFlow(appUUID uuid.UUID, flow types.FlowRec,
+	dnsEntries []dnsEntry) {
+
+	rec := flowExportRecord{appUUID: appUUID, flow: &flow}
+	for _, entry := range dnsEntries {
+		if !entry.AppIP.Equal(flow.Flow.Src) {
+			continue
+		}
+		for _, addr := range entry.Answers {
+			if addr.Equal(flow.Flow.Dst) {
+				rec.dnsName = entry.DomainName
+			}
+		}
+	}
+	exp.enqueue(rec)
+}
+
+// exportDNS queues a record per answer, only for IPFIX with the EVE
+// information elements
+func (exp *flowExporter) exportDNS(appUUID uuid.UUID, dns types.DNSReq) {
+	if exp.config.Protocol != types.FlowExportProtocolIPFIX ||
+		exp.config.EnterpriseNumber == 0 {
+		return
+	}
+	for _, addr := range dns.Addrs {
+		exp.enqueue(flowExportRecord{appUUID: appUUID, dnsName: dns.HostName,
+			dnsTime: dns.RequestTime, dnsAddr: addr})
+	}
+}
+
+func (exp *flowExporter) run() {
+	encoder := newFlowExportEncoder(exp.config, exp.domainID, time.Now())
+	var conn net.Conn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	for {
+		select {
+		case <-exp.done:
+			return
+		case rec := <-exp.records:
+			// The records come in bursts from FlowStatsCollect
+			batch := []flowExportRecord{rec}
+		drain:
+			for {
+				select {
+				case rec := <-exp.records:
+					batch = append(batch, rec)
+				default:
+					break drain
+				}
+			}
+			if conn == nil {
+				// Resolve the collector again if it failed
+				var err error
+				conn, err = net.Dial("udp", exp.config.Collector)
+				if err != nil {
+					log.Errorf("flowExporter(%s): %v", exp.config.Collector, err)
+					atomic.AddUint64(&exp.sendErrors, 1)
+					atomic.AddUint64(&exp.dropped, uint64(len(batch)))
+					continue
+				}
+			}
+			for _, msg := range encoder.encode(batch, time.Now()) {
+				if _, err := conn.Write(msg.data); err != nil {
+					log.Errorf("flowExporter(%s): %v", exp.config.Collector, err)
+					atomic.AddUint64(&exp.sendErrors, 1)
+					atomic.AddUint64(&exp.dropped, uint64(msg.records))
+					continue
+				}
+				atomic.AddUint64(&exp.exported, uint64(msg.records))
+			}
+		}
+	}
+}
+
+func lookupFlowExporter(ctx *zedrouterContext, niUUID uuid.UUID) *flowExporter {
+	exp, ok := ctx.flowExporters.Load(niUUID)
+	if !ok {
+		return nil
+	}
+	return exp.(*flowExporter)
+}
+
+// flowExportUpdate starts, restarts or stops the exporter of a network
+// instance, the counters are kept across the restarts
+func flowExportUpdate(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	config := status.FlowExport
+	if !status.Activated {
+		config = types.FlowExportConfig{}
+	}
+	domainID := uint32(status.BridgeNum)
+	old := lookupFlowExporter(ctx, status.UUID)
+	if old != nil && old.config == config && old.domainID == domainID {
+		return
+	}
+	var counters types.FlowExportMetrics
+	if old != nil {
+		counters = old.metrics()
+		old.stop()
+		ctx.flowExporters.Delete(status.UUID)
+	}
+	if config.Collector == "" {
+		return
+	}
+	if err := flowExportConfigCheck(config); err != nil {
+		log.Errorf("flowExportUpdate(%s): %v", status.Key(), err)
+		return
+	}
+	log.Noticef("flowExportUpdate(%s): export to %s protocol %d",
+		status.Key(), config.Collector, config.Protocol)
+	ctx.flowExporters.Store(status.UUID,
+		newFlowExporter(config, domainID, counters))
+}
+
+func flowExportStop(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	if exp := lookupFlowExporter(ctx, status.UUID); exp != nil {
+		log.Noticef("flowExportStop(%s)", status.Key())
+		exp.stop()
+		ctx.flowExporters.Delete(status.UUID)
+	}
+}
+
+func flowExportConfigCheck(config types.FlowExportConfig) error {
+	if _, _, err := net.SplitHostPort(config.Collector); err != nil {
+		return fmt.Errorf("invalid collector %s: %v", config.Collector, err)
+	}
+	switch config.Protocol {
+	case types.FlowExportProtocolIPFIX, types.FlowExportProtocolNetFlowV9:
+	default:
+		return fmt.Errorf("unsupported protocol %d", config.Protocol)
+	}
+	return nil
+}
+
+// flowExportMetrics returns the counters of the exporter of a network
+// instance, zero if not exported
+func flowExportMetrics(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) types.FlowExportMetrics {
+
+	if exp := lookupFlowExporter(ctx, status.UUID); exp != nil {
+		return exp.metrics()
+	}
+	return types.FlowExportMetrics{}
+}
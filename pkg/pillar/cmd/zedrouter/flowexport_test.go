@@ -0,0 +1,218 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	uuid "github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+var testFlowExportApp = uuid.FromStringOrNil("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func testFlowExportRecord(src, dst string) flowExportRecord {
+	start := time.Unix(1620000000, 0)
+	return flowExportRecord{
+		appUUID: testFlowExportApp,
+		flow: &types.FlowRec{
+			Flow: types.IPTuple{Src: net.ParseIP(src), Dst: net.ParseIP(dst),
+				SrcPort: 40000, DstPort: 443, Proto: 6},
+			Action:    types.ACLActionAccept,
+			ACLID:     3,
+			StartTime: start.UnixNano(),
+			StopTime:  start.Add(10 * time.Second).UnixNano(),
+			TxBytes:   1000,
+			TxPkts:    10,
+			RxBytes:   5000,
+			RxPkts:    8,
+		},
+		dnsName: "example.com",
+	}
+}
+
+// ipfixSets returns the sets of an IPFIX message by set id
+func ipfixSets(t *testing.T, msg []byte) map[uint16][]byte {
+	if len(msg) < ipfixHeaderLen ||
+		binary.BigEndian.Uint16(msg[0:]) != ipfixVersion ||
+		int(binary.BigEndian.Uint16(msg[2:])) != len(msg) {
+		t.Fatalf("invalid IPFIX header % x", msg)
+	}
+	sets := make(map[uint16][]byte)
+	for data := msg[ipfixHeaderLen:]; len(data) > 0; {
+		if len(data) < 4 {
+			t.Fatalf("truncated set % x", data)
+		}
+		setLen := int(binary.BigEndian.Uint16(data[2:]))
+		if setLen < 4 || setLen > len(data) {
+			t.Fatalf("invalid set length %d", setLen)
+		}
+		sets[binary.BigEndian.Uint16(data[0:])] = data[4:setLen]
+		data = data[setLen:]
+	}
+	return sets
+}
+
+func TestFlowExportIPFIX(t *testing.T) {
+	config := types.FlowExportConfig{Collector: "127.0.0.1:4739",
+		EnterpriseNumber: 32473}
+	e := newFlowExportEncoder(config, 7, time.Now())
+	records := []flowExportRecord{
+		testFlowExportRecord("10.1.0.2", "93.184.216.34"),
+		testFlowExportRecord("10.1.0.2", "93.184.216.35"),
+		{appUUID: testFlowExportApp, dnsName: "example.com",
+			dnsTime: time.Unix(1620000000, 0).UnixNano(),
+			dnsAddr: net.ParseIP("2606:2800:220:1::248")},
+	}
+	now := time.Unix(1620000100, 0)
+	msgs := e.encode(records, now)
+	if len(msgs) != 1 || msgs[0].records != 3 || msgs[0].templates != 4 {
+		t.Fatalf("unexpected messages %+v", msgs)
+	}
+	msg := msgs[0].data
+	if binary.BigEndian.Uint32(msg[4:]) != uint32(now.Unix()) ||
+		binary.BigEndian.Uint32(msg[8:]) != 0 ||
+		binary.BigEndian.Uint32(msg[12:]) != 7 {
+		t.Errorf("unexpected header % x", msg[:ipfixHeaderLen])
+	}
+	sets := ipfixSets(t, msg)
+	if _, ok := sets[ipfixTemplateSet]; !ok {
+		t.Errorf("no template set")
+	}
+	flows := sets[flowExportTemplateFlow4]
+	// 4+4+2+2+1+8+8+1+1+8+8+8+8+16+4 fixed and the DNS name
+	flowLen := 83 + 1 + len("example.com")
+	if len(flows) != 2*flowLen {
+		t.Fatalf("got %d bytes of flows, expected %d", len(flows), 2*flowLen)
+	}
+	flow := flows[:flowLen]
+	if !net.IP(flow[0:4]).Equal(net.ParseIP("10.1.0.2")) ||
+		!net.IP(flow[4:8]).Equal(net.ParseIP("93.184.216.34")) ||
+		binary.BigEndian.Uint16(flow[8:]) != 40000 ||
+		binary.BigEndian.Uint16(flow[10:]) != 443 || flow[12] != 6 ||
+		binary.BigEndian.Uint64(flow[13:]) != 1000 ||
+		binary.BigEndian.Uint64(flow[21:]) != 10 ||
+		flow[29] != 1 || flow[30] != forwardingStatusForwarded ||
+		binary.BigEndian.Uint64(flow[31:]) != 5000 ||
+		binary.BigEndian.Uint64(flow[39:]) != 8 ||
+		binary.BigEndian.Uint64(flow[47:]) != 1620000000000 ||
+		binary.BigEndian.Uint64(flow[55:]) != 1620000010000 ||
+		!uuid.Equal(uuid.FromBytesOrNil(flow[63:79]), testFlowExportApp) ||
+		binary.BigEndian.Uint32(flow[79:]) != 3 ||
+		flow[83] != byte(len("example.com")) ||
+		string(flow[84:]) != "example.com" {
+		t.Errorf("unexpected flow record % x", flow)
+	}
+	dns := sets[flowExportTemplateDNS6]
+	if len(dns) != 8+16+1+len("example.com")+16 ||
+		!net.IP(dns[len(dns)-16:]).Equal(net.ParseIP("2606:2800:220:1::248")) {
+		t.Errorf("unexpected DNS record % x", dns)
+	}
+
+	// No templates until the refresh, the sequence counts the records
+	msgs = e.encode(records[:1], now.Add(time.Second))
+	if len(msgs) != 1 || msgs[0].templates != 0 ||
+		binary.BigEndian.Uint32(msgs[0].data[8:]) != 3 {
+		t.Errorf("unexpected messages %+v", msgs)
+	}
+}
+
+func TestFlowExportNetFlowV9(t *testing.T) {
+	config := types.FlowExportConfig{Collector: "127.0.0.1:2055",
+		Protocol: types.FlowExportProtocolNetFlowV9, EnterpriseNumber: 32473}
+	start := time.Unix(1620000000, 0)
+	e := newFlowExportEncoder(config, 7, start)
+	records := []flowExportRecord{
+		testFlowExportRecord("10.1.0.2", "93.184.216.34"),
+		// DNS records are not exported with NetFlow v9
+		{appUUID: testFlowExportApp, dnsName: "example.com",
+			dnsAddr: net.ParseIP("93.184.216.34")},
+	}
+	now := start.Add(100 * time.Second)
+	msgs := e.encode(records, now)
+	if len(msgs) != 1 || msgs[0].records != 1 || msgs[0].templates != 2 {
+		t.Fatalf("unexpected messages %+v", msgs)
+	}
+	msg := msgs[0].data
+	if binary.BigEndian.Uint16(msg[0:]) != netflowV9Version ||
+		binary.BigEndian.Uint16(msg[2:]) != 3 ||
+		binary.BigEndian.Uint32(msg[4:]) != 100000 ||
+		binary.BigEndian.Uint32(msg[8:]) != uint32(now.Unix()) ||
+		binary.BigEndian.Uint32(msg[16:]) != 7 {
+		t.Errorf("unexpected header % x", msg[:netflowHeaderLen])
+	}
+	for data := msg[netflowHeaderLen:]; len(data) > 0; {
+		setLen := int(binary.BigEndian.Uint16(data[2:]))
+		if setLen%4 != 0 || setLen > len(data) {
+			t.Fatalf("invalid flowset length %d", setLen)
+		}
+		data = data[setLen:]
+	}
+}
+
+func TestFlowExportSplit(t *testing.T) {
+	config := types.FlowExportConfig{Collector: "127.0.0.1:4739"}
+	e := newFlowExportEncoder(config, 1, time.Now())
+	var records []flowExportRecord
+	for i := 0; i < 100; i++ {
+		records = append(records, testFlowExportRecord("10.1.0.2", "10.1.0.3"))
+	}
+	msgs := e.encode(records, time.Now())
+	total := 0
+	for _, msg := range msgs {
+		if len(msg.data) > flowExportMaxMsgLen {
+			t.Errorf("message of %d bytes", len(msg.data))
+		}
+		ipfixSets(t, msg.data)
+		total += msg.records
+	}
+	if len(msgs) < 2 || total != len(records) || msgs[0].templates != 2 ||
+		msgs[1].templates != 0 {
+		t.Errorf("unexpected %d messages with %d records", len(msgs), total)
+	}
+}
+
+func TestFlowExporter(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "zedrouter", 0)
+	collector, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skipf("no UDP: %v", err)
+	}
+	defer collector.Close()
+	config := types.FlowExportConfig{Collector: collector.LocalAddr().String()}
+	exp := newFlowExporter(config, 1, types.FlowExportMetrics{})
+	defer exp.stop()
+	rec := testFlowExportRecord("10.1.0.2", "93.184.216.34")
+	exp.exportFlow(testFlowExportApp, *rec.flow, nil)
+
+	buf := make([]byte, 2048)
+	collector.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, err := collector.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sets := ipfixSets(t, buf[:n]); len(sets[flowExportTemplateFlow4]) == 0 {
+		t.Errorf("no flow in % x", buf[:n])
+	}
+	for i := 0; i < 100 && exp.metrics().ExportedRecords != 1; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if metrics := exp.metrics(); metrics.ExportedRecords != 1 ||
+		metrics.DroppedRecords != 0 {
+		t.Errorf("unexpected metrics %+v", metrics)
+	}
+
+	// The buffer is bounded
+	full := &flowExporter{records: make(chan flowExportRecord, 1)}
+	full.enqueue(rec)
+	full.enqueue(rec)
+	if metrics := full.metrics(); metrics.DroppedRecords != 1 {
+		t.Errorf("unexpected metrics %+v", metrics)
+	}
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
+	"github.com/lf-edge/eve/pkg/pillar/conntrack"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	pcap "github.com/packetcap/go-pcap"
 	uuid "github.com/satori/go.uuid"
@@ -38,6 +39,7 @@ type flowStats struct {
 	TimeStart   int64
 	TimeStop    int64
 	TimeOut     uint32
+	vlanID      uint16
 	aclNum      uint32
 	appNum      uint8
 	drop        bool
@@ -58,8 +60,9 @@ type aclAttr struct {
 }
 
 type bridgeAttr struct {
-	bridge  string
-	netUUID uuid.UUID
+	bridge       string
+	netUUID      uuid.UUID
+	loadBalanced bool
 }
 
 type appInfo struct {
@@ -128,7 +131,7 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 	// Get IPv4/v6 conntrack table flows
 	Protocols := [2]netlink.InetFamily{syscall.AF_INET, syscall.AF_INET6}
 	for _, proto := range Protocols {
-		connT, err := netlink.ConntrackTableList(netlink.ConntrackTable, proto)
+		connT, err := conntrack.TableList(netlink.ConntrackTable, proto)
 		if err != nil {
 			log.Errorf("FlowStats(%d): ContrackTableList", proto)
 			return
@@ -136,8 +139,8 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 
 		log.Tracef("***FlowStats(%d): size of the flows %d", proto, len(connT))
 
-		for _, entry := range connT { // loop through and process current timedout flow collection
-			flowTuple := flowMergeProcess(entry, instData)
+		for i := range connT { // loop through and process current timedout flow collection
+			flowTuple := flowMergeProcess(&connT[i], instData)
 			// flowTuple := FlowMergeTuple(entry, instData, ipToName)
 			if flowTuple.IsTimeOut == false || flowTuple.foundApp == false {
 				continue
@@ -177,6 +180,8 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 			flowdata := types.IPFlow{
 				Scope: scope,
 			}
+			// Also exported to a local collector if configured
+			exporter := lookupFlowExporter(ctx, scope.NetUUID)
 
 			log.Tracef("FlowStats: bnx=%s, appidx %d\n", bnx, appIdx)
 			// temp print out the flow "tuple" and stats per app/bridge
@@ -254,9 +259,13 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 					TxPkts:    int64(tuple.SendPkts),
 					RxBytes:   int64(tuple.RecvBytes),
 					RxPkts:    int64(tuple.RecvPkts),
+					VlanID:    uint32(tuple.vlanID),
 				}
 
 				flowdata.Flows = append(flowdata.Flows, flowrec)
+				if exporter != nil {
+					exporter.exportFlow(scope.UUID, flowrec, dnsEntries)
+				}
 				flowIdx++
 				if flowIdx > maxFlowPack {
 					flowPublish(ctx, &flowdata, &sequence, &flowIdx)
@@ -293,6 +302,9 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 						RequestTime: dnsRec.TimeStamp.UnixNano(),
 					}
 					flowdata.DNSReqs = append(flowdata.DNSReqs, dnsrec)
+					if exporter != nil {
+						exporter.exportDNS(scope.UUID, dnsrec)
+					}
 					flowIdx++
 					if flowIdx > maxFlowPack {
 						flowPublish(ctx, &flowdata, &sequence, &flowIdx)
@@ -311,7 +323,7 @@ func FlowStatsCollect(ctx *zedrouterContext) {
 
 // conntrack flow of two uni-directional stats into one
 // bireditional flow stats
-func flowMergeProcess(entry *netlink.ConntrackFlow, instData networkAttrs) flowStats {
+func flowMergeProcess(entry *conntrack.Flow, instData networkAttrs) flowStats {
 	var ipFlow flowStats
 	var forwSrcApp, forwDstApp, backSrcApp, backDstApp bool
 	var AppNum int
@@ -332,6 +344,8 @@ func flowMergeProcess(entry *netlink.ConntrackFlow, instData networkAttrs) flowS
 	timeStop := time.Now().Add(-(time.Second * time.Duration(timeoutSec-int32(entry.TimeOut))))
 	ipFlow.TimeStop = timeStop.UnixNano()
 	ipFlow.TimeOut = entry.TimeOut
+	// The conntrack zone is the VLAN on switch network instances
+	ipFlow.vlanID = entry.Zone
 	ipFlow.Proto = entry.Forward.Protocol
 	ipFlow.IsTimeOut = true
 
@@ -423,6 +437,12 @@ func flowMergeProcess(entry *netlink.ConntrackFlow, instData networkAttrs) flowS
 	}
 
 	ipFlow.foundApp = true
+	// The connmark of a load balanced network instance has the uplink
+	// of the flow in the ACE ID
+	appinfo := flowGetAppInfo(ipFlow, instData.appIPinfo[AppNum])
+	if instData.bnNet[appinfo.localintf].loadBalanced {
+		ipFlow.aclNum = lbAceID(ipFlow.aclNum)
+	}
 	// If App initiated traffic, forw is sending 'OUT', otherwise forw is receiving 'IN'
 	if ipFlow.AppInitiate {
 		ipFlow.SendPkts = entry.Forward.Packets
@@ -517,6 +537,10 @@ func checkAppAndACL(ctx *zedrouterContext, instData *networkAttrs) {
 				bridge:  ulStatus.Bridge,
 				netUUID: ulconfig.Network,
 			}
+			if netstatus != nil && netstatus.Type == types.NetworkInstanceTypeLocal &&
+				netstatus.UplinkLoadBalancing != types.UplinkLoadBalancingNone {
+				intfAttr.loadBalanced = true
+			}
 			instData.bnNet[ulStatus.Bridge] = intfAttr
 
 			// build an App list cache, used for loop through all the Apps
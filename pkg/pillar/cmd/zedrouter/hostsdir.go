@@ -13,6 +13,12 @@ import (
 	"syscall"
 )
 
+// hostsDirpath returns the hosts directory used by dnsmasq for the bridge
+// Directory is /run/zedrouter/hosts.${BRIDGENAME}
+func hostsDirpath(bridgeName string) string {
+	return runDirname + "/hosts." + bridgeName
+}
+
 // Create the hosts file for the overlay DNS resolution
 // Would be more polite to return an error then to Fatal
 func createHostsConfiglet(cfgDirname string, nameToIPList []types.DnsNameToIP) {
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"reflect"
 	"strings"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
@@ -17,7 +18,6 @@ import (
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
-	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/vishvananda/netlink"
 )
@@ -83,58 +83,6 @@ func disableIcmpRedirects(bridgeName string) {
 	}
 }
 
-// doCreateBridge
-//		returns (error, bridgeMac-string)
-func doCreateBridge(bridgeName string, bridgeNum int,
-	status *types.NetworkInstanceStatus) (error, string) {
-
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Fatalf("bridgeCreate(%s) %s not possible",
-			status.DisplayName, status.BridgeName)
-	}
-	// Start clean
-	// delete the bridge
-	attrs := netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	link := &netlink.Bridge{LinkAttrs: attrs}
-	netlink.LinkDel(link)
-
-	//    ip link add ${bridgeName} type bridge
-	attrs = netlink.NewLinkAttrs()
-	attrs.Name = bridgeName
-	bridgeMac := fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
-	hw, err := net.ParseMAC(bridgeMac)
-	if err != nil {
-		log.Fatal("ParseMAC failed: ", bridgeMac, err)
-	}
-	attrs.HardwareAddr = hw
-	link = &netlink.Bridge{LinkAttrs: attrs}
-	if err := netlink.LinkAdd(link); err != nil {
-		errStr := fmt.Sprintf("LinkAdd on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	//    ip link set ${bridgeName} up
-	if err := netlink.LinkSetUp(link); err != nil {
-		errStr := fmt.Sprintf("LinkSetUp on %s failed: %s",
-			bridgeName, err)
-		return errors.New(errStr), ""
-	}
-	disableIcmpRedirects(bridgeName)
-
-	// Get Ifindex of bridge and store it in network instance status
-	bridgeLink, err := netlink.LinkByName(bridgeName)
-	if err != nil {
-		errStr := fmt.Sprintf("doCreateBridge: LinkByName(%s) failed: %s",
-			bridgeName, err)
-		log.Errorln(errStr)
-		return errors.New(errStr), ""
-	}
-	index := bridgeLink.Attrs().Index
-	status.BridgeIfindex = index
-	return err, bridgeMac
-}
-
 // doLookupBridge is used for switch network instance where nim
 // has created the bridge. All such NIs have an external port.
 //	returns (bridgeName, bridgeMac-string, error)
@@ -174,22 +122,12 @@ func doLookupBridge(ctx *zedrouterContext,
 func networkInstanceBridgeDelete(
 	ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
-	// Here we explicitly delete the iptables rules which are tied to the Linux bridge
-	// itself and not the rules for specific domU vifs.
-
-	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: status.BridgeName,
-		BridgeIP: status.BridgeIPAddr, NIType: status.Type, UpLinks: status.IfNameList}
-	handleNetworkInstanceACLConfiglet("-D", aclArgs)
-
-	if !strings.HasPrefix(status.BridgeName, "bn") {
-		log.Noticef("networkInstanceBridgeDelete(%s) %s ignored",
-			status.DisplayName, status.BridgeName)
-	} else {
-		attrs := netlink.NewLinkAttrs()
-		attrs.Name = status.BridgeName
-		link := &netlink.Bridge{LinkAttrs: attrs}
-		// Remove link and associated addresses
-		netlink.LinkDel(link)
+	// Remove the bridge (unless created by nim), dnsmasq, and the iptables
+	// chains and route table tied to the Linux bridge itself.
+	// Rules for specific domU vifs are removed by doBridgeAclsDelete.
+	if err := removeNetworkInstanceState(ctx, status); err != nil {
+		log.Errorf("networkInstanceBridgeDelete(%s): %v",
+			status.DisplayName, err)
 	}
 
 	if status.BridgeNum != 0 {
@@ -309,6 +247,7 @@ func handleNetworkInstanceCreate(
 			IPAssignments: make(map[string]types.AssignedAddrs),
 			VifMetricMap:  make(map[string]types.NetworkMetric),
 			VlanMap:       make(map[uint32]uint32),
+			TrunkVlanMap:  make(map[uint32]uint32),
 		},
 	}
 	appNumOnUNetBaseCreate(status.UUID)
@@ -353,6 +292,8 @@ func handleNetworkInstanceCreate(
 		} else {
 			log.Functionf("Activated network instance %s %s", status.UUID, status.DisplayName)
 			status.Activated = true
+			networkInstanceShapingUpdate(ctx, &status)
+			flowExportUpdate(ctx, &status)
 		}
 	}
 
@@ -424,50 +365,29 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	// Allocate bridgeNum.
 	bridgeNum := bridgeNumAllocate(ctx, status.UUID)
 	status.BridgeNum = bridgeNum
-	bridgeMac := ""
-	var bridgeName string
-	var err error
 
 	switch status.Type {
 	case types.NetworkInstanceTypeLocal, types.NetworkInstanceTypeCloud:
-		bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-		status.BridgeName = bridgeName
-		if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
-			return err
-		}
+		status.BridgeName = fmt.Sprintf("bn%d", bridgeNum)
+		status.BridgeMac = niBridgeMac(bridgeNum)
 
 	case types.NetworkInstanceTypeSwitch:
 		if status.CurrentUplinkIntf == "" {
 			// Create a local-only bridge
-			bridgeName = fmt.Sprintf("bn%d", bridgeNum)
-			status.BridgeName = bridgeName
-			if err, bridgeMac = doCreateBridge(bridgeName, bridgeNum, status); err != nil {
-				return err
-			}
+			status.BridgeName = fmt.Sprintf("bn%d", bridgeNum)
+			status.BridgeMac = niBridgeMac(bridgeNum)
 		} else {
 			// Find bridge created by nim
-			if bridgeName, bridgeMac, err = doLookupBridge(ctx, status); err != nil {
+			bridgeName, bridgeMac, err := doLookupBridge(ctx, status)
+			if err != nil {
 				// We will retry later
 				return err
 			}
 			status.BridgeName = bridgeName
+			status.BridgeMac = bridgeMac
 		}
 	}
-
-	// Get Ifindex of bridge and store it in network instance status
-	bridgeLink, err := netlink.LinkByName(bridgeName)
-	if err != nil {
-		err = fmt.Errorf("doNetworkInstanceCreate: LinkByName(%s) failed: %v",
-			bridgeName, err)
-		log.Error(err)
-		return err
-	}
-	status.BridgeIfindex = bridgeLink.Attrs().Index
-
-	status.BridgeMac = bridgeMac
-	publishNetworkInstanceStatus(ctx, status)
-
-	log.Functionf("bridge created. BridgeMac: %s\n", bridgeMac)
+	bridgeName := status.BridgeName
 
 	if err := setBridgeIPAddr(ctx, status); err != nil {
 		return err
@@ -475,33 +395,33 @@ func doNetworkInstanceCreate(ctx *zedrouterContext,
 	log.Functionf("IpAddress set for bridge\n")
 
 	// Create a hosts directory for the new bridge
-	// Directory is /run/zedrouter/hosts.${BRIDGENAME}
-	hostsDirpath := runDirname + "/hosts." + bridgeName
-	deleteHostsConfiglet(hostsDirpath, false)
-	createHostsConfiglet(hostsDirpath,
-		status.DnsNameToIPList)
+	hostsDir := hostsDirpath(bridgeName)
+	deleteHostsConfiglet(hostsDir, false)
+	createHostsConfiglet(hostsDir, status.DnsNameToIPList)
 
 	if status.BridgeIPAddr != "" {
 		// XXX arbitrary name "router"!!
-		addToHostsConfiglet(hostsDirpath, "router",
+		addToHostsConfiglet(hostsDir, "router",
 			[]string{status.BridgeIPAddr})
 	}
 
-	// Start clean
-	deleteDnsmasqConfiglet(bridgeName)
-	stopDnsmasq(bridgeName, false, false)
+	// Create the bridge and start dnsmasq
+	if err := reconcileNetworkInstance(ctx, status, false); err != nil {
+		log.Errorf("doNetworkInstanceCreate(%s): %v", status.Key(), err)
+		return err
+	}
 
-	if status.BridgeIPAddr != "" {
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			status.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			status.BridgeIPAddr, status,
-			hostsDirpath, status.BridgeIPSets,
-			status.CurrentUplinkIntf, dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
+	// Get Ifindex of bridge and store it in network instance status
+	bridgeIfindex, err := niBridgeIfindex(bridgeName)
+	if err != nil {
+		err = fmt.Errorf("doNetworkInstanceCreate: %v", err)
+		log.Error(err)
+		return err
 	}
+	status.BridgeIfindex = bridgeIfindex
+	publishNetworkInstanceStatus(ctx, status)
+
+	log.Functionf("bridge created. BridgeMac: %s\n", status.BridgeMac)
 
 	// monitor the DNS and DHCP information
 	log.Functionf("Creating %s at %s", "DNSDhcpMonitor", agentlog.GetMyStack())
@@ -700,6 +620,34 @@ func doNetworkInstanceModify(ctx *zedrouterContext,
 		return err
 	}
 
+	if config.Shaping != status.Shaping {
+		log.Functionf("doNetworkInstanceModify: key %s shaping from %+v to %+v",
+			config.UUID, status.Shaping, config.Shaping)
+		status.Shaping = config.Shaping
+		networkInstanceShapingUpdate(ctx, status)
+	}
+	if config.UplinkLoadBalancing != status.UplinkLoadBalancing ||
+		!reflect.DeepEqual(config.UplinkWeights, status.UplinkWeights) {
+		log.Functionf("doNetworkInstanceModify: key %s uplink load balancing from %d %v to %d %v",
+			config.UUID, status.UplinkLoadBalancing, status.UplinkWeights,
+			config.UplinkLoadBalancing, config.UplinkWeights)
+		status.UplinkLoadBalancing = config.UplinkLoadBalancing
+		status.UplinkWeights = config.UplinkWeights
+		probeMutex.Lock()
+		lbCheckStatus(ctx, status)
+		probeMutex.Unlock()
+		if status.Activated &&
+			!lbUplinksEqual(status.ProgLBUplinks, status.LBUplinks) {
+			doNetworkInstanceFallback(ctx, status)
+		}
+	}
+	if config.FlowExport != status.FlowExport {
+		log.Functionf("doNetworkInstanceModify: key %s flow export from %+v to %+v",
+			config.UUID, status.FlowExport, config.FlowExport)
+		status.FlowExport = config.FlowExport
+		flowExportUpdate(ctx, status)
+	}
+
 	if config.Activate && !status.Activated {
 		err := doNetworkInstanceActivate(ctx, status)
 		if err != nil {
@@ -710,6 +658,8 @@ func doNetworkInstanceModify(ctx *zedrouterContext,
 			return err
 		}
 		status.Activated = true
+		networkInstanceShapingUpdate(ctx, status)
+		flowExportUpdate(ctx, status)
 	} else if status.Activated && !config.Activate {
 		doNetworkInstanceInactivate(ctx, status)
 		status.Activated = false
@@ -782,46 +732,6 @@ func haveSwitchNetworkInstances(ctx *zedrouterContext) bool {
 	return false
 }
 
-func restartDnsmasq(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
-
-	log.Functionf("restartDnsmasq(%s) ipsets %v\n",
-		status.BridgeName, status.BridgeIPSets)
-	bridgeName := status.BridgeName
-	stopDnsmasq(bridgeName, false, true)
-
-	hostsDirpath := runDirname + "/hosts." + bridgeName
-	// XXX arbitrary name "router"!!
-	addToHostsConfiglet(hostsDirpath, "router",
-		[]string{status.BridgeIPAddr})
-
-	// Use existing BridgeIPSets
-	dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-		status.CurrentUplinkIntf)
-	createDnsmasqConfiglet(ctx, bridgeName, status.BridgeIPAddr,
-		status, hostsDirpath, status.BridgeIPSets,
-		status.CurrentUplinkIntf, dnsServers, ntpServers)
-	createHostDnsmasqFile(ctx, bridgeName)
-	startDnsmasq(bridgeName)
-}
-
-func createHostDnsmasqFile(ctx *zedrouterContext, bridge string) {
-	pub := ctx.pubAppNetworkStatus
-	items := pub.GetAll()
-	for _, st := range items {
-		status := st.(types.AppNetworkStatus)
-		for _, ulStatus := range status.UnderlayNetworkList {
-			if strings.Compare(bridge, ulStatus.Bridge) != 0 {
-				continue
-			}
-			addhostDnsmasq(bridge, ulStatus.Mac,
-				ulStatus.AllocatedIPv4Addr, status.UUIDandVersion.UUID.String())
-			log.Functionf("createHostDnsmasqFile:(%s) mac=%s, IP=%s\n", bridge, ulStatus.Mac, ulStatus.AllocatedIPv4Addr)
-		}
-	}
-}
-
 // Returns an IP address as a string, or "" for switch networks or, on error
 func lookupOrAllocateIPv4(status *types.NetworkInstanceStatus,
 	appID uuid.UUID, appNum int, mac net.HardwareAddr) (string, error) {
@@ -958,39 +868,14 @@ func setBridgeIPAddr(
 		return nil
 	}
 
-	// Get the linux interface with the attributes.
-	// This is used to add an IP Address below.
-	link, _ := netlink.LinkByName(status.BridgeName)
-	if link == nil {
-		// XXX..Why would this fail? Should this be Fatal instead??
-		errStr := fmt.Sprintf("Failed to get link for Bridge %s", status.BridgeName)
-		return errors.New(errStr)
-	}
-	log.Functionf("Bridge: %s, Link: %+v\n", status.BridgeName, link)
-
-	var err error
-
 	// Assign the gateway Address as the bridge IP address
-	var bridgeMac net.HardwareAddr
-
-	switch link.(type) {
-	case *netlink.Bridge:
-		// XXX always true?
-		bridgeLink := link.(*netlink.Bridge)
-		bridgeMac = bridgeLink.HardwareAddr
-	default:
-		// XXX - Same here.. Should be Fatal??
-		errStr := fmt.Sprintf("Not a bridge %s",
-			status.BridgeName)
-		return errors.New(errStr)
-	}
+	bridgeMac := status.BridgeMac
 	if status.Gateway != nil {
 		ipAddr = status.Gateway.String()
 		addrs := types.AssignedAddrs{IPv4Addr: status.Gateway}
-		status.IPAssignments[bridgeMac.String()] = addrs
+		status.IPAssignments[bridgeMac] = addrs
 	}
-	log.Functionf("BridgeMac: %s, ipAddr: %s\n",
-		bridgeMac.String(), ipAddr)
+	log.Functionf("BridgeMac: %s, ipAddr: %s\n", bridgeMac, ipAddr)
 
 	// outside of subnet, flag it
 	if !status.Subnet.Contains(status.Gateway) {
@@ -1007,22 +892,32 @@ func setBridgeIPAddr(
 		return errors.New(errStr)
 	}
 
-	prefixLen := getPrefixLenForBridgeIP(status)
-	if err = doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
-		log.Errorf("Failed to configure IPAddr on Interface\n")
-		return err
+	// Bridges created by zedrouter get their address assigned by
+	// reconcileNetworkInstance. Bridges created by nim are not managed
+	// by zedrouter, hence we add the address here.
+	if !strings.HasPrefix(status.BridgeName, "bn") {
+		link, _ := netlink.LinkByName(status.BridgeName)
+		if link == nil {
+			errStr := fmt.Sprintf("Failed to get link for Bridge %s",
+				status.BridgeName)
+			return errors.New(errStr)
+		}
+		if _, ok := link.(*netlink.Bridge); !ok {
+			errStr := fmt.Sprintf("Not a bridge %s", status.BridgeName)
+			return errors.New(errStr)
+		}
+		prefixLen := getPrefixLenForBridgeIP(status)
+		if err := doConfigureIpAddrOnInterface(ipAddr, prefixLen, link); err != nil {
+			log.Errorf("Failed to configure IPAddr on Interface\n")
+			return err
+		}
 	}
 
 	status.BridgeIPAddr = ipAddr
 	addr := net.ParseIP(ipAddr)
-	recordIPAssignment(ctx, status, addr, bridgeMac.String())
+	recordIPAssignment(ctx, status, addr, bridgeMac)
 	log.Functionf("Published NetworkStatus. BridgeIpAddr: %s\n",
 		status.BridgeIPAddr)
-	// Create new radvd configuration and restart radvd if ipv6
-	if status.IsIPv6() {
-		log.Functionf("Restart Radvd\n")
-		restartRadvdWithNewConfig(status.BridgeName)
-	}
 	return nil
 }
 
@@ -1041,9 +936,16 @@ func updateBridgeIPAddr(
 		return
 	}
 	if status.BridgeIPAddr != old && status.BridgeIPAddr != "" {
-		log.Functionf("updateBridgeIPAddr(%s) restarting dnsmasq\n",
+		log.Functionf("updateBridgeIPAddr(%s) reconciling network instance\n",
 			status.Key())
-		restartDnsmasq(ctx, status)
+		if err := reconcileNetworkInstance(ctx, status, status.Activated); err != nil {
+			log.Errorf("updateBridgeIPAddr(%s): %v", status.Key(), err)
+		}
+		// Create new radvd configuration and restart radvd if ipv6
+		if status.IsIPv6() {
+			log.Functionf("Restart Radvd\n")
+			restartRadvdWithNewConfig(status.BridgeName)
+		}
 	}
 	// TBD:XXX if no ip Addr, we may need to stop the dns
 }
@@ -1238,7 +1140,7 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 		// switched NI is not probed and does not have a CurrentUplinkIntf
 		status.IfNameList = getIfNameListForLLOrIfname(ctx, status.Logicallabel)
 	} else {
-		status.IfNameList = getIfNameListForLLOrIfname(ctx, status.CurrentUplinkIntf)
+		status.IfNameList = lbIfNameList(ctx, status)
 	}
 	log.Functionf("IfNameList: %+v", status.IfNameList)
 	switch status.Type {
@@ -1247,18 +1149,9 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 		if err != nil {
 			updateBridgeIPAddr(ctx, status)
 		}
-		// Drop external connection request to meta-data server
-		portName := "k" + status.BridgeName
-		link, _ := netlink.LinkByName(portName)
-		if link != nil {
-			err = iptables.IptableCmd(log, "-t", "filter",
-				"-A", "INPUT", "-i", status.BridgeName,
-				"-p", "tcp", "--dport", "80", "-m", "physdev",
-				"--physdev-in", portName, "-j", "DROP")
-			if err != nil {
-				log.Errorf("doNetworkInstanceActivate: Failed adding Iptables command that rejects external connections to meta-data store: %s", err)
-			}
-		}
+		// The bridge or its port could be new, set all the vlans
+		status.UplinkVlans = nil
+		updateSwitchUplinkVlans(status)
 	case types.NetworkInstanceTypeLocal:
 		err = natActivate(ctx, status)
 
@@ -1270,6 +1163,12 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 			status.Type)
 		err = errors.New(errStr)
 	}
+	if err == nil {
+		// Setup NAT, PBR, the metadata server protection and the ACLs
+		// tied to the Linux bridge itself (and not to any specific
+		// domU vifs).
+		err = reconcileNetworkInstance(ctx, status, true)
+	}
 	if err == nil && !status.Server4Running {
 		switch status.IpType {
 		case types.AddressTypeIPV4:
@@ -1302,13 +1201,7 @@ func doNetworkInstanceActivate(ctx *zedrouterContext,
 	}
 
 	status.ProgUplinkIntf = status.CurrentUplinkIntf
-	// setup the ACLs for the bridge
-	// Here we explicitly adding the iptables rules, to the bottom of the
-	// rule chains, which are tied to the Linux bridge itself and not the
-	//  rules for any specific domU vifs.
-	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: status.BridgeName,
-		BridgeIP: status.BridgeIPAddr, NIType: status.Type, UpLinks: status.IfNameList}
-	handleNetworkInstanceACLConfiglet("-A", aclArgs)
+	status.ProgLBUplinks = status.LBUplinks
 	return err
 }
 
@@ -1379,25 +1272,16 @@ func doNetworkInstanceInactivate(
 		status.UUID, status.Type)
 
 	bridgeInactivateforNetworkInstance(ctx, status)
+	shapingDelete(ctx, status.BridgeName)
+	flowExportStop(ctx, status)
 	switch status.Type {
-	case types.NetworkInstanceTypeLocal:
-		natInactivate(ctx, status, false)
 	case types.NetworkInstanceTypeCloud:
 		vpnInactivate(ctx, status)
-	case types.NetworkInstanceTypeSwitch:
-		portName := "k" + status.BridgeName
-		link, _ := netlink.LinkByName(portName)
-		if link != nil {
-			err := iptables.IptableCmd(log, "-t", "filter",
-				"-D", "INPUT", "-i", status.BridgeName,
-				"-p", "tcp", "--dport", "80", "-m", "physdev",
-				"--physdev-in", portName, "-j", "DROP")
-			if err != nil {
-				log.Errorf("doNetworkInstanceInactivate: Failed deleting Iptables command that rejects external connections to meta-data store: %s", err)
-			}
-		}
 	}
-
+	// Remove NAT, PBR and the bridge ACLs
+	if err := reconcileNetworkInstance(ctx, status, false); err != nil {
+		log.Errorf("doNetworkInstanceInactivate(%s): %v", status.Key(), err)
+	}
 	return
 }
 
@@ -1427,8 +1311,6 @@ func doNetworkInstanceDelete(
 	}
 	doBridgeAclsDelete(ctx, status)
 	if status.BridgeName != "" {
-		stopDnsmasq(status.BridgeName, false, false)
-
 		if status.IsIPv6() {
 			stopRadvd(status.BridgeName, true)
 		}
@@ -1443,6 +1325,8 @@ func doNetworkInstanceDelete(
 			releaseIPv4FromNetworkInstance(ctx, status, mac)
 		}
 	}
+	shapingDelete(ctx, status.BridgeName)
+	flowExportStop(ctx, status)
 	networkInstanceBridgeDelete(ctx, status)
 	appNumOnUNetBaseDelete(ctx, status.UUID)
 }
@@ -1497,9 +1381,11 @@ func createNetworkInstanceMetrics(ctx *zedrouterContext,
 
 	niMetrics.VlanMetrics.NumTrunkPorts = status.NumTrunkPorts
 	niMetrics.VlanMetrics.VlanCounts = status.VlanMap
+	niMetrics.VlanMetrics.TrunkVlanCounts = status.TrunkVlanMap
+	niMetrics.FlowExport = flowExportMetrics(ctx, status)
 	switch status.Type {
 	case types.NetworkInstanceTypeCloud:
-		if strongSwanVpnStatusGet(ctx, status, &niMetrics) {
+		if vpnStatusGet(ctx, status, &niMetrics) {
 			publishNetworkInstanceStatus(ctx, status)
 		}
 	default:
@@ -1646,73 +1532,27 @@ func bridgeInactivateforNetworkInstance(ctx *zedrouterContext,
 
 // ==== Nat
 
+// natActivate checks the uplink of a local network instance.
+// NAT and PBR are configured by reconcileNetworkInstance.
 // When the uplink port changes, doNetworkInstanceFallback will redo
 // this function.
 func natActivate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) error {
 
 	log.Functionf("natActivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
 
-	// status.IfNameList should not have more than one interface name.
-	// Put a check anyway.
-	// XXX Remove the loop below in future when we have reasonable stability in code.
-	if len(status.IfNameList) > 1 {
+	// status.IfNameList should not have more than one interface name
+	// unless the uplinks are load balanced. Put a check anyway.
+	if len(status.IfNameList) > 1 && len(status.LBUplinks) == 0 {
 		errStr := fmt.Sprintf("Network instance can have ONE interface active at the most,"+
 			" but we have %d active interfaces.", len(status.IfNameList))
 		log.Errorf(errStr)
 		err := errors.New(errStr)
 		return err
 	}
-	for _, a := range status.IfNameList {
-		log.Functionf("Adding iptables rules for %s \n", a)
-		err := iptables.IptableCmd(log, "-t", "nat", "-A", "POSTROUTING", "-o", a,
-			"-s", subnetStr, "-j", "MASQUERADE")
-		if err != nil {
-			log.Errorf("IptableCmd failed: %s", err)
-			return err
-		}
-		err = PbrRouteAddAll(status.BridgeName, a)
-		if err != nil {
-			log.Errorf("PbrRouteAddAll for Bridge(%s) and interface %s failed. "+
-				"Err: %s", status.BridgeName, a, err)
-			return err
-		}
-		devicenetwork.AddGatewaySourceRule(log, status.Subnet,
-			net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
-		devicenetwork.AddSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
-		devicenetwork.AddInwardSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatInPrio)
-	}
 	return nil
 }
 
-func natInactivate(ctx *zedrouterContext,
-	status *types.NetworkInstanceStatus, inActivateOld bool) {
-
-	log.Functionf("natInactivate(%s)\n", status.DisplayName)
-	subnetStr := status.Subnet.String()
-	var oldUplinkIntf string
-	if inActivateOld {
-		// XXX Should we instead use status.ProgUplinkIntf
-		oldUplinkIntf = status.PrevUplinkIntf
-	} else {
-		oldUplinkIntf = status.CurrentUplinkIntf
-	}
-	err := iptables.IptableCmd(log, "-t", "nat", "-D", "POSTROUTING", "-o", oldUplinkIntf,
-		"-s", subnetStr, "-j", "MASQUERADE")
-	if err != nil {
-		log.Errorf("natInactivate: iptableCmd failed %s\n", err)
-	}
-	devicenetwork.DelGatewaySourceRule(log, status.Subnet,
-		net.ParseIP(status.BridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
-	devicenetwork.DelSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatOutPrio)
-	devicenetwork.DelInwardSourceRule(log, status.BridgeIfindex, status.Subnet, true, devicenetwork.PbrNatInPrio)
-	err = PbrRouteDeleteAll(status.BridgeName, oldUplinkIntf)
-	if err != nil {
-		log.Errorf("natInactivate: PbrRouteDeleteAll failed %s\n", err)
-	}
-}
-
 func natDelete(status *types.NetworkInstanceStatus) {
 
 	log.Functionf("natDelete(%s)\n", status.DisplayName)
@@ -1777,6 +1617,9 @@ func vpnCreate(ctx *zedrouterContext,
 	if status.OpaqueConfig == "" {
 		return errors.New("Vpn network instance create, invalid config")
 	}
+	if status.VpnType == types.VpnTypeWireGuard {
+		return wireGuardNetworkInstanceCreate(ctx, status)
+	}
 	return strongswanNetworkInstanceCreate(ctx, status)
 }
 
@@ -1785,21 +1628,43 @@ func vpnActivate(ctx *zedrouterContext,
 	if status.OpaqueConfig == "" {
 		return errors.New("Vpn network instance activate, invalid config")
 	}
+	if status.VpnType == types.VpnTypeWireGuard {
+		return wireGuardNetworkInstanceActivate(ctx, status)
+	}
 	return strongswanNetworkInstanceActivate(ctx, status)
 }
 
 func vpnInactivate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
 
+	if status.VpnType == types.VpnTypeWireGuard {
+		wireGuardNetworkInstanceInactivate(ctx, status)
+		return
+	}
 	strongswanNetworkInstanceInactivate(ctx, status)
 }
 
 func vpnDelete(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) {
 
+	if status.VpnType == types.VpnTypeWireGuard {
+		wireGuardNetworkInstanceDestroy(ctx, status)
+		return
+	}
 	strongswanNetworkInstanceDestroy(ctx, status)
 }
 
+// vpnStatusGet updates the VpnStatus and fills the VpnMetrics,
+// returns whether the status changed
+func vpnStatusGet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, nis *types.NetworkInstanceMetrics) bool {
+
+	if status.VpnType == types.VpnTypeWireGuard {
+		return wireGuardVpnStatusGet(ctx, status, nis)
+	}
+	return strongSwanVpnStatusGet(ctx, status, nis)
+}
+
 func strongswanNetworkInstanceCreate(ctx *zedrouterContext,
 	status *types.NetworkInstanceStatus) error {
 
@@ -1945,7 +1810,8 @@ func checkAndReprogramNetworkInstances(ctx *zedrouterContext) {
 		if !status.NeedIntfUpdate {
 			continue
 		}
-		if status.ProgUplinkIntf == status.CurrentUplinkIntf {
+		if status.ProgUplinkIntf == status.CurrentUplinkIntf &&
+			lbUplinksEqual(status.ProgLBUplinks, status.LBUplinks) {
 			log.Functionf("checkAndReprogramNetworkInstances: Uplink (%s) has not changed"+
 				" for network instance %s",
 				status.CurrentUplinkIntf, status.DisplayName)
@@ -1980,7 +1846,7 @@ func doNetworkInstanceFallback(
 
 	var err error
 	// Get a list of IfNames to the ones we have an ifIndex for.
-	status.IfNameList = getIfNameListForLLOrIfname(ctx, status.CurrentUplinkIntf)
+	status.IfNameList = lbIfNameList(ctx, status)
 	publishNetworkInstanceStatus(ctx, status)
 	log.Functionf("IfNameList: %+v", status.IfNameList)
 
@@ -1989,29 +1855,21 @@ func doNetworkInstanceFallback(
 		if !status.Activated {
 			return nil
 		}
-		natInactivate(ctx, status, true)
 		err = natActivate(ctx, status)
 		if err != nil {
 			log.Errorf("doNetworkInstanceFallback: %s", err)
 		}
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
+		status.ProgLBUplinks = status.LBUplinks
 
-		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
+		// Move NAT and PBR to the current uplink and use dns server
+		// received from DHCP for the current uplink
+		if reconcileErr := reconcileNetworkInstance(ctx, status,
+			status.Activated); reconcileErr != nil {
+			log.Errorf("doNetworkInstanceFallback: %s", reconcileErr)
+			if err == nil {
+				err = reconcileErr
+			}
 		}
 
 		// Go through the list of all application connected to this network instance
@@ -2051,22 +1909,14 @@ func doNetworkInstanceFallback(
 		}
 		status.ProgUplinkIntf = status.CurrentUplinkIntf
 
-		// Use dns server received from DHCP for the current uplink
-		bridgeName := status.BridgeName
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		deleteOnlyDnsmasqConfiglet(bridgeName)
-		stopDnsmasq(bridgeName, false, false)
-
-		if status.BridgeIPAddr != "" {
-			dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-				status.CurrentUplinkIntf)
-			createDnsmasqConfiglet(ctx, bridgeName,
-				status.BridgeIPAddr, status,
-				hostsDirpath, status.BridgeIPSets,
-				status.CurrentUplinkIntf, dnsServers, ntpServers)
-			startDnsmasq(bridgeName)
+		// Move NAT and PBR to the current uplink and use dns server
+		// received from DHCP for the current uplink
+		if reconcileErr := reconcileNetworkInstance(ctx, status,
+			status.Activated); reconcileErr != nil {
+			log.Errorf("doNetworkInstanceFallback: %s", reconcileErr)
+			if err == nil {
+				err = reconcileErr
+			}
 		}
 
 		// Go through the list of all application connected to this network instance
@@ -110,6 +110,7 @@ func getNetworkMetrics(ctx *zedrouterContext) types.NetworkMetrics {
 			bridgeName, vifName, ipVer, inout)
 		metric.RxAclRateLimitDrops = iptables.GetIPRuleACLRateLimitDrop(log, ac,
 			bridgeName, vifName, ipVer, !inout)
+		shapingMetricsGet(ctx, ni.Name, &metric)
 		metrics = append(metrics, metric)
 	}
 	return types.NetworkMetrics{MetricList: metrics, TotalRuleCount: uint64(len(ac))}
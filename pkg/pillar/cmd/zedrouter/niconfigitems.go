@@ -0,0 +1,914 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Configuration items used to describe network instances as an intended-state
+// graph (see libs/depgraph), together with the Configurators which apply them
+// through libs/reconciler (see nireconciler.go).
+
+package zedrouter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"syscall"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/devicenetwork"
+	"github.com/lf-edge/eve/pkg/pillar/iptables"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+// Item types of the network-instance graph.
+const (
+	bridgeTypename           = "Bridge"
+	vifTypename              = "VIF"
+	dnsmasqTypename          = "Dnsmasq"
+	iptablesChainTypename    = "IptablesChain"
+	ebtablesChainTypename    = "EbtablesChain"
+	routeTableTypename       = "RouteTable"
+	uplinkRouteTableTypename = "UplinkRouteTable"
+)
+
+// ==== Bridge
+
+// bridgeItem is a Linux bridge used by a network instance.
+// Bridges of switch network instances with an external port are created
+// by nim and are therefore represented as external items.
+type bridgeItem struct {
+	bridgeName string
+	macAddr    string
+	// ipAddr is the bridge IP address in CIDR notation, empty if none.
+	ipAddr string
+	// createdByNIM is true if the bridge is owned by nim.
+	createdByNIM bool
+}
+
+// Name returns the bridge interface name.
+func (b bridgeItem) Name() string {
+	return b.bridgeName
+}
+
+// Label is not used for bridges.
+func (b bridgeItem) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (b bridgeItem) Type() string {
+	return bridgeTypename
+}
+
+// Equal compares two bridge instances.
+func (b bridgeItem) Equal(other dg.Item) bool {
+	b2 := other.(bridgeItem)
+	return b == b2
+}
+
+// External returns true for bridges created by nim.
+func (b bridgeItem) External() bool {
+	return b.createdByNIM
+}
+
+// String describes the bridge.
+func (b bridgeItem) String() string {
+	return fmt.Sprintf("Bridge %s: MAC=%s IP=%s createdByNIM=%t",
+		b.bridgeName, b.macAddr, b.ipAddr, b.createdByNIM)
+}
+
+// Dependencies returns no dependencies, bridge is created first.
+func (b bridgeItem) Dependencies() []dg.Dependency {
+	return nil
+}
+
+// bridgeConfigurator creates and removes bnX bridges.
+type bridgeConfigurator struct{}
+
+// Create creates the bridge and assigns the IP address (if any).
+func (c bridgeConfigurator) Create(_ context.Context, item dg.Item) error {
+	bridge := item.(bridgeItem)
+	// Start clean
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = bridge.bridgeName
+	netlink.LinkDel(&netlink.Bridge{LinkAttrs: attrs})
+
+	//    ip link add ${bridgeName} type bridge
+	attrs = netlink.NewLinkAttrs()
+	attrs.Name = bridge.bridgeName
+	hw, err := net.ParseMAC(bridge.macAddr)
+	if err != nil {
+		return fmt.Errorf("ParseMAC %s failed: %v", bridge.macAddr, err)
+	}
+	attrs.HardwareAddr = hw
+	link := &netlink.Bridge{LinkAttrs: attrs}
+	if err := netlink.LinkAdd(link); err != nil {
+		return fmt.Errorf("LinkAdd on %s failed: %s",
+			bridge.bridgeName, err)
+	}
+	//    ip link set ${bridgeName} up
+	if err := netlink.LinkSetUp(link); err != nil {
+		return fmt.Errorf("LinkSetUp on %s failed: %s",
+			bridge.bridgeName, err)
+	}
+	disableIcmpRedirects(bridge.bridgeName)
+	if bridge.ipAddr != "" {
+		return bridgeAddrChange(bridge.bridgeName, "", bridge.ipAddr)
+	}
+	return nil
+}
+
+// Modify changes the bridge IP address.
+func (c bridgeConfigurator) Modify(_ context.Context, oldItem, newItem dg.Item) error {
+	oldBridge := oldItem.(bridgeItem)
+	newBridge := newItem.(bridgeItem)
+	return bridgeAddrChange(newBridge.bridgeName, oldBridge.ipAddr,
+		newBridge.ipAddr)
+}
+
+// Delete removes the bridge together with the assigned addresses.
+func (c bridgeConfigurator) Delete(_ context.Context, item dg.Item) error {
+	bridge := item.(bridgeItem)
+	attrs := netlink.NewLinkAttrs()
+	attrs.Name = bridge.bridgeName
+	if err := netlink.LinkDel(&netlink.Bridge{LinkAttrs: attrs}); err != nil {
+		return fmt.Errorf("LinkDel on %s failed: %s",
+			bridge.bridgeName, err)
+	}
+	return nil
+}
+
+// NeedsRecreate returns true if the MAC address changes.
+func (c bridgeConfigurator) NeedsRecreate(oldItem, newItem dg.Item) bool {
+	return oldItem.(bridgeItem).macAddr != newItem.(bridgeItem).macAddr
+}
+
+// Exists checks if the bridge is still present in the kernel.
+func (c bridgeConfigurator) Exists(item dg.Item) bool {
+	link, _ := netlink.LinkByName(item.(bridgeItem).bridgeName)
+	return link != nil
+}
+
+// bridgeAddrChange replaces oldAddr with newAddr on the bridge.
+// Addresses are in CIDR notation, either may be empty.
+func bridgeAddrChange(bridgeName, oldAddr, newAddr string) error {
+	link, err := netlink.LinkByName(bridgeName)
+	if err != nil {
+		return fmt.Errorf("LinkByName(%s) failed: %v", bridgeName, err)
+	}
+	if oldAddr != "" {
+		addr, err := netlink.ParseAddr(oldAddr)
+		if err != nil {
+			return fmt.Errorf("ParseAddr %s failed: %s", oldAddr, err)
+		}
+		if err := netlink.AddrDel(link, addr); err != nil {
+			log.Warnf("bridgeAddrChange: AddrDel %s from %s failed: %s",
+				oldAddr, bridgeName, err)
+		}
+	}
+	if newAddr != "" {
+		addr, err := netlink.ParseAddr(newAddr)
+		if err != nil {
+			return fmt.Errorf("ParseAddr %s failed: %s", newAddr, err)
+		}
+		if err := netlink.AddrAdd(link, addr); err != nil {
+			return fmt.Errorf("AddrAdd %s failed: %s", newAddr, err)
+		}
+	}
+	return nil
+}
+
+// ==== VIF
+
+// vifItem is an application VIF connected to a network instance bridge.
+// The VIF link itself is created by domainmgr, zedrouter is responsible
+// for the DHCP reservation and the DNS host entry of the application.
+type vifItem struct {
+	vifName    string
+	bridgeName string
+	appMac     string
+	// appIPAddr is empty for switch network instances.
+	appIPAddr string
+	appID     string
+	appName   string
+	// withDnsmasq is true if the network instance runs dnsmasq.
+	withDnsmasq bool
+	// accessVlanID is the access VLAN of the VIF on a switch network
+	// instance, zero for trunk VIFs and on other network instances.
+	accessVlanID uint32
+}
+
+// Name returns the VIF interface name.
+func (v vifItem) Name() string {
+	return v.vifName
+}
+
+// Label is not used for VIFs.
+func (v vifItem) Label() string {
+	return ""
+}
+
+// Type of the item.
+func (v vifItem) Type() string {
+	return vifTypename
+}
+
+// Equal compares two VIF instances.
+func (v vifItem) Equal(other dg.Item) bool {
+	v2 := other.(vifItem)
+	return v == v2
+}
+
+// External returns false, the reservation is managed by zedrouter.
+func (v vifItem) External() bool {
+	return false
+}
+
+// String describes the VIF.
+func (v vifItem) String() string {
+	return fmt.Sprintf("VIF %s: bridge=%s MAC=%s IP=%s app=%s(%s)",
+		v.vifName, v.bridgeName, v.appMac, v.appIPAddr, v.appName, v.appID)
+}
+
+// Dependencies returns the bridge and, for VIFs with an allocated IP address,
+// the dnsmasq instance which hands out the address.
+func (v vifItem) Dependencies() []dg.Dependency {
+	deps := []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: v.bridgeName,
+			},
+			Description: "VIF is connected to the network instance bridge",
+		},
+	}
+	if v.withDnsmasq && v.appIPAddr != "" {
+		deps = append(deps, dg.Dependency{
+			RequiredItem: dg.ItemRef{
+				ItemType: dnsmasqTypename,
+				ItemName: v.bridgeName,
+			},
+			Description: "DHCP reservation is served by dnsmasq",
+		})
+	}
+	return deps
+}
+
+// vifConfigurator manages DHCP reservations and host entries for VIFs.
+type vifConfigurator struct{}
+
+// Create adds the host entry and the DHCP reservation.
+func (c vifConfigurator) Create(_ context.Context, item dg.Item) error {
+	vif := item.(vifItem)
+	if vif.appIPAddr == "" {
+		return nil
+	}
+	addToHostsConfiglet(hostsDirpath(vif.bridgeName), vif.appName,
+		[]string{vif.appIPAddr})
+	if vif.withDnsmasq {
+		addhostDnsmasq(vif.bridgeName, vif.appMac, vif.appIPAddr, vif.appID)
+	}
+	return nil
+}
+
+// Modify is not supported, VIFs are recreated.
+func (c vifConfigurator) Modify(_ context.Context, _, _ dg.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete removes the host entry and the DHCP reservation.
+func (c vifConfigurator) Delete(_ context.Context, item dg.Item) error {
+	vif := item.(vifItem)
+	removeFromHostsConfiglet(hostsDirpath(vif.bridgeName), vif.appName)
+	if vif.withDnsmasq && vif.appIPAddr != "" {
+		removehostDnsmasq(vif.bridgeName, vif.appMac, vif.appIPAddr)
+	}
+	return nil
+}
+
+// NeedsRecreate always returns true.
+func (c vifConfigurator) NeedsRecreate(_, _ dg.Item) bool {
+	return true
+}
+
+// ==== Dnsmasq
+
+// dnsmasqItem is a dnsmasq instance providing DHCP and DNS services
+// for a network instance.
+type dnsmasqItem struct {
+	bridgeName   string
+	bridgeIPAddr string
+	ipsets       []string
+	uplink       string
+	dnsServers   []net.IP
+	ntpServers   []net.IP
+	// Network instance config propagated into DHCP options.
+	logicallabel string
+	gateway      net.IP
+	subnet       net.IPNet
+	dhcpRange    types.IpRange
+	domainName   string
+	niDNSServers []net.IP
+	niNTPServer  net.IP
+	// From GlobalConfig.
+	disableDHCPAllOnesNetMask bool
+}
+
+// Name returns the bridge name, there is one dnsmasq per bridge.
+func (d dnsmasqItem) Name() string {
+	return d.bridgeName
+}
+
+// Label is used for graph visualization.
+func (d dnsmasqItem) Label() string {
+	return "dnsmasq for " + d.bridgeName
+}
+
+// Type of the item.
+func (d dnsmasqItem) Type() string {
+	return dnsmasqTypename
+}
+
+// Equal compares all parameters which are rendered into the configlet.
+func (d dnsmasqItem) Equal(other dg.Item) bool {
+	return reflect.DeepEqual(d, other.(dnsmasqItem))
+}
+
+// External returns false, dnsmasq is started by zedrouter.
+func (d dnsmasqItem) External() bool {
+	return false
+}
+
+// String describes the dnsmasq instance.
+func (d dnsmasqItem) String() string {
+	return fmt.Sprintf("Dnsmasq for %s: listen=%s uplink=%s ipsets=%v "+
+		"dnsServers=%v ntpServers=%v", d.bridgeName, d.bridgeIPAddr,
+		d.uplink, d.ipsets, d.dnsServers, d.ntpServers)
+}
+
+// Dependencies returns the bridge with the listen address assigned.
+func (d dnsmasqItem) Dependencies() []dg.Dependency {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: d.bridgeName,
+			},
+			MustSatisfy: func(item dg.Item) bool {
+				bridge := item.(bridgeItem)
+				if bridge.createdByNIM {
+					// Address is assigned outside of the graph.
+					return true
+				}
+				return strings.HasPrefix(bridge.ipAddr, d.bridgeIPAddr+"/")
+			},
+			Description: "dnsmasq listens on the bridge IP address",
+		},
+	}
+}
+
+// dnsmasqConfigurator starts and stops dnsmasq instances.
+type dnsmasqConfigurator struct{}
+
+// Create writes the configlet and starts dnsmasq.
+func (c dnsmasqConfigurator) Create(_ context.Context, item dg.Item) error {
+	dnsmasq := item.(dnsmasqItem)
+	// Start clean
+	deleteDnsmasqConfiglet(dnsmasq.bridgeName)
+	stopDnsmasq(dnsmasq.bridgeName, false, false)
+
+	if err := createDnsmasqConfiglet(dnsmasq); err != nil {
+		return err
+	}
+	startDnsmasq(dnsmasq.bridgeName)
+	return nil
+}
+
+// Modify is not supported, dnsmasq is restarted with the new configlet.
+func (c dnsmasqConfigurator) Modify(_ context.Context, _, _ dg.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete stops dnsmasq and removes the configlet.
+func (c dnsmasqConfigurator) Delete(_ context.Context, item dg.Item) error {
+	dnsmasq := item.(dnsmasqItem)
+	stopDnsmasq(dnsmasq.bridgeName, false, true)
+	return nil
+}
+
+// NeedsRecreate always returns true, dnsmasq does not reload its config.
+func (c dnsmasqConfigurator) NeedsRecreate(_, _ dg.Item) bool {
+	return true
+}
+
+// Exists checks if the dnsmasq process is still running.
+func (c dnsmasqConfigurator) Exists(item dg.Item) bool {
+	pidfile := fmt.Sprintf("/run/dnsmasq.%s.pid", item.(dnsmasqItem).bridgeName)
+	pidBytes, err := ioutil.ReadFile(pidfile)
+	if err != nil {
+		return false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(pidBytes)))
+	if err != nil {
+		return false
+	}
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return false
+	}
+	return p.Signal(syscall.Signal(0)) == nil
+}
+
+// ==== Iptables chain
+
+// iptablesChainItem is an iptables chain owned by a network instance.
+// The chain is hooked into parentChain using a jump rule appended at the end
+// of the parent, so that it stays below the per-app ACL rules which
+// are inserted at the top.
+type iptablesChainItem struct {
+	table       string
+	chainName   string
+	parentChain string
+	// Each rule is a list of iptables arguments (match + target).
+	rules      [][]string
+	bridgeName string
+}
+
+// Name returns the table and the chain name.
+func (ch iptablesChainItem) Name() string {
+	return ch.table + "/" + ch.chainName
+}
+
+// Label is used for graph visualization.
+func (ch iptablesChainItem) Label() string {
+	return ch.chainName + " (" + ch.table + ")"
+}
+
+// Type of the item.
+func (ch iptablesChainItem) Type() string {
+	return iptablesChainTypename
+}
+
+// Equal compares the chain parameters and the rules.
+func (ch iptablesChainItem) Equal(other dg.Item) bool {
+	return reflect.DeepEqual(ch, other.(iptablesChainItem))
+}
+
+// External returns false, chains are created by zedrouter.
+func (ch iptablesChainItem) External() bool {
+	return false
+}
+
+// String describes the chain.
+func (ch iptablesChainItem) String() string {
+	var rules []string
+	for _, rule := range ch.rules {
+		rules = append(rules, strings.Join(rule, " "))
+	}
+	return fmt.Sprintf("Iptables chain %s in table %s (jump from %s):\n%s",
+		ch.chainName, ch.table, ch.parentChain, strings.Join(rules, "\n"))
+}
+
+// Dependencies returns the bridge of the network instance.
+func (ch iptablesChainItem) Dependencies() []dg.Dependency {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: ch.bridgeName,
+			},
+			Description: "Chain rules reference the network instance bridge",
+		},
+	}
+}
+
+// iptablesChainConfigurator creates and removes iptables chains.
+type iptablesChainConfigurator struct{}
+
+// Create creates the chain, adds the rules and hooks the chain into the parent.
+func (c iptablesChainConfigurator) Create(_ context.Context, item dg.Item) error {
+	ch := item.(iptablesChainItem)
+	// Start clean
+	c.unhookAndRemove(ch)
+	if err := iptables.IptableCmd(log, "-t", ch.table, "-N", ch.chainName); err != nil {
+		return err
+	}
+	if err := c.appendRules(ch); err != nil {
+		return err
+	}
+	return iptables.IptableCmd(log, "-t", ch.table, "-A", ch.parentChain,
+		"-j", ch.chainName)
+}
+
+// Modify replaces the chain rules.
+func (c iptablesChainConfigurator) Modify(_ context.Context, _, newItem dg.Item) error {
+	ch := newItem.(iptablesChainItem)
+	if err := iptables.IptableCmd(log, "-t", ch.table, "-F", ch.chainName); err != nil {
+		return err
+	}
+	return c.appendRules(ch)
+}
+
+// Delete unhooks the chain from the parent and removes it.
+func (c iptablesChainConfigurator) Delete(_ context.Context, item dg.Item) error {
+	return c.unhookAndRemove(item.(iptablesChainItem))
+}
+
+// NeedsRecreate returns true if the chain is moved to another parent.
+func (c iptablesChainConfigurator) NeedsRecreate(oldItem, newItem dg.Item) bool {
+	oldCh := oldItem.(iptablesChainItem)
+	newCh := newItem.(iptablesChainItem)
+	return oldCh.parentChain != newCh.parentChain
+}
+
+// Exists checks if the chain is still present.
+func (c iptablesChainConfigurator) Exists(item dg.Item) bool {
+	ch := item.(iptablesChainItem)
+	err := iptables.IptableCmd(nil, "-t", ch.table, "-n", "-L", ch.chainName)
+	return err == nil
+}
+
+func (c iptablesChainConfigurator) appendRules(ch iptablesChainItem) error {
+	for _, rule := range ch.rules {
+		args := append([]string{"-t", ch.table, "-A", ch.chainName}, rule...)
+		if err := iptables.IptableCmd(log, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c iptablesChainConfigurator) unhookAndRemove(ch iptablesChainItem) error {
+	// The chain may not exist (yet), do not log failures.
+	_ = iptables.IptableCmd(nil, "-t", ch.table, "-D", ch.parentChain,
+		"-j", ch.chainName)
+	_ = iptables.IptableCmd(nil, "-t", ch.table, "-F", ch.chainName)
+	return iptables.IptableCmd(nil, "-t", ch.table, "-X", ch.chainName)
+}
+
+// ==== Ebtables chain
+
+// ebtablesChainItem is an ebtables chain owned by a network instance.
+// The chain is hooked into parentChain for the frames received on the bridge
+// and returns to the parent when no rule matches.
+type ebtablesChainItem struct {
+	table       string
+	chainName   string
+	parentChain string
+	// Each rule is a list of ebtables arguments (match + target).
+	rules      [][]string
+	bridgeName string
+}
+
+// Name returns the table and the chain name.
+func (ch ebtablesChainItem) Name() string {
+	return ch.table + "/" + ch.chainName
+}
+
+// Label is used for graph visualization.
+func (ch ebtablesChainItem) Label() string {
+	return ch.chainName + " (ebtables " + ch.table + ")"
+}
+
+// Type of the item.
+func (ch ebtablesChainItem) Type() string {
+	return ebtablesChainTypename
+}
+
+// Equal compares the chain parameters and the rules.
+func (ch ebtablesChainItem) Equal(other dg.Item) bool {
+	return reflect.DeepEqual(ch, other.(ebtablesChainItem))
+}
+
+// External returns false, chains are created by zedrouter.
+func (ch ebtablesChainItem) External() bool {
+	return false
+}
+
+// String describes the chain.
+func (ch ebtablesChainItem) String() string {
+	var rules []string
+	for _, rule := range ch.rules {
+		rules = append(rules, strings.Join(rule, " "))
+	}
+	return fmt.Sprintf("Ebtables chain %s in table %s (jump from %s):\n%s",
+		ch.chainName, ch.table, ch.parentChain, strings.Join(rules, "\n"))
+}
+
+// Dependencies returns the bridge of the network instance.
+func (ch ebtablesChainItem) Dependencies() []dg.Dependency {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: ch.bridgeName,
+			},
+			Description: "Chain is hooked for the network instance bridge",
+		},
+	}
+}
+
+// ebtablesChainConfigurator creates and removes ebtables chains.
+type ebtablesChainConfigurator struct{}
+
+// Create creates the chain, adds the rules and hooks the chain into the parent.
+func (c ebtablesChainConfigurator) Create(_ context.Context, item dg.Item) error {
+	ch := item.(ebtablesChainItem)
+	// Start clean
+	c.unhookAndRemove(ch)
+	if err := iptables.EbtablesCmd(log, "-t", ch.table, "-N", ch.chainName,
+		"-P", "RETURN"); err != nil {
+		return err
+	}
+	if err := c.appendRules(ch); err != nil {
+		return err
+	}
+	return iptables.EbtablesCmd(log, "-t", ch.table, "-A", ch.parentChain,
+		"--logical-in", ch.bridgeName, "-j", ch.chainName)
+}
+
+// Modify replaces the chain rules.
+func (c ebtablesChainConfigurator) Modify(_ context.Context, _, newItem dg.Item) error {
+	ch := newItem.(ebtablesChainItem)
+	if err := iptables.EbtablesCmd(log, "-t", ch.table, "-F", ch.chainName); err != nil {
+		return err
+	}
+	return c.appendRules(ch)
+}
+
+// Delete unhooks the chain from the parent and removes it.
+func (c ebtablesChainConfigurator) Delete(_ context.Context, item dg.Item) error {
+	return c.unhookAndRemove(item.(ebtablesChainItem))
+}
+
+// NeedsRecreate returns true if the chain is moved to another parent.
+func (c ebtablesChainConfigurator) NeedsRecreate(oldItem, newItem dg.Item) bool {
+	oldCh := oldItem.(ebtablesChainItem)
+	newCh := newItem.(ebtablesChainItem)
+	return oldCh.parentChain != newCh.parentChain
+}
+
+// Exists checks if the chain is still present.
+func (c ebtablesChainConfigurator) Exists(item dg.Item) bool {
+	ch := item.(ebtablesChainItem)
+	err := iptables.EbtablesCmd(nil, "-t", ch.table, "-L", ch.chainName)
+	return err == nil
+}
+
+func (c ebtablesChainConfigurator) appendRules(ch ebtablesChainItem) error {
+	for _, rule := range ch.rules {
+		args := append([]string{"-t", ch.table, "-A", ch.chainName}, rule...)
+		if err := iptables.EbtablesCmd(log, args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c ebtablesChainConfigurator) unhookAndRemove(ch ebtablesChainItem) error {
+	// The chain may not exist (yet), do not log failures.
+	_ = iptables.EbtablesCmd(nil, "-t", ch.table, "-D", ch.parentChain,
+		"--logical-in", ch.bridgeName, "-j", ch.chainName)
+	return iptables.EbtablesCmd(nil, "-t", ch.table, "-X", ch.chainName)
+}
+
+// ==== Route table
+
+// routeTableItem is the network-instance specific routing table
+// (baseTableIndex + bridge ifindex) with routes copied from the uplink port,
+// together with the ip rules that steer the NI traffic into it.
+type routeTableItem struct {
+	bridgeName   string
+	bridgeIPAddr string
+	subnet       net.IPNet
+	uplink       string
+}
+
+// Name returns the bridge name, there is one table per bridge.
+func (rt routeTableItem) Name() string {
+	return rt.bridgeName
+}
+
+// Label is used for graph visualization.
+func (rt routeTableItem) Label() string {
+	return "PBR for " + rt.bridgeName + " via " + rt.uplink
+}
+
+// Type of the item.
+func (rt routeTableItem) Type() string {
+	return routeTableTypename
+}
+
+// Equal compares two route tables.
+func (rt routeTableItem) Equal(other dg.Item) bool {
+	return reflect.DeepEqual(rt, other.(routeTableItem))
+}
+
+// External returns false, route table is populated by zedrouter.
+func (rt routeTableItem) External() bool {
+	return false
+}
+
+// String describes the route table.
+func (rt routeTableItem) String() string {
+	return fmt.Sprintf("Route table for %s (%s, subnet %s) via uplink %s",
+		rt.bridgeName, rt.bridgeIPAddr, rt.subnet.String(), rt.uplink)
+}
+
+// Dependencies returns the bridge of the network instance.
+func (rt routeTableItem) Dependencies() []dg.Dependency {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: rt.bridgeName,
+			},
+			Description: "Route table is indexed by the bridge ifindex",
+		},
+	}
+}
+
+// routeTableConfigurator populates and flushes route tables.
+type routeTableConfigurator struct{}
+
+// Create copies the uplink routes and installs the ip rules.
+func (c routeTableConfigurator) Create(_ context.Context, item dg.Item) error {
+	rt := item.(routeTableItem)
+	bridgeIfindex, err := IfnameToIndex(log, rt.bridgeName)
+	if err != nil {
+		return err
+	}
+	if err := PbrRouteAddAll(rt.bridgeName, rt.uplink); err != nil {
+		return err
+	}
+	devicenetwork.AddGatewaySourceRule(log, rt.subnet,
+		net.ParseIP(rt.bridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
+	devicenetwork.AddSourceRule(log, bridgeIfindex, rt.subnet, true,
+		devicenetwork.PbrNatOutPrio)
+	devicenetwork.AddInwardSourceRule(log, bridgeIfindex, rt.subnet, true,
+		devicenetwork.PbrNatInPrio)
+	return nil
+}
+
+// Modify is not supported, route table is recreated.
+func (c routeTableConfigurator) Modify(_ context.Context, _, _ dg.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete removes the ip rules and the routes.
+func (c routeTableConfigurator) Delete(_ context.Context, item dg.Item) error {
+	rt := item.(routeTableItem)
+	bridgeIfindex, err := IfnameToIndex(log, rt.bridgeName)
+	if err != nil {
+		return err
+	}
+	devicenetwork.DelGatewaySourceRule(log, rt.subnet,
+		net.ParseIP(rt.bridgeIPAddr), devicenetwork.PbrNatOutGatewayPrio)
+	devicenetwork.DelSourceRule(log, bridgeIfindex, rt.subnet, true,
+		devicenetwork.PbrNatOutPrio)
+	devicenetwork.DelInwardSourceRule(log, bridgeIfindex, rt.subnet, true,
+		devicenetwork.PbrNatInPrio)
+	return PbrRouteDeleteAll(rt.bridgeName, rt.uplink)
+}
+
+// NeedsRecreate always returns true.
+func (c routeTableConfigurator) NeedsRecreate(_, _ dg.Item) bool {
+	return true
+}
+
+// ==== Uplink route table
+
+// uplinkRouteTableItem is the route table of one of the uplinks of a load
+// balanced network instance, with routes copied from the uplink port and
+// the route to the network instance subnet, together with the ip rule
+// that steers the NI traffic marked with the uplink into it.
+type uplinkRouteTableItem struct {
+	bridgeName string
+	subnet     net.IPNet
+	uplink     string
+	mark       uint8
+}
+
+// Name returns the bridge and the uplink name.
+func (rt uplinkRouteTableItem) Name() string {
+	return rt.bridgeName + "/" + rt.uplink
+}
+
+// Label is used for graph visualization.
+func (rt uplinkRouteTableItem) Label() string {
+	return "Load balanced PBR for " + rt.bridgeName + " via " + rt.uplink
+}
+
+// Type of the item.
+func (rt uplinkRouteTableItem) Type() string {
+	return uplinkRouteTableTypename
+}
+
+// Equal compares two uplink route tables.
+func (rt uplinkRouteTableItem) Equal(other dg.Item) bool {
+	return reflect.DeepEqual(rt, other.(uplinkRouteTableItem))
+}
+
+// External returns false, route table is populated by zedrouter.
+func (rt uplinkRouteTableItem) External() bool {
+	return false
+}
+
+// String describes the uplink route table.
+func (rt uplinkRouteTableItem) String() string {
+	return fmt.Sprintf("Route table for %s (subnet %s) via uplink %s, mark %d",
+		rt.bridgeName, rt.subnet.String(), rt.uplink, rt.mark)
+}
+
+// Dependencies returns the bridge of the network instance.
+func (rt uplinkRouteTableItem) Dependencies() []dg.Dependency {
+	return []dg.Dependency{
+		{
+			RequiredItem: dg.ItemRef{
+				ItemType: bridgeTypename,
+				ItemName: rt.bridgeName,
+			},
+			Description: "Route table is indexed by the bridge ifindex",
+		},
+	}
+}
+
+// uplinkRouteTableConfigurator populates and flushes uplink route tables.
+type uplinkRouteTableConfigurator struct{}
+
+// Create copies the uplink routes and installs the ip rule.
+func (c uplinkRouteTableConfigurator) Create(_ context.Context, item dg.Item) error {
+	rt := item.(uplinkRouteTableItem)
+	bridgeIfindex, err := IfnameToIndex(log, rt.bridgeName)
+	if err != nil {
+		return err
+	}
+	uplinkIfindex, err := IfnameToIndex(log, rt.uplink)
+	if err != nil {
+		return err
+	}
+	table := lbTable(bridgeIfindex, rt.mark)
+	routes := []netlink.Route{{
+		LinkIndex: bridgeIfindex,
+		Dst:       &rt.subnet,
+		Scope:     netlink.SCOPE_LINK,
+	}}
+	routes = append(routes, getAllIPv4Routes(uplinkIfindex)...)
+	dropRoute, err := makeDefaultDropRoute(0, true)
+	if err != nil {
+		return err
+	}
+	routes = append(routes, *dropRoute)
+	for _, route := range routes {
+		route.Table = table
+		// Clear any RTNH_F_LINKDOWN etc flags since add doesn't like them
+		route.Flags = 0
+		if err := netlink.RouteAdd(&route); err != nil {
+			return fmt.Errorf("failed to add %v to %d: %w", route, table, err)
+		}
+	}
+	return netlink.RuleAdd(uplinkRule(bridgeIfindex, table, rt.mark))
+}
+
+// Modify is not supported, route table is recreated.
+func (c uplinkRouteTableConfigurator) Modify(_ context.Context, _, _ dg.Item) error {
+	return errors.New("not implemented")
+}
+
+// Delete removes the ip rule and the routes.
+func (c uplinkRouteTableConfigurator) Delete(_ context.Context, item dg.Item) error {
+	rt := item.(uplinkRouteTableItem)
+	bridgeIfindex, err := IfnameToIndex(log, rt.bridgeName)
+	if err != nil {
+		return err
+	}
+	table := lbTable(bridgeIfindex, rt.mark)
+	if err := netlink.RuleDel(uplinkRule(bridgeIfindex, table, rt.mark)); err != nil {
+		log.Errorf("uplinkRouteTableConfigurator: RuleDel failed: %v", err)
+	}
+	devicenetwork.FlushRoutesTable(log, table, 0)
+	return nil
+}
+
+// NeedsRecreate always returns true.
+func (c uplinkRouteTableConfigurator) NeedsRecreate(_, _ dg.Item) bool {
+	return true
+}
+
+// uplinkRule matches the traffic of the bridge marked with the uplink.
+func uplinkRule(bridgeIfindex, table int, mark uint8) *netlink.Rule {
+	r := netlink.NewRule()
+	r.Table = table
+	r.Priority = devicenetwork.PbrNatOutLBPrio
+	r.Family = netlink.FAMILY_V4
+	r.IifName, _, _ = IfindexToName(log, bridgeIfindex)
+	r.Mark = int(mark) << uplinkShift
+	r.Mask = uplinkMask
+	return r
+}
@@ -0,0 +1,510 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Network instance plumbing (bridges, dnsmasq, iptables chains, PBR route
+// tables and the application VIFs) described as an intended-state graph
+// and applied using libs/reconciler.
+// Each network instance is represented by one subgraph named after its UUID.
+// Failed items are periodically retried from niRetryTimer, and items which
+// disappeared from the system behind our back are detected and recreated
+// there as well.
+
+package zedrouter
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	niGraphName = "NetworkInstances"
+	// niRetryInterval : how often to retry failed items and check for drift.
+	niRetryInterval = time.Minute
+	// niStateDotFile : current vs. intended state exported in the DOT format.
+	niStateDotFile = runDirname + "/ni-state.dot"
+)
+
+// driftDetector is optionally implemented by Configurators which are able
+// to check if a previously created item still exists.
+type driftDetector interface {
+	Exists(item dg.Item) bool
+}
+
+// niReconcilerInit registers the Configurators and prepares empty graphs.
+func niReconcilerInit(ctx *zedrouterContext) {
+	registry := &reconciler.DefaultRegistry{}
+	configurators := map[string]reconciler.Configurator{
+		bridgeTypename:           bridgeConfigurator{},
+		vifTypename:              vifConfigurator{},
+		dnsmasqTypename:          dnsmasqConfigurator{},
+		iptablesChainTypename:    iptablesChainConfigurator{},
+		ebtablesChainTypename:    ebtablesChainConfigurator{},
+		routeTableTypename:       routeTableConfigurator{},
+		uplinkRouteTableTypename: uplinkRouteTableConfigurator{},
+	}
+	for itemType, configurator := range configurators {
+		if err := registry.Register(configurator, itemType); err != nil {
+			log.Fatal(err)
+		}
+	}
+	ctx.niRegistry = registry
+	ctx.niIntendedState = dg.New(dg.InitArgs{
+		Name:        niGraphName,
+		Description: "Network instances configured by zedrouter",
+	})
+	status := reconciler.New(registry).Reconcile(context.Background(),
+		nil, ctx.niIntendedState)
+	ctx.niCurrentState = status.NewCurrentState
+	ctx.niRetryTimer = time.NewTimer(niRetryInterval)
+}
+
+// reconcileNetworkInstance updates the intended state of the network instance
+// and reconciles it. Plumbing for uplink ports (NAT, PBR, flow marking)
+// is included only if activated is true.
+// VIFs already in the intended state are preserved.
+// Returns error if any of the network instance items failed.
+func reconcileNetworkInstance(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, activated bool) error {
+
+	sgName := status.UUID.String()
+	niGraph := dg.New(dg.InitArgs{
+		Name:        sgName,
+		Description: fmt.Sprintf("Network instance %s", status.DisplayName),
+		Items:       niIntendedItems(ctx, status, activated),
+	})
+	if prevGraph := ctx.niIntendedState.SubGraph(sgName); prevGraph != nil {
+		iter := prevGraph.Items(false)
+		for iter.Next() {
+			item, _ := iter.Item()
+			if vif, isVif := item.(vifItem); isVif {
+				vif.withDnsmasq = status.BridgeIPAddr != ""
+				niGraph.PutItem(vif, nil)
+			}
+		}
+	}
+	niPutVlanMarkChain(niGraph, status)
+	ctx.niIntendedState.PutSubGraph(niGraph)
+	niUpdateExternalItems(ctx, status, niGraph)
+	niReconcile(ctx)
+	return niGraphError(ctx, sgName)
+}
+
+// removeNetworkInstanceState removes all items of the network instance
+// (including VIFs) and reconciles.
+func removeNetworkInstanceState(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	sgName := status.UUID.String()
+	ctx.niIntendedState.DelSubGraph(sgName)
+	if curGraph := ctx.niCurrentState.SubGraph(sgName); curGraph != nil {
+		// Forget external items, they are not ours to remove.
+		niUpdateExternalItems(ctx, status, nil)
+	}
+	niReconcile(ctx)
+	return niGraphError(ctx, sgName)
+}
+
+// putNetworkInstanceVif adds or updates a VIF of the network instance
+// and reconciles.
+func putNetworkInstanceVif(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, vif vifItem) error {
+
+	vif.bridgeName = status.BridgeName
+	vif.withDnsmasq = status.BridgeIPAddr != ""
+	sgName := status.UUID.String()
+	if !dg.PutItemInto(ctx.niIntendedState, vif, nil,
+		dg.NewSubGraphPath(sgName)) {
+		return fmt.Errorf("network instance %s is not configured",
+			status.Key())
+	}
+	niGraph := ctx.niIntendedState.EditSubGraph(
+		ctx.niIntendedState.SubGraph(sgName))
+	niPutVlanMarkChain(niGraph, status)
+	niReconcile(ctx)
+	return niGraphError(ctx, sgName)
+}
+
+// delNetworkInstanceVif removes a VIF of the network instance
+// and reconciles.
+func delNetworkInstanceVif(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, vifName string) error {
+
+	sgName := status.UUID.String()
+	vifRef := dg.ItemRef{ItemType: vifTypename, ItemName: vifName}
+	if !dg.DelItemFrom(ctx.niIntendedState, vifRef,
+		dg.NewSubGraphPath(sgName)) {
+		return nil
+	}
+	niGraph := ctx.niIntendedState.EditSubGraph(
+		ctx.niIntendedState.SubGraph(sgName))
+	niPutVlanMarkChain(niGraph, status)
+	niReconcile(ctx)
+	return niGraphError(ctx, sgName)
+}
+
+// niIntendedItems builds the list of items which should be configured
+// for the network instance.
+func niIntendedItems(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, activated bool) []dg.Item {
+
+	var items []dg.Item
+	if status.BridgeName == "" {
+		return items
+	}
+	bridge := bridgeItem{
+		bridgeName:   status.BridgeName,
+		macAddr:      status.BridgeMac,
+		createdByNIM: !strings.HasPrefix(status.BridgeName, "bn"),
+	}
+	if status.BridgeIPAddr != "" && !bridge.createdByNIM {
+		bridge.ipAddr = fmt.Sprintf("%s/%d", status.BridgeIPAddr,
+			getPrefixLenForBridgeIP(status))
+	}
+	items = append(items, bridge)
+
+	if status.BridgeIPAddr != "" {
+		items = append(items, dnsmasqItem{
+			bridgeName:   status.BridgeName,
+			bridgeIPAddr: status.BridgeIPAddr,
+			ipsets:       status.BridgeIPSets,
+			uplink:       status.CurrentUplinkIntf,
+			dnsServers: types.GetDNSServers(*ctx.deviceNetworkStatus,
+				status.CurrentUplinkIntf),
+			ntpServers: types.GetNTPServers(*ctx.deviceNetworkStatus,
+				status.CurrentUplinkIntf),
+			logicallabel:              status.Logicallabel,
+			gateway:                   status.Gateway,
+			subnet:                    status.Subnet,
+			dhcpRange:                 status.DhcpRange,
+			domainName:                status.DomainName,
+			niDNSServers:              status.DnsServers,
+			niNTPServer:               status.NtpServer,
+			disableDHCPAllOnesNetMask: ctx.disableDHCPAllOnesNetMask,
+		})
+	}
+	if !activated {
+		return items
+	}
+
+	// Flow marking rules for traffic coming from the uplinks.
+	aclArgs := types.AppNetworkACLArgs{IsMgmt: false, BridgeName: status.BridgeName,
+		BridgeIP: status.BridgeIPAddr, NIType: status.Type, UpLinks: status.IfNameList}
+	lbACLArgs(&aclArgs, status)
+	if rules := networkInstanceBridgeRules(aclArgs); len(rules) > 0 {
+		items = append(items, niIptablesChain("mangle", "PREROUTING",
+			status.BridgeName, rules))
+	}
+
+	switch status.Type {
+	case types.NetworkInstanceTypeLocal:
+		// status.IfNameList has more than one interface name only with
+		// load balancing, the first one is CurrentUplinkIntf.
+		// This is checked by natActivate.
+		var natRules types.IPTablesRuleList
+		for _, uplink := range status.IfNameList {
+			natRules = append(natRules, types.IPTablesRule{
+				Rule:   []string{"-o", uplink, "-s", status.Subnet.String()},
+				Action: []string{"-j", "MASQUERADE"},
+			})
+		}
+		if len(status.IfNameList) > 0 {
+			items = append(items, niIptablesChain("nat", "POSTROUTING",
+				status.BridgeName, natRules))
+			items = append(items, routeTableItem{
+				bridgeName:   status.BridgeName,
+				bridgeIPAddr: status.BridgeIPAddr,
+				subnet:       status.Subnet,
+				uplink:       status.IfNameList[0],
+			})
+		}
+		for _, uplink := range aclArgs.LBUplinks {
+			items = append(items, uplinkRouteTableItem{
+				bridgeName: status.BridgeName,
+				subnet:     status.Subnet,
+				uplink:     uplink.IfName,
+				mark:       uplink.Mark,
+			})
+		}
+	case types.NetworkInstanceTypeSwitch:
+		// Drop external connection requests to the meta-data server.
+		portName := "k" + status.BridgeName
+		if link, _ := netlink.LinkByName(portName); link != nil {
+			items = append(items, niIptablesChain("filter", "INPUT",
+				status.BridgeName, types.IPTablesRuleList{{
+					Rule: []string{"-i", status.BridgeName, "-p", "tcp",
+						"--dport", "80", "-m", "physdev", "--physdev-in", portName},
+					Action: []string{"-j", "DROP"},
+				}}))
+		}
+	}
+	return items
+}
+
+// niIptablesChain returns a chain named <parentChain>-<bridgeName> with
+// the given rules. Table and Chain of the rules are ignored.
+func niIptablesChain(table, parentChain, bridgeName string,
+	rules types.IPTablesRuleList) iptablesChainItem {
+
+	chain := iptablesChainItem{
+		table:       table,
+		chainName:   parentChain + "-" + bridgeName,
+		parentChain: parentChain,
+		bridgeName:  bridgeName,
+	}
+	for _, rule := range rules {
+		args := append([]string{}, rule.Rule...)
+		args = append(args, rule.Action...)
+		chain.rules = append(chain.rules, args)
+	}
+	return chain
+}
+
+// niPutVlanMarkChain puts the ebtables chain which marks the frames of
+// a switch network instance with their VLAN into the network instance graph,
+// or removes it if no VLAN is used. The mark is the conntrack zone of the
+// flows (see iptables.VlanZonesChain).
+// The untagged frames of the VIFs with an access VLAN are matched on the VIF,
+// the tagged frames on the VLANs used by the network instance.
+func niPutVlanMarkChain(niGraph dg.Graph, status *types.NetworkInstanceStatus) {
+	chain := ebtablesChainItem{
+		table:       "nat",
+		chainName:   "vlan-" + status.BridgeName,
+		parentChain: "PREROUTING",
+		bridgeName:  status.BridgeName,
+	}
+	if status.Type == types.NetworkInstanceTypeSwitch {
+		var vifs []vifItem
+		iter := niGraph.Items(false)
+		for iter.Next() {
+			item, _ := iter.Item()
+			if vif, isVif := item.(vifItem); isVif && vif.accessVlanID != 0 {
+				vifs = append(vifs, vif)
+			}
+		}
+		sort.Slice(vifs, func(i, j int) bool {
+			return vifs[i].vifName < vifs[j].vifName
+		})
+		for _, vif := range vifs {
+			// The +, as for the ACLs, for the <vif>-emu interface of qemu
+			chain.rules = append(chain.rules, vlanMarkRule(vif.accessVlanID,
+				"-i", vif.vifName+"+"))
+		}
+		for _, vlanID := range switchUplinkVlansGet(status) {
+			chain.rules = append(chain.rules, vlanMarkRule(vlanID,
+				"-p", "802_1Q", "--vlan-id", strconv.Itoa(int(vlanID))))
+		}
+	}
+	if len(chain.rules) == 0 {
+		niGraph.DelItem(dg.Reference(chain))
+		return
+	}
+	niGraph.PutItem(chain, nil)
+}
+
+// vlanMarkRule returns the ebtables rule which marks the matching frames
+// with the VLAN
+func vlanMarkRule(vlanID uint32, match ...string) []string {
+	return append(match, "-j", "mark", "--mark-set",
+		strconv.Itoa(int(vlanID)), "--mark-target", "RETURN")
+}
+
+// niUpdateExternalItems records bridges created by nim in the current state.
+// With nil niGraph the external items of the network instance are removed.
+func niUpdateExternalItems(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, niGraph dg.GraphR) {
+
+	sgName := status.UUID.String()
+	curGraphR := ctx.niCurrentState.SubGraph(sgName)
+	if curGraphR == nil {
+		if niGraph == nil {
+			return
+		}
+		ctx.niCurrentState.PutSubGraph(dg.New(dg.InitArgs{Name: sgName}))
+		curGraphR = ctx.niCurrentState.SubGraph(sgName)
+	}
+	curGraph := ctx.niCurrentState.EditSubGraph(curGraphR)
+	var staleItems []dg.ItemRef
+	iter := curGraph.Items(false)
+	for iter.Next() {
+		item, _ := iter.Item()
+		if !item.External() {
+			continue
+		}
+		if niGraph != nil {
+			if _, _, _, found := niGraph.Item(dg.Reference(item)); found {
+				continue
+			}
+		}
+		staleItems = append(staleItems, dg.Reference(item))
+	}
+	for _, itemRef := range staleItems {
+		curGraph.DelItem(itemRef)
+	}
+	if niGraph == nil {
+		return
+	}
+	iter = niGraph.Items(false)
+	for iter.Next() {
+		item, _ := iter.Item()
+		if !item.External() {
+			continue
+		}
+		prevItem, _, _, found := curGraph.Item(dg.Reference(item))
+		if found && prevItem.Equal(item) {
+			continue
+		}
+		curGraph.PutItem(item, &reconciler.ItemStateData{
+			State:              reconciler.ItemStateCreated,
+			ExternallyModified: found,
+		})
+	}
+}
+
+// niReconcile runs state reconciliation for all network instances.
+// Failed items are left for niRetryReconcile.
+func niReconcile(ctx *zedrouterContext) {
+	r := reconciler.New(ctx.niRegistry)
+	status := r.Reconcile(context.Background(), ctx.niCurrentState,
+		ctx.niIntendedState)
+	ctx.niCurrentState = status.NewCurrentState
+	for _, opLog := range status.OperationLog {
+		if opLog.Err != nil {
+			log.Errorf("niReconcile: %s %s/%s failed: %v",
+				opLog.Operation, opLog.Item.Type(), opLog.Item.Name(),
+				opLog.Err)
+		} else {
+			log.Noticef("niReconcile: %s %s/%s done",
+				opLog.Operation, opLog.Item.Type(), opLog.Item.Name())
+		}
+	}
+	niDumpState(ctx)
+}
+
+// niGraphError collects errors of failed items from the network instance
+// subgraph of the current state.
+func niGraphError(ctx *zedrouterContext, sgName string) error {
+	curGraph := ctx.niCurrentState.SubGraph(sgName)
+	if curGraph == nil {
+		return nil
+	}
+	var errs []string
+	iter := curGraph.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		if state == nil || state.WithError() == nil {
+			continue
+		}
+		errs = append(errs, fmt.Sprintf("%s %s: %v",
+			item.Type(), item.Name(), state.WithError()))
+	}
+	// Items with unsatisfied dependencies are pending and missing
+	// from the current state.
+	intGraph := ctx.niIntendedState.SubGraph(sgName)
+	if intGraph != nil {
+		iter = intGraph.Items(true)
+		for iter.Next() {
+			item, _ := iter.Item()
+			if _, _, _, found := curGraph.Item(dg.Reference(item)); !found {
+				errs = append(errs, fmt.Sprintf("%s %s: pending",
+					item.Type(), item.Name()))
+			}
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("network instance configuration failed: %s",
+		strings.Join(errs, "; "))
+}
+
+// niRetryReconcile is called when niRetryTimer fires. It checks for items
+// which were created but have since disappeared and reconciles all network
+// instances to retry failed and recreate missing items.
+// The timer is re-armed before returning.
+func niRetryReconcile(ctx *zedrouterContext) {
+	var removed []dg.ItemRef
+	iter := ctx.niCurrentState.Items(true)
+	for iter.Next() {
+		item, state := iter.Item()
+		if item.External() || state == nil || !state.IsCreated() {
+			continue
+		}
+		configurator := ctx.niRegistry.GetConfigurator(item)
+		detector, ok := configurator.(driftDetector)
+		if !ok || detector.Exists(item) {
+			continue
+		}
+		log.Warnf("niRetryReconcile: %s %s disappeared",
+			item.Type(), item.Name())
+		removed = append(removed, dg.Reference(item))
+	}
+	for _, itemRef := range removed {
+		_, _, path, found := ctx.niCurrentState.Item(itemRef)
+		if found {
+			dg.DelItemFrom(ctx.niCurrentState, itemRef, path)
+		}
+	}
+	niReconcile(ctx)
+	for _, st := range ctx.pubNetworkInstanceStatus.GetAll() {
+		status := st.(types.NetworkInstanceStatus)
+		if err := niGraphError(ctx, status.UUID.String()); err != nil {
+			log.Warnf("niRetryReconcile(%s): %v", status.Key(), err)
+		}
+	}
+	ctx.niRetryTimer.Reset(niRetryInterval)
+}
+
+// niDumpState exports the current and the intended state into a file
+// which can be rendered with graphviz.
+func niDumpState(ctx *zedrouterContext) {
+	exporter := dg.DotExporter{CheckDeps: true}
+	dot, err := exporter.ExportTransition(ctx.niCurrentState, ctx.niIntendedState)
+	if err != nil {
+		log.Errorf("niDumpState: export failed: %v", err)
+		return
+	}
+	if err := ioutil.WriteFile(niStateDotFile, []byte(dot), 0644); err != nil {
+		log.Errorf("niDumpState: %v", err)
+	}
+}
+
+// niBridgeIfindex returns the ifindex of the network instance bridge.
+func niBridgeIfindex(bridgeName string) (int, error) {
+	link, err := netlink.LinkByName(bridgeName)
+	if err != nil {
+		return 0, fmt.Errorf("LinkByName(%s) failed: %v", bridgeName, err)
+	}
+	return link.Attrs().Index, nil
+}
+
+// niBridgeMac returns the MAC address we assign to bnX bridges.
+func niBridgeMac(bridgeNum int) string {
+	return fmt.Sprintf("00:16:3e:06:00:%02x", bridgeNum)
+}
+
+// vifItemForUnderlay builds the VIF item for an application underlay.
+func vifItemForUnderlay(appNetStatus *types.AppNetworkStatus,
+	ulStatus *types.UnderlayNetworkStatus) vifItem {
+
+	vif := vifItem{
+		vifName:   ulStatus.Vif,
+		appMac:    ulStatus.Mac,
+		appIPAddr: ulStatus.AllocatedIPv4Addr,
+		appID:     appNetStatus.UUIDandVersion.UUID.String(),
+		appName:   appNetStatus.DisplayName,
+	}
+	if ulStatus.Vlan.Start != 0 && !ulStatus.Vlan.IsTrunk {
+		vif.accessVlanID = ulStatus.Vlan.Start
+	}
+	return vif
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+func TestNIIntendedItems(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "zedrouter", 0)
+	ctx := &zedrouterContext{deviceNetworkStatus: &types.DeviceNetworkStatus{}}
+	_, subnet, _ := net.ParseCIDR("10.1.0.0/24")
+	localNI := func(uplinks ...string) types.NetworkInstanceStatus {
+		status := types.NetworkInstanceStatus{
+			NetworkInstanceConfig: types.NetworkInstanceConfig{
+				Type:   types.NetworkInstanceTypeLocal,
+				Subnet: *subnet,
+			},
+		}
+		status.BridgeName = "bn1"
+		status.BridgeMac = niBridgeMac(1)
+		status.BridgeIPAddr = "10.1.0.1"
+		status.IfNameList = uplinks
+		if len(uplinks) > 0 {
+			status.CurrentUplinkIntf = uplinks[0]
+		}
+		return status
+	}
+	lbNI := localNI("eth0", "eth1")
+	lbNI.UplinkLoadBalancing = types.UplinkLoadBalancingPerFlow
+	lbNI.LBUplinks = []types.UplinkLBPort{
+		{IfName: "eth0", Mark: 1, Weight: 1},
+		{IfName: "eth1", Mark: 2, Weight: 1},
+	}
+	switchNI := types.NetworkInstanceStatus{
+		NetworkInstanceConfig: types.NetworkInstanceConfig{
+			Type: types.NetworkInstanceTypeSwitch,
+		},
+	}
+	switchNI.BridgeName = "eth0"
+
+	testMatrix := map[string]struct {
+		status    types.NetworkInstanceStatus
+		activated bool
+		expected  []string
+	}{
+		"no bridge": {
+			status:    types.NetworkInstanceStatus{},
+			activated: true,
+		},
+		"local inactive": {
+			status: localNI("eth0"),
+			expected: []string{
+				bridgeTypename + "/bn1",
+				dnsmasqTypename + "/bn1",
+			},
+		},
+		"local without uplink": {
+			status:    localNI(),
+			activated: true,
+			expected: []string{
+				bridgeTypename + "/bn1",
+				dnsmasqTypename + "/bn1",
+			},
+		},
+		"local": {
+			status:    localNI("eth0"),
+			activated: true,
+			expected: []string{
+				bridgeTypename + "/bn1",
+				dnsmasqTypename + "/bn1",
+				iptablesChainTypename + "/mangle/PREROUTING-bn1",
+				iptablesChainTypename + "/nat/POSTROUTING-bn1",
+				routeTableTypename + "/bn1",
+			},
+		},
+		"local load balanced": {
+			status:    lbNI,
+			activated: true,
+			expected: []string{
+				bridgeTypename + "/bn1",
+				dnsmasqTypename + "/bn1",
+				iptablesChainTypename + "/mangle/PREROUTING-bn1",
+				iptablesChainTypename + "/nat/POSTROUTING-bn1",
+				routeTableTypename + "/bn1",
+				uplinkRouteTableTypename + "/bn1/eth0",
+				uplinkRouteTableTypename + "/bn1/eth1",
+			},
+		},
+		"switch": {
+			status:    switchNI,
+			activated: true,
+			expected: []string{
+				bridgeTypename + "/eth0",
+			},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var names []string
+		for _, item := range niIntendedItems(ctx, &test.status, test.activated) {
+			names = append(names, item.Type()+"/"+item.Name())
+		}
+		if !reflect.DeepEqual(names, test.expected) {
+			t.Errorf("%s: got %v, expected %v", testname, names, test.expected)
+		}
+	}
+
+	// The NAT chain has a rule per uplink and the bridge of nim has no
+	// address set by zedrouter
+	items := niIntendedItems(ctx, &lbNI, true)
+	natChain := items[3].(iptablesChainItem)
+	if len(natChain.rules) != 2 || natChain.rules[1][1] != "eth1" {
+		t.Errorf("unexpected NAT rules %v", natChain.rules)
+	}
+	if bridge := items[0].(bridgeItem); bridge.createdByNIM ||
+		bridge.ipAddr != "10.1.0.1/24" {
+		t.Errorf("unexpected bridge %+v", bridge)
+	}
+	switchNI.BridgeIPAddr = "192.168.1.10"
+	items = niIntendedItems(ctx, &switchNI, false)
+	if bridge := items[0].(bridgeItem); !bridge.createdByNIM ||
+		bridge.ipAddr != "" {
+		t.Errorf("unexpected bridge %+v", bridge)
+	}
+}
+
+func TestNIPutVlanMarkChain(t *testing.T) {
+	status := types.NetworkInstanceStatus{
+		NetworkInstanceConfig: types.NetworkInstanceConfig{
+			Type: types.NetworkInstanceTypeSwitch,
+		},
+	}
+	status.BridgeName = "eth0"
+	status.VlanMap = map[uint32]uint32{10: 1}
+	status.TrunkVlanMap = map[uint32]uint32{10: 1, 20: 1}
+	niGraph := dg.New(dg.InitArgs{Name: "ni"})
+	niGraph.PutItem(vifItem{vifName: "nbu2x1", accessVlanID: 10}, nil)
+	niGraph.PutItem(vifItem{vifName: "nbu1x1"}, nil)
+	niPutVlanMarkChain(niGraph, &status)
+
+	chainRef := dg.ItemRef{ItemType: ebtablesChainTypename,
+		ItemName: "nat/vlan-eth0"}
+	item, _, _, found := niGraph.Item(chainRef)
+	if !found {
+		t.Fatalf("VLAN mark chain missing")
+	}
+	expected := [][]string{
+		{"-i", "nbu2x1+", "-j", "mark", "--mark-set", "10",
+			"--mark-target", "RETURN"},
+		{"-p", "802_1Q", "--vlan-id", "10", "-j", "mark", "--mark-set", "10",
+			"--mark-target", "RETURN"},
+		{"-p", "802_1Q", "--vlan-id", "20", "-j", "mark", "--mark-set", "20",
+			"--mark-target", "RETURN"},
+	}
+	if rules := item.(ebtablesChainItem).rules; !reflect.DeepEqual(rules, expected) {
+		t.Errorf("got rules %v, expected %v", rules, expected)
+	}
+
+	// The chain is removed once no VLAN is used
+	niGraph.DelItem(dg.ItemRef{ItemType: vifTypename, ItemName: "nbu2x1"})
+	status.VlanMap = map[uint32]uint32{}
+	status.TrunkVlanMap = map[uint32]uint32{}
+	niPutVlanMarkChain(niGraph, &status)
+	if _, _, _, found := niGraph.Item(chainRef); found {
+		t.Errorf("VLAN mark chain not removed")
+	}
+}
@@ -218,6 +218,7 @@ func PbrRouteChange(ctx *zedrouterContext,
 					myrt, myrt.Table, err)
 			}
 		}
+		lbRouteChange(ctx, ifname, rt, false)
 	} else if change.Type == getRouteUpdateTypeNEWROUTE() {
 		log.Functionf("Received route add %v\n", rt)
 		if linkType == "bridge" {
@@ -245,6 +246,7 @@ func PbrRouteChange(ctx *zedrouterContext,
 					myrt, myrt.Table, err)
 			}
 		}
+		lbRouteChange(ctx, ifname, rt, true)
 	}
 }
 
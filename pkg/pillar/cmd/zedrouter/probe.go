@@ -490,6 +490,7 @@ func probeCheckStatus(ctx *zedrouterContext, status *types.NetworkInstanceStatus
 			}
 		}
 	}
+	lbCheckStatus(ctx, status)
 	log.Tracef("probeCheckStatus: %s current Uplink Intf %s, prev %s, need-update %v\n",
 		status.BridgeName, status.CurrentUplinkIntf, status.PrevUplinkIntf, status.NeedIntfUpdate)
 }
@@ -794,6 +795,10 @@ func ipAddrIsValid(ipAddr net.IP) bool {
 
 func getNIProbeMetric(ctx *zedrouterContext, netstatus *types.NetworkInstanceStatus) types.ProbeMetrics {
 	var metrics types.ProbeMetrics
+	var flowCounts map[uint8]uint32
+	if len(netstatus.ProgLBUplinks) > 0 {
+		flowCounts = lbFlowCounts(netstatus.Subnet)
+	}
 	remoteURL := getSystemURL()
 	probeMutex.Lock()
 	defer probeMutex.Unlock()
@@ -820,6 +825,13 @@ func getNIProbeMetric(ctx *zedrouterContext, netstatus *types.NetworkInstanceSta
 				RemoteDownCnt:   infom.FailedProbeCnt,
 				LatencyToRemote: uint32(infom.AveLatency),
 			}
+			for _, uplink := range mapstatus.ProgLBUplinks {
+				if uplink.IfName == infom.IfName {
+					intfstats.LoadBalanced = true
+					intfstats.Weight = uplink.Weight
+					intfstats.FlowCount = flowCounts[uplink.Mark]
+				}
+			}
 			metrics.IntfProbeStats = append(metrics.IntfProbeStats, intfstats)
 		}
 	}
@@ -9,6 +9,7 @@ package zedrouter
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/base64"
 	"encoding/binary"
 	"encoding/json"
@@ -21,14 +22,17 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/iptables"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
 )
 
 // Provides a json file
@@ -51,6 +55,12 @@ type openstackHandler struct {
 	ctx *zedrouterContext
 }
 
+// Provides EC2 style meta-data/user-data protected by IMDSv2 session tokens
+type ec2Handler struct {
+	ctx    *zedrouterContext
+	tokens *ec2TokenStore
+}
+
 // Provides k3s cluster kubeconfig
 type kubeConfigHandler struct {
 	ctx *zedrouterContext
@@ -91,6 +101,10 @@ func createServer4(ctx *zedrouterContext, bridgeIP string, bridgeName string) er
 	kubeConfigHandler := &kubeConfigHandler{ctx: ctx}
 	mux.Handle("/eve/v1/kubeconfig", kubeConfigHandler)
 
+	// EC2 clients use /latest/ or a dated version hence the catch-all
+	ec2Handler := &ec2Handler{ctx: ctx, tokens: newEC2TokenStore()}
+	mux.Handle("/", ec2Handler)
+
 	targetPort := 80
 	subnetStr := "169.254.169.254/32"
 	target := fmt.Sprintf("%s:%d", bridgeIP, targetPort)
@@ -546,10 +560,12 @@ func (hdl openstackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write(resp)
 	case "network_data.json":
-		resp, _ := json.Marshal(map[string]interface{}{
-			"services": []string{},
-			"networks": []string{},
-		})
+		networkData := buildOpenstackNetworkData(anStatus.UnderlayNetworkList,
+			func(network string) *types.NetworkInstanceStatus {
+				return lookupNetworkInstanceStatus(hdl.ctx, network)
+			},
+			getVifMTU, hdl.ctx.disableDHCPAllOnesNetMask)
+		resp, _ := json.Marshal(networkData)
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 		w.Write(resp)
@@ -581,6 +597,429 @@ func (hdl openstackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotFound)
 }
 
+const (
+	ec2TokenHeader    = "X-aws-ec2-metadata-token"
+	ec2TokenTTLHeader = "X-aws-ec2-metadata-token-ttl-seconds"
+	// ec2TokenMaxTTL is the maximum token lifetime allowed by IMDSv2
+	ec2TokenMaxTTL = 6 * time.Hour
+	// ec2MaxTokensPerApp bounds the number of sessions of an app instance;
+	// the token closest to expiry is dropped when a new one is requested
+	ec2MaxTokensPerApp = 64
+)
+
+// Matches the dated EC2 meta-data versions e.g. 2009-04-04
+var ec2VersionRegexp = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}$`)
+
+// isEC2Version returns true for "latest" and the dated versions
+func isEC2Version(version string) bool {
+	return version == "latest" || ec2VersionRegexp.MatchString(version)
+}
+
+type ec2Token struct {
+	appIP  string
+	expiry time.Time
+}
+
+// ec2TokenStore holds the IMDSv2 session tokens handed out by one server.
+// A token can only be used by the app instance IP which requested it.
+type ec2TokenStore struct {
+	sync.Mutex
+	tokens map[string]ec2Token
+}
+
+func newEC2TokenStore() *ec2TokenStore {
+	return &ec2TokenStore{tokens: make(map[string]ec2Token)}
+}
+
+// create returns a new token for appIP valid for ttl
+func (store *ec2TokenStore) create(appIP string, ttl time.Duration,
+	now time.Time) (string, error) {
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate token: %v", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+
+	store.Lock()
+	defer store.Unlock()
+	var count int
+	var oldest string
+	for t, info := range store.tokens {
+		if !now.Before(info.expiry) {
+			delete(store.tokens, t)
+			continue
+		}
+		if info.appIP != appIP {
+			continue
+		}
+		count++
+		if oldest == "" || info.expiry.Before(store.tokens[oldest].expiry) {
+			oldest = t
+		}
+	}
+	if count >= ec2MaxTokensPerApp {
+		delete(store.tokens, oldest)
+	}
+	store.tokens[token] = ec2Token{appIP: appIP, expiry: now.Add(ttl)}
+	return token, nil
+}
+
+// valid returns true if the token was created for appIP and has not expired
+func (store *ec2TokenStore) valid(token string, appIP string,
+	now time.Time) bool {
+
+	if token == "" {
+		return false
+	}
+	store.Lock()
+	defer store.Unlock()
+	info, ok := store.tokens[token]
+	if !ok {
+		return false
+	}
+	if !now.Before(info.expiry) {
+		delete(store.tokens, token)
+		return false
+	}
+	return info.appIP == appIP
+}
+
+// ServeHTTP for ec2Handler provides the EC2 metadata service with
+// tokens as in IMDSv2. Requests without a valid token are rejected.
+func (hdl ec2Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log.Tracef("ec2Handler ServeHTTP request: %s %s", r.Method,
+		r.URL.String())
+	remoteIP := net.ParseIP(strings.Split(r.RemoteAddr, ":")[0])
+	anStatus := lookupAppNetworkStatusByAppIP(hdl.ctx, remoteIP)
+	if anStatus == nil {
+		errorLine := fmt.Sprintf("no AppNetworkStatus for %s",
+			remoteIP.String())
+		log.Error(errorLine)
+		http.Error(w, errorLine, http.StatusNotFound)
+		return
+	}
+	anConfig := lookupAppNetworkConfig(hdl.ctx, anStatus.Key())
+	if anConfig == nil {
+		errorLine := fmt.Sprintf("no AppNetworkConfig for %s",
+			anStatus.Key())
+		log.Error(errorLine)
+		http.Error(w, errorLine, http.StatusNotFound)
+		return
+	}
+	if anConfig.MetaDataType != types.MetaDataEC2 {
+		errorLine := fmt.Sprintf("no MetaDataEC2 for %s",
+			anStatus.Key())
+		log.Tracef(errorLine)
+		http.Error(w, errorLine, http.StatusNotFound)
+		return
+	}
+	elems := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if !isEC2Version(elems[0]) {
+		http.NotFound(w, r)
+		return
+	}
+	elems = elems[1:]
+	if len(elems) == 2 && elems[0] == "api" && elems[1] == "token" {
+		hdl.serveToken(w, r, remoteIP)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed),
+			http.StatusMethodNotAllowed)
+		return
+	}
+	if !hdl.tokens.valid(r.Header.Get(ec2TokenHeader), remoteIP.String(),
+		time.Now()) {
+		log.Warnf("ec2Handler: missing or invalid token from %s",
+			remoteIP.String())
+		http.Error(w, http.StatusText(http.StatusUnauthorized),
+			http.StatusUnauthorized)
+		return
+	}
+	switch {
+	case len(elems) == 0 || elems[0] == "":
+		writeEC2Text(w, []string{"meta-data/", "user-data"})
+	case elems[0] == "meta-data":
+		hdl.serveMetaData(w, r, anStatus, anConfig, remoteIP, elems[1:])
+	case elems[0] == "user-data" && len(elems) == 1:
+		userData, err := getCloudInitUserData(hdl.ctx, anConfig)
+		if err != nil {
+			errorLine := fmt.Sprintf("cannot get userData for %s: %v",
+				anStatus.Key(), err)
+			log.Error(errorLine)
+			http.Error(w, errorLine, http.StatusInternalServerError)
+			return
+		}
+		ud, err := base64.StdEncoding.DecodeString(userData)
+		if err != nil {
+			errorLine := fmt.Sprintf("cannot decode userData for %s: %v",
+				anStatus.Key(), err)
+			log.Error(errorLine)
+			http.Error(w, errorLine, http.StatusInternalServerError)
+			return
+		}
+		if len(ud) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.WriteHeader(http.StatusOK)
+		w.Write(ud)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveToken handles PUT api/token
+func (hdl ec2Handler) serveToken(w http.ResponseWriter, r *http.Request,
+	remoteIP net.IP) {
+
+	if r.Method != http.MethodPut {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed),
+			http.StatusMethodNotAllowed)
+		return
+	}
+	// As in IMDSv2 refuse requests which went through a proxy
+	if r.Header.Get("X-Forwarded-For") != "" {
+		http.Error(w, http.StatusText(http.StatusForbidden),
+			http.StatusForbidden)
+		return
+	}
+	ttlStr := r.Header.Get(ec2TokenTTLHeader)
+	ttlSeconds, err := strconv.Atoi(ttlStr)
+	if err != nil || ttlSeconds < 1 ||
+		time.Duration(ttlSeconds)*time.Second > ec2TokenMaxTTL {
+		errorLine := fmt.Sprintf("invalid %s %q", ec2TokenTTLHeader, ttlStr)
+		http.Error(w, errorLine, http.StatusBadRequest)
+		return
+	}
+	token, err := hdl.tokens.create(remoteIP.String(),
+		time.Duration(ttlSeconds)*time.Second, time.Now())
+	if err != nil {
+		log.Errorf("ec2Handler: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError),
+			http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(ec2TokenTTLHeader, strconv.Itoa(ttlSeconds))
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(token))
+}
+
+// serveMetaData handles the meta-data/ tree
+func (hdl ec2Handler) serveMetaData(w http.ResponseWriter, r *http.Request,
+	anStatus *types.AppNetworkStatus, anConfig *types.AppNetworkConfig,
+	remoteIP net.IP, elems []string) {
+
+	keys := getSSHPublicKeys(hdl.ctx, anConfig)
+	if len(elems) == 0 || elems[0] == "" {
+		entries := []string{"hostname", "instance-id", "local-hostname",
+			"local-ipv4"}
+		if len(keys) != 0 {
+			entries = append(entries, "public-keys/")
+		}
+		writeEC2Text(w, entries)
+		return
+	}
+	switch elems[0] {
+	case "instance-id":
+		writeEC2Text(w, []string{anStatus.UUIDandVersion.UUID.String()})
+	case "hostname", "local-hostname":
+		writeEC2Text(w, []string{anStatus.DisplayName})
+	case "local-ipv4":
+		writeEC2Text(w, []string{remoteIP.String()})
+	case "public-keys":
+		if len(keys) == 0 {
+			http.NotFound(w, r)
+			return
+		}
+		if len(elems) == 1 || elems[1] == "" {
+			var entries []string
+			for ind := range keys {
+				entries = append(entries, fmt.Sprintf("%d=key-%d", ind, ind))
+			}
+			writeEC2Text(w, entries)
+			return
+		}
+		ind, err := strconv.Atoi(elems[1])
+		if err != nil || ind < 0 || ind >= len(keys) {
+			http.NotFound(w, r)
+			return
+		}
+		if len(elems) == 2 || elems[2] == "" {
+			writeEC2Text(w, []string{"openssh-key"})
+		} else if len(elems) == 3 && elems[2] == "openssh-key" {
+			writeEC2Text(w, []string{keys[ind]})
+		} else {
+			http.NotFound(w, r)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// writeEC2Text returns a LF-separated list of entries or a single value
+func writeEC2Text(w http.ResponseWriter, lines []string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(strings.Join(lines, "\n")))
+}
+
+// openstackNetworkData is the network_data.json content
+type openstackNetworkData struct {
+	Links    []openstackLink    `json:"links"`
+	Networks []openstackNetwork `json:"networks"`
+	Services []openstackService `json:"services"`
+}
+
+type openstackLink struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	MacAddress string `json:"ethernet_mac_address"`
+	MTU        int    `json:"mtu,omitempty"`
+}
+
+type openstackNetwork struct {
+	ID        string             `json:"id"`
+	Type      string             `json:"type"`
+	Link      string             `json:"link"`
+	NetworkID string             `json:"network_id"`
+	IPAddress string             `json:"ip_address,omitempty"`
+	Netmask   string             `json:"netmask,omitempty"`
+	Routes    []openstackRoute   `json:"routes,omitempty"`
+	Services  []openstackService `json:"services,omitempty"`
+}
+
+type openstackRoute struct {
+	Network string `json:"network"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway"`
+}
+
+type openstackService struct {
+	Type    string `json:"type"`
+	Address string `json:"address"`
+}
+
+// buildOpenstackNetworkData returns a link per VIF and a network per VIF.
+// For local network instances with an allocated IPv4 address the network
+// is static with the same netmask, routes and DNS servers as dnsmasq
+// would hand out, otherwise the guest is told to use DHCP.
+func buildOpenstackNetworkData(ulStatusList []types.UnderlayNetworkStatus,
+	lookupNI func(network string) *types.NetworkInstanceStatus,
+	vifMTU func(vifName string) int,
+	disableDHCPAllOnesNetMask bool) openstackNetworkData {
+
+	data := openstackNetworkData{
+		Links:    []openstackLink{},
+		Networks: []openstackNetwork{},
+		Services: []openstackService{},
+	}
+	for i, ulStatus := range ulStatusList {
+		link := openstackLink{
+			ID:         fmt.Sprintf("tap%d", i),
+			Type:       "vif",
+			MacAddress: ulStatus.Mac,
+		}
+		if ulStatus.VifUsed != "" {
+			link.MTU = vifMTU(ulStatus.VifUsed)
+		}
+		data.Links = append(data.Links, link)
+		network := openstackNetwork{
+			ID:        fmt.Sprintf("network%d", i),
+			Type:      "ipv4_dhcp",
+			Link:      link.ID,
+			NetworkID: ulStatus.Network.String(),
+		}
+		niStatus := lookupNI(ulStatus.Network.String())
+		ip := net.ParseIP(ulStatus.AllocatedIPv4Addr)
+		if niStatus == nil || niStatus.Type == types.NetworkInstanceTypeSwitch ||
+			ip == nil || ip.To4() == nil || niStatus.Subnet.IP == nil {
+			data.Networks = append(data.Networks, network)
+			continue
+		}
+		network.Type = "ipv4"
+		network.IPAddress = ip.String()
+		router := openstackRouter(niStatus)
+		if router != nil && !disableDHCPAllOnesNetMask {
+			// Same as the all-ones netmask and classless routes
+			// from dnsmasq so that traffic goes through the bridge
+			network.Netmask = "255.255.255.255"
+			network.Routes = []openstackRoute{
+				{Network: router.String(), Netmask: "255.255.255.255",
+					Gateway: "0.0.0.0"},
+				{Network: "0.0.0.0", Netmask: "0.0.0.0",
+					Gateway: router.String()},
+				{Network: niStatus.Subnet.IP.String(),
+					Netmask: net.IP(niStatus.Subnet.Mask).String(),
+					Gateway: router.String()},
+			}
+		} else {
+			network.Netmask = net.IP(niStatus.Subnet.Mask).String()
+			if router != nil {
+				network.Routes = []openstackRoute{
+					{Network: "0.0.0.0", Netmask: "0.0.0.0",
+						Gateway: router.String()},
+				}
+			}
+		}
+		var dnsServers []net.IP
+		if len(niStatus.DnsServers) != 0 {
+			dnsServers = niStatus.DnsServers
+		} else if router != nil && niStatus.BridgeIPAddr != "" {
+			// dnsmasq on the bridge is the default DNS server
+			dnsServers = []net.IP{net.ParseIP(niStatus.BridgeIPAddr)}
+		}
+		for _, dnsServer := range dnsServers {
+			if dnsServer == nil {
+				continue
+			}
+			service := openstackService{Type: "dns",
+				Address: dnsServer.String()}
+			network.Services = append(network.Services, service)
+			found := false
+			for _, s := range data.Services {
+				if s == service {
+					found = true
+					break
+				}
+			}
+			if !found {
+				data.Services = append(data.Services, service)
+			}
+		}
+		data.Networks = append(data.Networks, network)
+	}
+	return data
+}
+
+// openstackRouter returns the router which dnsmasq advertizes for the
+// network instance, if any
+func openstackRouter(niStatus *types.NetworkInstanceStatus) net.IP {
+	if niStatus.Logicallabel == "" {
+		return nil
+	}
+	if niStatus.Gateway != nil {
+		if niStatus.Gateway.IsUnspecified() {
+			return nil
+		}
+		return niStatus.Gateway
+	}
+	return net.ParseIP(niStatus.BridgeIPAddr)
+}
+
+// getVifMTU returns zero if the MTU can not be determined
+func getVifMTU(vifName string) int {
+	link, err := netlink.LinkByName(vifName)
+	if err != nil {
+		log.Warnf("getVifMTU(%s) failed: %v", vifName, err)
+		return 0
+	}
+	return link.Attrs().MTU
+}
+
 // ServeHTTP for kubeConfigHandler provides cluster kube config
 func (hdl kubeConfigHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -0,0 +1,168 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsEC2Version(t *testing.T) {
+	tests := map[string]bool{
+		"latest":      true,
+		"2009-04-04":  true,
+		"2021-03-23":  true,
+		"openstack":   false,
+		"2009-04":     false,
+		"eve":         false,
+		"":            false,
+		"2009-04-04x": false,
+	}
+	for version, expected := range tests {
+		if isEC2Version(version) != expected {
+			t.Errorf("isEC2Version(%q) expected %t", version, expected)
+		}
+	}
+}
+
+func TestEC2TokenStore(t *testing.T) {
+	store := newEC2TokenStore()
+	now := time.Now()
+	token, err := store.create("10.1.0.2", time.Minute, now)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	if !store.valid(token, "10.1.0.2", now.Add(30*time.Second)) {
+		t.Errorf("token not valid before expiry")
+	}
+	if store.valid(token, "10.1.0.3", now) {
+		t.Errorf("token valid for another app IP")
+	}
+	if store.valid("", "10.1.0.2", now) {
+		t.Errorf("empty token valid")
+	}
+	if store.valid(token, "10.1.0.2", now.Add(time.Minute)) {
+		t.Errorf("token valid after expiry")
+	}
+	if len(store.tokens) != 0 {
+		t.Errorf("expired token not removed")
+	}
+
+	// The token closest to expiry is dropped when over the limit
+	first, err := store.create("10.1.0.2", time.Minute, now)
+	if err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	for i := 1; i <= ec2MaxTokensPerApp; i++ {
+		if _, err := store.create("10.1.0.2", time.Hour, now); err != nil {
+			t.Fatalf("create failed: %v", err)
+		}
+	}
+	if store.valid(first, "10.1.0.2", now) {
+		t.Errorf("oldest token not evicted")
+	}
+	if len(store.tokens) != ec2MaxTokensPerApp {
+		t.Errorf("expected %d tokens, got %d", ec2MaxTokensPerApp,
+			len(store.tokens))
+	}
+}
+
+func TestBuildOpenstackNetworkData(t *testing.T) {
+	localNI := uuid.FromStringOrNil("3a5fa8d4-54f6-4c0b-a6f6-26ee7b6c2b2b")
+	airgapNI := uuid.FromStringOrNil("8c6e2dba-3c61-4d56-9b44-6f5b3fb8b7a6")
+	switchNI := uuid.FromStringOrNil("f4a1c4cb-1d4b-4a9d-8a84-4d1c2d3e4f50")
+	_, subnet, _ := net.ParseCIDR("10.1.0.0/24")
+	niStatuses := map[string]*types.NetworkInstanceStatus{
+		localNI.String(): {
+			NetworkInstanceConfig: types.NetworkInstanceConfig{
+				Type:         types.NetworkInstanceTypeLocal,
+				Logicallabel: "uplink",
+				Subnet:       *subnet,
+			},
+			NetworkInstanceInfo: types.NetworkInstanceInfo{
+				BridgeIPAddr: "10.1.0.1",
+			},
+		},
+		airgapNI.String(): {
+			NetworkInstanceConfig: types.NetworkInstanceConfig{
+				Type:       types.NetworkInstanceTypeLocal,
+				Subnet:     *subnet,
+				DnsServers: []net.IP{net.ParseIP("10.1.0.53")},
+			},
+			NetworkInstanceInfo: types.NetworkInstanceInfo{
+				BridgeIPAddr: "10.1.0.1",
+			},
+		},
+		switchNI.String(): {
+			NetworkInstanceConfig: types.NetworkInstanceConfig{
+				Type: types.NetworkInstanceTypeSwitch,
+			},
+		},
+	}
+	ulStatusList := []types.UnderlayNetworkStatus{
+		{
+			UnderlayNetworkConfig: types.UnderlayNetworkConfig{Network: localNI},
+			VifInfo:               types.VifInfo{VifUsed: "nbu1x1", Mac: "02:16:3e:00:00:01"},
+			AllocatedIPv4Addr:     "10.1.0.2",
+		},
+		{
+			UnderlayNetworkConfig: types.UnderlayNetworkConfig{Network: airgapNI},
+			VifInfo:               types.VifInfo{VifUsed: "nbu2x1", Mac: "02:16:3e:00:00:02"},
+			AllocatedIPv4Addr:     "10.1.0.3",
+		},
+		{
+			UnderlayNetworkConfig: types.UnderlayNetworkConfig{Network: switchNI},
+			VifInfo:               types.VifInfo{VifUsed: "nbu3x1", Mac: "02:16:3e:00:00:03"},
+		},
+	}
+	lookupNI := func(network string) *types.NetworkInstanceStatus {
+		return niStatuses[network]
+	}
+	vifMTU := func(vifName string) int { return 1500 }
+
+	data := buildOpenstackNetworkData(ulStatusList, lookupNI, vifMTU, false)
+	assert.Equal(t, []openstackLink{
+		{ID: "tap0", Type: "vif", MacAddress: "02:16:3e:00:00:01", MTU: 1500},
+		{ID: "tap1", Type: "vif", MacAddress: "02:16:3e:00:00:02", MTU: 1500},
+		{ID: "tap2", Type: "vif", MacAddress: "02:16:3e:00:00:03", MTU: 1500},
+	}, data.Links)
+	assert.Equal(t, []openstackNetwork{
+		{
+			ID: "network0", Type: "ipv4", Link: "tap0",
+			NetworkID: localNI.String(),
+			IPAddress: "10.1.0.2", Netmask: "255.255.255.255",
+			Routes: []openstackRoute{
+				{Network: "10.1.0.1", Netmask: "255.255.255.255", Gateway: "0.0.0.0"},
+				{Network: "0.0.0.0", Netmask: "0.0.0.0", Gateway: "10.1.0.1"},
+				{Network: "10.1.0.0", Netmask: "255.255.255.0", Gateway: "10.1.0.1"},
+			},
+			Services: []openstackService{{Type: "dns", Address: "10.1.0.1"}},
+		},
+		{
+			ID: "network1", Type: "ipv4", Link: "tap1",
+			NetworkID: airgapNI.String(),
+			IPAddress: "10.1.0.3", Netmask: "255.255.255.0",
+			Services: []openstackService{{Type: "dns", Address: "10.1.0.53"}},
+		},
+		{
+			ID: "network2", Type: "ipv4_dhcp", Link: "tap2",
+			NetworkID: switchNI.String(),
+		},
+	}, data.Networks)
+	assert.Equal(t, []openstackService{
+		{Type: "dns", Address: "10.1.0.1"},
+		{Type: "dns", Address: "10.1.0.53"},
+	}, data.Services)
+
+	data = buildOpenstackNetworkData(ulStatusList[:1], lookupNI, vifMTU, true)
+	assert.Equal(t, "255.255.255.0", data.Networks[0].Netmask)
+	assert.Equal(t, []openstackRoute{
+		{Network: "0.0.0.0", Netmask: "0.0.0.0", Gateway: "10.1.0.1"},
+	}, data.Networks[0].Routes)
+}
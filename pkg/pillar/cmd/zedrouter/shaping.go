@@ -0,0 +1,457 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Bandwidth shaping of the app vifs and of the network instance bridges
+// with tc HTB classes and fq_codel queues. The shaping of a network
+// instance is on its bridge, hence shared by its uplinks.
+// The traffic sent out of an interface is shaped by its root qdisc, the
+// traffic received is redirected by an ingress qdisc to an ifb and shaped
+// by the root qdisc of the ifb.
+// On a vif the traffic sent out is received by the app, hence the app
+// ingress shaping is on the vif and the app egress shaping is on the ifb.
+// On a bridge the traffic sent out is routed to the apps, hence the
+// network instance ingress shaping is on the bridge, with a class per app
+// guarantee matching on the destination address, and the network instance
+// egress shaping is on the ifb, with the app classes matching on the source.
+
+package zedrouter
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// tc class and qdisc ids, in hex
+	shapingRootClass    = "1:1"
+	shapingDefaultMinor = "fffe"
+	shapingIngressQdisc = "ffff:"
+	shapingFirstLeaf    = 2
+	// HTB needs a rate for the default class, even if the guarantees
+	// of the apps use all of the rate of the network instance
+	shapingMinRate = 8 // kbit/s
+)
+
+// shapingClass is an HTB class, in kbit/s
+type shapingClass struct {
+	Rate uint64
+	Ceil uint64
+}
+
+// shapingLeaf is the class of an app guarantee in a network instance
+type shapingLeaf struct {
+	IP net.IP
+	shapingClass
+}
+
+// shapingTree is the HTB tree of one direction of an interface.
+// The traffic not matching a leaf goes to a default class getting the
+// rate left by the leaves.
+type shapingTree struct {
+	Root   shapingClass
+	Leaves []shapingLeaf
+}
+
+// shapingConfig is the shaping of an interface, Out for the traffic sent
+// out of it and In for the traffic it receives. nil is not shaped.
+type shapingConfig struct {
+	Out *shapingTree
+	In  *shapingTree
+}
+
+func (config shapingConfig) isSet() bool {
+	return config.Out != nil || config.In != nil
+}
+
+func (config shapingConfig) String() string {
+	out, in := "none", "none"
+	if config.Out != nil {
+		out = fmt.Sprintf("%+v", *config.Out)
+	}
+	if config.In != nil {
+		in = fmt.Sprintf("%+v", *config.In)
+	}
+	return fmt.Sprintf("out %s in %s", out, in)
+}
+
+// shapingApp is an app address of a network instance and its shaping
+type shapingApp struct {
+	IP      net.IP
+	Shaping types.BandwidthShaping
+}
+
+// shapingStats are the counters of the root qdisc of an interface
+type shapingStats struct {
+	Drops      uint64 `json:"drops"`
+	Overlimits uint64 `json:"overlimits"`
+	Backlog    uint64 `json:"backlog"`
+}
+
+// shapingClassGet resolves the rate and the ceil of one direction,
+// returns false if the direction is not shaped
+func shapingClassGet(rate, ceil uint64) (shapingClass, bool) {
+	if rate == 0 && ceil == 0 {
+		return shapingClass{}, false
+	}
+	if ceil == 0 {
+		ceil = rate
+	}
+	if rate == 0 || rate > ceil {
+		rate = ceil
+	}
+	return shapingClass{Rate: rate, Ceil: ceil}, true
+}
+
+// vifShapingConfig returns the shaping of an app vif
+func vifShapingConfig(shaping types.BandwidthShaping) shapingConfig {
+	var config shapingConfig
+	if class, ok := shapingClassGet(shaping.IngressRate, shaping.IngressCeil); ok {
+		config.Out = &shapingTree{Root: class}
+	}
+	if class, ok := shapingClassGet(shaping.EgressRate, shaping.EgressCeil); ok {
+		config.In = &shapingTree{Root: class}
+	}
+	return config
+}
+
+// niShapingConfig returns the shaping of a network instance bridge,
+// with the guarantees of the apps as leaves
+func niShapingConfig(shaping types.BandwidthShaping,
+	apps []shapingApp) shapingConfig {
+
+	var config shapingConfig
+	if class, ok := shapingClassGet(shaping.IngressRate, shaping.IngressCeil); ok {
+		tree := shapingTree{Root: class}
+		for _, app := range apps {
+			leaf, ok := shapingClassGet(app.Shaping.IngressRate,
+				app.Shaping.IngressCeil)
+			if ok {
+				tree.Leaves = append(tree.Leaves,
+					shapingLeaf{IP: app.IP, shapingClass: leaf})
+			}
+		}
+		config.Out = &tree
+	}
+	if class, ok := shapingClassGet(shaping.EgressRate, shaping.EgressCeil); ok {
+		tree := shapingTree{Root: class}
+		for _, app := range apps {
+			leaf, ok := shapingClassGet(app.Shaping.EgressRate,
+				app.Shaping.EgressCeil)
+			if ok {
+				tree.Leaves = append(tree.Leaves,
+					shapingLeaf{IP: app.IP, shapingClass: leaf})
+			}
+		}
+		config.In = &tree
+	}
+	return config
+}
+
+func shapingIfbName(ifname string) string {
+	return "ifb" + ifname
+}
+
+func htbClassCommand(dev, parent, classid string, class shapingClass) []string {
+	return []string{"tc", "class", "add", "dev", dev, "parent", parent,
+		"classid", classid, "htb",
+		"rate", fmt.Sprintf("%dkbit", class.Rate),
+		"ceil", fmt.Sprintf("%dkbit", class.Ceil)}
+}
+
+func fqCodelCommand(dev, minor string) []string {
+	return []string{"tc", "qdisc", "add", "dev", dev, "parent", "1:" + minor,
+		"handle", minor + ":", "fq_codel"}
+}
+
+// htbCommands returns the tc commands setting up tree as the root qdisc
+// of dev, the leaves match on the "src" or "dst" address
+func htbCommands(dev string, tree shapingTree, match string) [][]string {
+	cmds := [][]string{
+		{"tc", "qdisc", "add", "dev", dev, "root", "handle", "1:",
+			"htb", "default", shapingDefaultMinor},
+		htbClassCommand(dev, "1:", shapingRootClass, tree.Root),
+	}
+	defaultRate := tree.Root.Rate
+	for i, leaf := range tree.Leaves {
+		if leaf.Rate < defaultRate {
+			defaultRate -= leaf.Rate
+		} else {
+			defaultRate = 0
+		}
+		minor := fmt.Sprintf("%x", i+shapingFirstLeaf)
+		class := shapingClass{Rate: leaf.Rate, Ceil: tree.Root.Ceil}
+		protocol, ipMatch, prefix := "ip", "ip", "/32"
+		if leaf.IP.To4() == nil {
+			protocol, ipMatch, prefix = "ipv6", "ip6", "/128"
+		}
+		cmds = append(cmds,
+			htbClassCommand(dev, shapingRootClass, "1:"+minor, class),
+			fqCodelCommand(dev, minor),
+			[]string{"tc", "filter", "add", "dev", dev, "parent", "1:",
+				"protocol", protocol, "prio", "1", "u32",
+				"match", ipMatch, match, leaf.IP.String() + prefix,
+				"flowid", "1:" + minor})
+	}
+	if defaultRate < shapingMinRate {
+		defaultRate = shapingMinRate
+	}
+	class := shapingClass{Rate: defaultRate, Ceil: tree.Root.Ceil}
+	cmds = append(cmds,
+		htbClassCommand(dev, shapingRootClass, "1:"+shapingDefaultMinor, class),
+		fqCodelCommand(dev, shapingDefaultMinor))
+	return cmds
+}
+
+// shapingCommands returns the ip and tc commands setting up config on ifname
+func shapingCommands(ifname string, config shapingConfig) [][]string {
+	var cmds [][]string
+	if config.Out != nil {
+		cmds = append(cmds, htbCommands(ifname, *config.Out, "dst")...)
+	}
+	if config.In != nil {
+		ifb := shapingIfbName(ifname)
+		cmds = append(cmds,
+			[]string{"ip", "link", "add", ifb, "type", "ifb"},
+			[]string{"ip", "link", "set", ifb, "up"},
+			[]string{"tc", "qdisc", "add", "dev", ifname,
+				"handle", shapingIngressQdisc, "ingress"},
+			[]string{"tc", "filter", "add", "dev", ifname,
+				"parent", shapingIngressQdisc, "protocol", "all",
+				"prio", "1", "u32", "match", "u32", "0", "0",
+				"action", "mirred", "egress", "redirect", "dev", ifb})
+		cmds = append(cmds, htbCommands(ifb, *config.In, "src")...)
+	}
+	return cmds
+}
+
+// shapingApply replaces the shaping of ifname with config, unless it is
+// already set up. Nothing is done if ifname does not exist (yet), the vifs
+// are set up in shapingLinkChange when the domain is started.
+func shapingApply(ctx *zedrouterContext, ifname string,
+	config shapingConfig) error {
+
+	if current, ok := ctx.shapedIntfs[ifname]; ok &&
+		reflect.DeepEqual(current, config) {
+		return nil
+	}
+	shapingDelete(ctx, ifname)
+	if !config.isSet() {
+		return nil
+	}
+	if _, err := netlink.LinkByName(ifname); err != nil {
+		log.Functionf("shapingApply(%s): no link: %v", ifname, err)
+		return nil
+	}
+	log.Noticef("shapingApply(%s): %s", ifname, config)
+	if config.In != nil {
+		// In case it was left behind by a previous run
+		base.Exec(log, "ip", "link", "del",
+			shapingIfbName(ifname)).CombinedOutput()
+	}
+	for _, cmd := range shapingCommands(ifname, config) {
+		out, err := base.Exec(log, cmd[0], cmd[1:]...).CombinedOutput()
+		if err != nil {
+			err = fmt.Errorf("%s failed: %s: %v",
+				strings.Join(cmd, " "), out, err)
+			log.Errorf("shapingApply(%s): %v", ifname, err)
+			// Do not leave a partial setup behind
+			ctx.shapedIntfs[ifname] = config
+			shapingDelete(ctx, ifname)
+			return err
+		}
+	}
+	ctx.shapedIntfs[ifname] = config
+	return nil
+}
+
+// shapingDelete removes the qdiscs and the ifb of ifname, if any
+func shapingDelete(ctx *zedrouterContext, ifname string) {
+	config, ok := ctx.shapedIntfs[ifname]
+	if !ok {
+		return
+	}
+	log.Noticef("shapingDelete(%s)", ifname)
+	// The qdiscs may be gone with the link, ignore the errors
+	if config.Out != nil {
+		base.Exec(log, "tc", "qdisc", "del", "dev", ifname,
+			"root").CombinedOutput()
+	}
+	if config.In != nil {
+		base.Exec(log, "tc", "qdisc", "del", "dev", ifname,
+			"handle", shapingIngressQdisc, "ingress").CombinedOutput()
+		base.Exec(log, "ip", "link", "del",
+			shapingIfbName(ifname)).CombinedOutput()
+	}
+	delete(ctx.shapedIntfs, ifname)
+}
+
+// appNetworkShapingUpdate sets up the shaping of the vifs of an app and
+// of the network instances, which depends on their apps.
+// Called once the AppNetworkStatus is published.
+func appNetworkShapingUpdate(ctx *zedrouterContext,
+	status *types.AppNetworkStatus) {
+
+	for i := range status.UnderlayNetworkList {
+		ulStatus := &status.UnderlayNetworkList[i]
+		if ulStatus.Vif == "" {
+			continue
+		}
+		config := shapingConfig{}
+		if status.Activated {
+			config = vifShapingConfig(ulStatus.Shaping)
+		}
+		if err := shapingApply(ctx, ulStatus.Vif, config); err != nil {
+			addError(ctx, status, "shaping", err)
+		}
+	}
+	// The app might have moved from one network instance to another
+	ctx.networkInstanceStatusMap.Range(func(key, value interface{}) bool {
+		networkInstanceShapingUpdate(ctx,
+			value.(*types.NetworkInstanceStatus))
+		return true
+	})
+}
+
+// networkInstanceShapingUpdate sets up the shaping of a network instance
+// bridge from the published AppNetworkStatus of its apps
+func networkInstanceShapingUpdate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	if status.BridgeName == "" {
+		return
+	}
+	config := shapingConfig{}
+	if status.Activated && status.Shaping.IsSet() {
+		// zedagent reports the shaping of a switch network instance as
+		// an error, only the app shaping applies
+		if status.Type == types.NetworkInstanceTypeSwitch {
+			log.Warnf("networkInstanceShapingUpdate(%s): not supported for switch network instances, only app shaping applies",
+				status.Key())
+		} else {
+			config = niShapingConfig(status.Shaping,
+				networkInstanceShapingApps(ctx, status))
+		}
+	}
+	if err := shapingApply(ctx, status.BridgeName, config); err != nil {
+		log.Errorf("networkInstanceShapingUpdate(%s): %v", status.Key(), err)
+	}
+}
+
+// networkInstanceShapingApps returns the addresses of the activated apps
+// of a network instance with a shaping
+func networkInstanceShapingApps(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) []shapingApp {
+
+	var apps []shapingApp
+	items := ctx.pubAppNetworkStatus.GetAll()
+	for _, item := range items {
+		appStatus := item.(types.AppNetworkStatus)
+		if !appStatus.Activated {
+			continue
+		}
+		for _, ulStatus := range appStatus.UnderlayNetworkList {
+			if ulStatus.Network != status.UUID || !ulStatus.Shaping.IsSet() {
+				continue
+			}
+			addrs := append([]string{ulStatus.AllocatedIPv4Addr},
+				ulStatus.AllocatedIPv6List...)
+			for _, addr := range addrs {
+				if ip := net.ParseIP(addr); ip != nil {
+					apps = append(apps, shapingApp{IP: ip,
+						Shaping: ulStatus.Shaping})
+				}
+			}
+		}
+	}
+	return apps
+}
+
+// shapingLinkChange sets up the shaping of a vif which was (re)created,
+// typically when a domain is started
+func shapingLinkChange(ctx *zedrouterContext, ifname string) {
+	items := ctx.pubAppNetworkStatus.GetAll()
+	for _, item := range items {
+		status := item.(types.AppNetworkStatus)
+		if !status.Activated {
+			continue
+		}
+		for _, ulStatus := range status.UnderlayNetworkList {
+			if ulStatus.Vif != ifname {
+				continue
+			}
+			// The qdiscs are gone with a deleted link, not the ifb
+			shapingDelete(ctx, ifname)
+			err := shapingApply(ctx, ifname,
+				vifShapingConfig(ulStatus.Shaping))
+			if err != nil {
+				log.Errorf("shapingLinkChange(%s): %v", ifname, err)
+			}
+			return
+		}
+	}
+}
+
+// shapingStatsParse returns the counters of the root qdisc from the
+// output of "tc -s -j qdisc show"
+func shapingStatsParse(out []byte) (shapingStats, error) {
+	var qdiscs []struct {
+		Root bool `json:"root"`
+		shapingStats
+	}
+	if err := json.Unmarshal(out, &qdiscs); err != nil {
+		return shapingStats{}, err
+	}
+	for _, qdisc := range qdiscs {
+		if qdisc.Root {
+			return qdisc.shapingStats, nil
+		}
+	}
+	return shapingStats{}, fmt.Errorf("no root qdisc")
+}
+
+func shapingStatsGet(ifname string) (shapingStats, error) {
+	out, err := base.Exec(log, "tc", "-s", "-j", "qdisc", "show",
+		"dev", ifname).Output()
+	if err != nil {
+		return shapingStats{}, fmt.Errorf("tc qdisc show dev %s failed: %v",
+			ifname, err)
+	}
+	return shapingStatsParse(out)
+}
+
+// shapingMetricsGet adds the counters of the shaped ifname to metric
+func shapingMetricsGet(ctx *zedrouterContext, ifname string,
+	metric *types.NetworkMetric) {
+
+	config, ok := ctx.shapedIntfs[ifname]
+	if !ok {
+		return
+	}
+	if config.Out != nil {
+		stats, err := shapingStatsGet(ifname)
+		if err != nil {
+			log.Errorf("shapingMetricsGet(%s): %v", ifname, err)
+		} else {
+			metric.TxShapingDrops = stats.Drops
+			metric.TxShapingOverlimits = stats.Overlimits
+			metric.TxShapingBacklog = stats.Backlog
+		}
+	}
+	if config.In != nil {
+		stats, err := shapingStatsGet(shapingIfbName(ifname))
+		if err != nil {
+			log.Errorf("shapingMetricsGet(%s): %v", ifname, err)
+		} else {
+			metric.RxShapingDrops = stats.Drops
+			metric.RxShapingOverlimits = stats.Overlimits
+			metric.RxShapingBacklog = stats.Backlog
+		}
+	}
+}
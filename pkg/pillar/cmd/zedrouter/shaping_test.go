@@ -0,0 +1,128 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestShapingClassGet(t *testing.T) {
+	tests := []struct {
+		rate, ceil uint64
+		expected   shapingClass
+		shaped     bool
+	}{
+		{rate: 0, ceil: 0},
+		{rate: 1000, ceil: 0, expected: shapingClass{1000, 1000}, shaped: true},
+		{rate: 0, ceil: 2000, expected: shapingClass{2000, 2000}, shaped: true},
+		{rate: 1000, ceil: 2000, expected: shapingClass{1000, 2000}, shaped: true},
+		{rate: 3000, ceil: 2000, expected: shapingClass{2000, 2000}, shaped: true},
+	}
+	for _, test := range tests {
+		class, shaped := shapingClassGet(test.rate, test.ceil)
+		if shaped != test.shaped || class != test.expected {
+			t.Errorf("rate %d ceil %d: got %+v %v, expected %+v %v",
+				test.rate, test.ceil, class, shaped, test.expected, test.shaped)
+		}
+	}
+}
+
+func TestNiShapingConfig(t *testing.T) {
+	apps := []shapingApp{
+		{IP: net.ParseIP("10.1.0.2"),
+			Shaping: types.BandwidthShaping{EgressRate: 1000, IngressCeil: 500}},
+		{IP: net.ParseIP("10.1.0.3"),
+			Shaping: types.BandwidthShaping{EgressCeil: 3000}},
+	}
+	// Only egress is shaped for the network instance
+	config := niShapingConfig(types.BandwidthShaping{EgressCeil: 10000}, apps)
+	if config.Out != nil {
+		t.Errorf("unexpected ingress shaping %+v", *config.Out)
+	}
+	expected := shapingTree{
+		Root: shapingClass{Rate: 10000, Ceil: 10000},
+		Leaves: []shapingLeaf{
+			{IP: apps[0].IP, shapingClass: shapingClass{Rate: 1000, Ceil: 1000}},
+			{IP: apps[1].IP, shapingClass: shapingClass{Rate: 3000, Ceil: 3000}},
+		},
+	}
+	if config.In == nil || !reflect.DeepEqual(*config.In, expected) {
+		t.Errorf("got %s, expected egress %+v", config, expected)
+	}
+
+	config = vifShapingConfig(apps[0].Shaping)
+	if config.In == nil || config.In.Root != (shapingClass{1000, 1000}) ||
+		config.Out == nil || config.Out.Root != (shapingClass{500, 500}) {
+		t.Errorf("unexpected vif shaping %s", config)
+	}
+}
+
+func TestShapingCommands(t *testing.T) {
+	config := shapingConfig{
+		Out: &shapingTree{Root: shapingClass{Rate: 5000, Ceil: 10000}},
+		In: &shapingTree{
+			Root: shapingClass{Rate: 2000, Ceil: 4000},
+			Leaves: []shapingLeaf{
+				{IP: net.ParseIP("10.1.0.2"), shapingClass: shapingClass{Rate: 1500}},
+				{IP: net.ParseIP("fd00::2"), shapingClass: shapingClass{Rate: 1000}},
+			},
+		},
+	}
+	var cmds []string
+	for _, cmd := range shapingCommands("bn1", config) {
+		cmds = append(cmds, strings.Join(cmd, " "))
+	}
+	expected := []string{
+		"tc qdisc add dev bn1 root handle 1: htb default fffe",
+		"tc class add dev bn1 parent 1: classid 1:1 htb rate 5000kbit ceil 10000kbit",
+		"tc class add dev bn1 parent 1:1 classid 1:fffe htb rate 5000kbit ceil 10000kbit",
+		"tc qdisc add dev bn1 parent 1:fffe handle fffe: fq_codel",
+		"ip link add ifbbn1 type ifb",
+		"ip link set ifbbn1 up",
+		"tc qdisc add dev bn1 handle ffff: ingress",
+		"tc filter add dev bn1 parent ffff: protocol all prio 1 u32 match u32 0 0 action mirred egress redirect dev ifbbn1",
+		"tc qdisc add dev ifbbn1 root handle 1: htb default fffe",
+		"tc class add dev ifbbn1 parent 1: classid 1:1 htb rate 2000kbit ceil 4000kbit",
+		"tc class add dev ifbbn1 parent 1:1 classid 1:2 htb rate 1500kbit ceil 4000kbit",
+		"tc qdisc add dev ifbbn1 parent 1:2 handle 2: fq_codel",
+		"tc filter add dev ifbbn1 parent 1: protocol ip prio 1 u32 match ip src 10.1.0.2/32 flowid 1:2",
+		"tc class add dev ifbbn1 parent 1:1 classid 1:3 htb rate 1000kbit ceil 4000kbit",
+		"tc qdisc add dev ifbbn1 parent 1:3 handle 3: fq_codel",
+		"tc filter add dev ifbbn1 parent 1: protocol ipv6 prio 1 u32 match ip6 src fd00::2/128 flowid 1:3",
+		// The guarantees use more than the rate
+		"tc class add dev ifbbn1 parent 1:1 classid 1:fffe htb rate 8kbit ceil 4000kbit",
+		"tc qdisc add dev ifbbn1 parent 1:fffe handle fffe: fq_codel",
+	}
+	if !reflect.DeepEqual(cmds, expected) {
+		t.Errorf("got\n%s\nexpected\n%s", strings.Join(cmds, "\n"),
+			strings.Join(expected, "\n"))
+	}
+}
+
+func TestShapingStatsParse(t *testing.T) {
+	out := `[{"kind":"htb","handle":"1:","root":true,"refcnt":2,"options":{"r2q":10,"default":"0xfffe","direct_packets_stat":0,"direct_qlen":1000},"bytes":123456,"packets":1000,"drops":12,"overlimits":345,"requeues":0,"backlog":1514,"qlen":1},` +
+		`{"kind":"fq_codel","handle":"fffe:","parent":"1:fffe","options":{"limit":10240},"bytes":123456,"packets":1000,"drops":12,"overlimits":0,"requeues":0,"backlog":1514,"qlen":1},` +
+		`{"kind":"ingress","handle":"ffff:","parent":"ffff:fff1","options":{},"bytes":0,"packets":0,"drops":0,"overlimits":0,"requeues":0,"backlog":0,"qlen":0}]`
+	stats, err := shapingStatsParse([]byte(out))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := shapingStats{Drops: 12, Overlimits: 345, Backlog: 1514}
+	if stats != expected {
+		t.Errorf("got %+v, expected %+v", stats, expected)
+	}
+
+	out = `[{"kind":"noqueue","handle":"0:","root":true,"refcnt":2,"options":{},"bytes":0,"packets":0,"drops":0,"overlimits":0,"requeues":0,"backlog":0,"qlen":0}]`
+	if _, err := shapingStatsParse([]byte(`[]`)); err == nil {
+		t.Errorf("expected an error without a root qdisc")
+	}
+	if _, err := shapingStatsParse([]byte(out)); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
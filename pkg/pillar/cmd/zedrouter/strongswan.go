@@ -632,10 +632,12 @@ func incrementVpnMetricsConnStats(vpnMetrics *types.VpnMetrics,
 	inPktStats := lStats.PktStats
 	outPktStats := rStats.PktStats
 
-	// existing connection
+	// existing connection, with counters which were not reset
 	if oldConnMetrics != nil &&
 		oldConnMetrics.LEndPoint.LinkInfo.SpiId == lStats.SpiId &&
-		oldConnMetrics.REndPoint.LinkInfo.SpiId == rStats.SpiId {
+		oldConnMetrics.REndPoint.LinkInfo.SpiId == rStats.SpiId &&
+		inPktStats.Bytes >= oldConnMetrics.LEndPoint.PktStats.Bytes &&
+		outPktStats.Bytes >= oldConnMetrics.REndPoint.PktStats.Bytes {
 
 		oldInPktStats := oldConnMetrics.LEndPoint.PktStats
 		inPktStats.Bytes -= oldInPktStats.Bytes
@@ -862,7 +864,9 @@ func publishVpnMetrics(ctx *zedrouterContext,
 	}
 
 	publishVpnConnMetrics(ctx, status, oldMetrics, vpnMetrics, vpnStatus)
-	publishVpnMetricsAclCounters(vpnMetrics)
+	if status.VpnType == types.VpnTypeStrongSwan {
+		publishVpnMetricsAclCounters(vpnMetrics)
+	}
 	nis.VpnMetrics = vpnMetrics
 	return
 }
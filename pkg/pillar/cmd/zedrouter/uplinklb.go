@@ -0,0 +1,274 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Load balancing of local network instances across the healthy uplinks
+// of the lowest cost of their port label.
+// The uplink of a flow is picked when the flow is marked by the ACLs of the
+// app, and stored in the uplink bits of the connection mark (see acl.go).
+// Packets with uplink bits are routed using a per-uplink table of the network
+// instance, so established flows keep their uplink (and their NAT) when the
+// set of uplinks changes. Flows of an uplink which is no longer used fall
+// back to the network instance table, i.e. to CurrentUplinkIntf.
+
+package zedrouter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net"
+	"sort"
+	"strconv"
+	"syscall"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+const (
+	// Number of uplinks which can be told apart in the connection mark
+	lbMaxUplinks = uplinkMask >> uplinkShift
+	// Tables of the uplinks are numbered from here + bridge ifindex * 16 + mark
+	lbBaseTableIndex = 1 << 16
+)
+
+// lbUplinksGet returns the healthy ports of the lowest cost with a healthy
+// port, sorted by name. Weights are by ifname, the default weight is 1.
+// The mark of a port depends only on the set of probed ports, so that it
+// does not change when other ports go up or down.
+func lbUplinksGet(pinfo map[string]types.ProbeInfo, costList []uint8,
+	weights map[string]uint32) []types.UplinkLBPort {
+
+	var ifnames []string
+	for ifname := range pinfo {
+		ifnames = append(ifnames, ifname)
+	}
+	sort.Strings(ifnames)
+	if len(ifnames) > lbMaxUplinks {
+		ifnames = ifnames[:lbMaxUplinks]
+	}
+	for _, cost := range costList {
+		var uplinks []types.UplinkLBPort
+		for i, ifname := range ifnames {
+			info := pinfo[ifname]
+			if info.Cost != cost || infoUpCount(info) == 0 {
+				continue
+			}
+			weight := weights[ifname]
+			if weight == 0 {
+				weight = 1
+			}
+			uplinks = append(uplinks, types.UplinkLBPort{
+				IfName: ifname,
+				Mark:   uint8(i + 1),
+				Weight: weight,
+			})
+		}
+		if len(uplinks) > 0 {
+			return uplinks
+		}
+	}
+	return nil
+}
+
+// lbWeights returns the configured weights by ifname
+func lbWeights(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) map[string]uint32 {
+
+	weights := make(map[string]uint32)
+	for label, weight := range status.UplinkWeights {
+		ifname := types.LogicallabelToIfName(ctx.deviceNetworkStatus, label)
+		weights[ifname] = weight
+	}
+	return weights
+}
+
+// lbCheckStatus updates LBUplinks from the probing state and sets
+// NeedIntfUpdate if they changed. Called with probeMutex held.
+func lbCheckStatus(ctx *zedrouterContext, status *types.NetworkInstanceStatus) {
+	var uplinks []types.UplinkLBPort
+	if status.Type == types.NetworkInstanceTypeLocal &&
+		status.UplinkLoadBalancing != types.UplinkLoadBalancingNone {
+		costList := types.GetPortCostList(*ctx.deviceNetworkStatus)
+		uplinks = lbUplinksGet(status.PInfo, costList, lbWeights(ctx, status))
+	}
+	if !lbUplinksEqual(uplinks, status.LBUplinks) {
+		log.Functionf("lbCheckStatus: %s load balanced uplinks from %v to %v",
+			status.BridgeName, status.LBUplinks, uplinks)
+		status.LBUplinks = uplinks
+		status.NeedIntfUpdate = true
+	}
+}
+
+func lbUplinksEqual(uplinks1, uplinks2 []types.UplinkLBPort) bool {
+	if len(uplinks1) != len(uplinks2) {
+		return false
+	}
+	for i := range uplinks1 {
+		if uplinks1[i] != uplinks2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lbIfNameList returns the ifnames of the uplinks used by a local network
+// instance, starting with CurrentUplinkIntf
+func lbIfNameList(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) []string {
+
+	ifNameList := getIfNameListForLLOrIfname(ctx, status.CurrentUplinkIntf)
+	for _, uplink := range status.LBUplinks {
+		if uplink.IfName == status.CurrentUplinkIntf {
+			continue
+		}
+		ifNameList = append(ifNameList,
+			getIfNameListForLLOrIfname(ctx, uplink.IfName)...)
+	}
+	return ifNameList
+}
+
+// lbACLArgs sets the load balancing of the network instance in aclArgs
+func lbACLArgs(aclArgs *types.AppNetworkACLArgs,
+	status *types.NetworkInstanceStatus) {
+
+	if status.Type != types.NetworkInstanceTypeLocal ||
+		status.UplinkLoadBalancing == types.UplinkLoadBalancingNone {
+		return
+	}
+	aclArgs.LoadBalanced = true
+	aclArgs.LBUplinks = status.LBUplinks
+	aclArgs.LBSticky = status.UplinkLoadBalancing == types.UplinkLoadBalancingSticky
+}
+
+// lbStickyUplink picks the uplink of an app IP using weighted rendezvous
+// hashing, so that only the apps of an uplink which goes down move
+func lbStickyUplink(appIP string,
+	uplinks []types.UplinkLBPort) types.UplinkLBPort {
+
+	var picked types.UplinkLBPort
+	best := math.Inf(-1)
+	for _, uplink := range uplinks {
+		h := fnv.New64a()
+		h.Write([]byte(appIP + "/" + uplink.IfName))
+		// The high bits of FNV are poorly mixed for short keys,
+		// finish with the splitmix64 finalizer
+		x := h.Sum64()
+		x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+		x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+		x ^= x >> 31
+		// Uniform in (0, 1)
+		u := (float64(x>>11) + 0.5) / (1 << 53)
+		score := -float64(uplink.Weight) / math.Log(u)
+		if score > best {
+			best = score
+			picked = uplink
+		}
+	}
+	return picked
+}
+
+func lbConnmark(mark uint8) string {
+	return fmt.Sprintf("0x%x/0x%x", uint32(mark)<<uplinkShift, uint32(uplinkMask))
+}
+
+// lbMarkRules returns the rules which set the uplink bits of the
+// connection mark of new flows, to be run after the ACE mark is set
+func lbMarkRules(aclArgs types.AppNetworkACLArgs) [][]string {
+	var rules [][]string
+	if len(aclArgs.LBUplinks) == 0 {
+		return rules
+	}
+	if aclArgs.LBSticky {
+		uplink := lbStickyUplink(aclArgs.AppIP, aclArgs.LBUplinks)
+		return append(rules, []string{"-j", "CONNMARK", "--set-xmark",
+			lbConnmark(uplink.Mark)})
+	}
+	var remaining uint32
+	for _, uplink := range aclArgs.LBUplinks {
+		remaining += uplink.Weight
+	}
+	unset := []string{"-m", "connmark", "--mark", lbConnmark(0)}
+	for i, uplink := range aclArgs.LBUplinks {
+		rule := append([]string{}, unset...)
+		if i < len(aclArgs.LBUplinks)-1 {
+			probability := float64(uplink.Weight) / float64(remaining)
+			rule = append(rule, "-m", "statistic", "--mode", "random",
+				"--probability", strconv.FormatFloat(probability, 'f', 6, 64))
+		}
+		rules = append(rules, append(rule, "-j", "CONNMARK", "--set-xmark",
+			lbConnmark(uplink.Mark)))
+		remaining -= uplink.Weight
+	}
+	return rules
+}
+
+// lbInboundConnmark returns the uplink bits for flows coming from uplink,
+// so that the replies leave through the same uplink
+func lbInboundConnmark(aclArgs types.AppNetworkACLArgs, uplink string) uint32 {
+	for _, lbUplink := range aclArgs.LBUplinks {
+		if lbUplink.IfName == uplink {
+			return uint32(lbUplink.Mark) << uplinkShift
+		}
+	}
+	return 0
+}
+
+func lbTable(bridgeIfindex int, mark uint8) int {
+	return lbBaseTableIndex + bridgeIfindex*(lbMaxUplinks+1) + int(mark)
+}
+
+// lbRouteChange applies a route change of a port to the tables of the
+// load balanced network instances using it
+func lbRouteChange(ctx *zedrouterContext, ifname string,
+	rt netlink.Route, add bool) {
+
+	pub := ctx.pubNetworkInstanceStatus
+	for _, st := range pub.GetAll() {
+		status := st.(types.NetworkInstanceStatus)
+		for _, uplink := range status.ProgLBUplinks {
+			if uplink.IfName != ifname || status.BridgeIfindex == 0 {
+				continue
+			}
+			myrt := rt
+			myrt.Table = lbTable(status.BridgeIfindex, uplink.Mark)
+			myrt.Flags = 0
+			var err error
+			if add {
+				err = netlink.RouteAdd(&myrt)
+			} else {
+				err = netlink.RouteDel(&myrt)
+			}
+			if err != nil {
+				log.Errorf("lbRouteChange: route %v in %d: %s",
+					myrt, myrt.Table, err)
+			}
+		}
+	}
+}
+
+// lbFlowCounts returns the number of tracked flows of the subnet by
+// uplink mark
+func lbFlowCounts(subnet net.IPNet) map[uint8]uint32 {
+	counts := make(map[uint8]uint32)
+	if subnet.IP == nil {
+		return counts
+	}
+	flows, err := netlink.ConntrackTableList(netlink.ConntrackTable,
+		syscall.AF_INET)
+	if err != nil {
+		log.Errorf("lbFlowCounts: ConntrackTableList failed: %v", err)
+		return counts
+	}
+	for _, flow := range flows {
+		if !subnet.Contains(flow.Forward.SrcIP) &&
+			!subnet.Contains(flow.Reverse.SrcIP) {
+			continue
+		}
+		mark := uint8((flow.Mark & uplinkMask) >> uplinkShift)
+		if mark != 0 {
+			counts[mark]++
+		}
+	}
+	return counts
+}
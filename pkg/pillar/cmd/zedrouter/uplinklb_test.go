@@ -0,0 +1,119 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestLBUplinksGet(t *testing.T) {
+	pinfo := map[string]types.ProbeInfo{
+		"eth0":  {IfName: "eth0", GatewayUP: true, RemoteHostUP: true},
+		"eth1":  {IfName: "eth1", RemoteHostUP: true},
+		"eth2":  {IfName: "eth2"},
+		"wwan0": {IfName: "wwan0", Cost: 10, RemoteHostUP: true},
+	}
+	costList := []uint8{0, 10}
+	uplinks := lbUplinksGet(pinfo, costList, map[string]uint32{"eth1": 3})
+	expected := []types.UplinkLBPort{
+		{IfName: "eth0", Mark: 1, Weight: 1},
+		{IfName: "eth1", Mark: 2, Weight: 3},
+	}
+	if !reflect.DeepEqual(uplinks, expected) {
+		t.Errorf("got %+v, expected %+v", uplinks, expected)
+	}
+
+	// The next cost is used when no port of the lowest cost is healthy,
+	// the marks do not change
+	for _, ifname := range []string{"eth0", "eth1"} {
+		info := pinfo[ifname]
+		info.GatewayUP = false
+		info.RemoteHostUP = false
+		pinfo[ifname] = info
+	}
+	uplinks = lbUplinksGet(pinfo, costList, nil)
+	expected = []types.UplinkLBPort{{IfName: "wwan0", Mark: 4, Weight: 1}}
+	if !reflect.DeepEqual(uplinks, expected) {
+		t.Errorf("got %+v, expected %+v", uplinks, expected)
+	}
+
+	info := pinfo["wwan0"]
+	info.RemoteHostUP = false
+	pinfo["wwan0"] = info
+	if uplinks := lbUplinksGet(pinfo, costList, nil); uplinks != nil {
+		t.Errorf("unexpected uplinks %+v", uplinks)
+	}
+}
+
+func TestLBMarkRules(t *testing.T) {
+	aclArgs := types.AppNetworkACLArgs{
+		AppIP: "10.1.0.2",
+		LBUplinks: []types.UplinkLBPort{
+			{IfName: "eth0", Mark: 1, Weight: 1},
+			{IfName: "eth1", Mark: 2, Weight: 3},
+			{IfName: "eth3", Mark: 4, Weight: 4},
+		},
+	}
+	var rules []string
+	for _, rule := range lbMarkRules(aclArgs) {
+		rules = append(rules, strings.Join(rule, " "))
+	}
+	expected := []string{
+		"-m connmark --mark 0x0/0x780000 -m statistic --mode random --probability 0.125000 -j CONNMARK --set-xmark 0x80000/0x780000",
+		"-m connmark --mark 0x0/0x780000 -m statistic --mode random --probability 0.428571 -j CONNMARK --set-xmark 0x100000/0x780000",
+		"-m connmark --mark 0x0/0x780000 -j CONNMARK --set-xmark 0x200000/0x780000",
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Errorf("got\n%s\nexpected\n%s", strings.Join(rules, "\n"),
+			strings.Join(expected, "\n"))
+	}
+
+	aclArgs.LBSticky = true
+	uplink := lbStickyUplink(aclArgs.AppIP, aclArgs.LBUplinks)
+	if rules := lbMarkRules(aclArgs); len(rules) != 1 ||
+		rules[0][len(rules[0])-1] != lbConnmark(uplink.Mark) {
+		t.Errorf("unexpected sticky rules %v", rules)
+	}
+
+	if rules := lbMarkRules(types.AppNetworkACLArgs{}); len(rules) != 0 {
+		t.Errorf("unexpected rules without load balancing %v", rules)
+	}
+}
+
+func TestLBStickyUplink(t *testing.T) {
+	uplinks := []types.UplinkLBPort{
+		{IfName: "eth0", Mark: 1, Weight: 1},
+		{IfName: "eth1", Mark: 2, Weight: 1},
+		{IfName: "eth2", Mark: 3, Weight: 2},
+	}
+	picked := make(map[string]types.UplinkLBPort)
+	counts := make(map[string]int)
+	for i := 2; i < 202; i++ {
+		appIP := fmt.Sprintf("10.1.%d.%d", i/100, i%100)
+		uplink := lbStickyUplink(appIP, uplinks)
+		picked[appIP] = uplink
+		counts[uplink.IfName]++
+	}
+	for _, uplink := range uplinks {
+		if counts[uplink.IfName] == 0 {
+			t.Errorf("no app on %s: %v", uplink.IfName, counts)
+		}
+	}
+	if counts["eth2"] <= counts["eth0"] || counts["eth2"] <= counts["eth1"] {
+		t.Errorf("weights not applied: %v", counts)
+	}
+
+	// Only the apps of the removed uplink move
+	for appIP, uplink := range picked {
+		moved := lbStickyUplink(appIP, uplinks[1:])
+		if uplink.IfName != "eth0" && moved != uplink {
+			t.Errorf("%s moved from %s to %s", appIP, uplink.IfName, moved.IfName)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// VLANs of the switch network instances.
+// The access or trunk VLANs of an app vif are set by domainmgr once the vif
+// exists, which also enables vlan_filtering on the bridge (see setupVlans).
+// The VLANs used by the apps are set here, tagged, on the external port of
+// the bridge, so that they reach the network, and on the bridge itself,
+// so that the DHCP and DNS snooping of the flow logging sees them.
+// Only the VLANs which were set everywhere are recorded in UplinkVlans, the
+// others are retried by retrySwitchUplinkVlans.
+// The ACLs of an app vif apply to its tagged traffic as well since the
+// net.bridge.bridge-nf-filter-vlan-tagged sysctl hands the tagged packets
+// to iptables, but there are no per-VLAN ACLs.
+// The frames of the VLANs used by the apps are marked with their VLAN by
+// ebtables (see niPutVlanMarkChain), which puts their flows into the
+// conntrack zone of the VLAN (see iptables.VlanZonesChain). Apps in
+// different VLANs may thus share IP addresses, and the flow records carry
+// the zone as the VLAN.
+
+package zedrouter
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/vishvananda/netlink"
+)
+
+// switchVlanInfo returns the VLANs of an app vif on a switch network instance
+func switchVlanInfo(ulConfig *types.UnderlayNetworkConfig) types.VlanInfo {
+	if ulConfig.AccessVlanID > 1 {
+		return types.VlanInfo{
+			Start: ulConfig.AccessVlanID,
+			End:   ulConfig.AccessVlanID,
+		}
+	}
+	// No valid vlan configuration on this app adapter.
+	// Make this adapter trunk port, for all the vlans unless
+	// a list of trunk vlans is configured.
+	vlan := types.VlanInfo{
+		IsTrunk:     true,
+		Start:       2,
+		End:         4093,
+		TrunkRanges: ulConfig.TrunkVlans,
+	}
+	for i, vlanRange := range ulConfig.TrunkVlans {
+		if i == 0 || vlanRange.Start < vlan.Start {
+			vlan.Start = vlanRange.Start
+		}
+		if i == 0 || vlanRange.End > vlan.End {
+			vlan.End = vlanRange.End
+		}
+	}
+	return vlan
+}
+
+// switchVlanAdd counts the VLANs of an app vif in the network instance
+func switchVlanAdd(status *types.NetworkInstanceStatus, vlan types.VlanInfo) {
+	if !vlan.IsTrunk {
+		status.VlanMap[vlan.Start]++
+		return
+	}
+	status.NumTrunkPorts++
+	for _, vlanRange := range vlan.TrunkRanges {
+		for vlanID := vlanRange.Start; vlanID <= vlanRange.End; vlanID++ {
+			status.TrunkVlanMap[vlanID]++
+		}
+	}
+}
+
+// switchVlanDel undoes switchVlanAdd
+func switchVlanDel(status *types.NetworkInstanceStatus, vlan types.VlanInfo) {
+	decrement := func(vlanMap map[uint32]uint32, vlanID uint32) {
+		if vlanMap[vlanID] <= 1 {
+			delete(vlanMap, vlanID)
+		} else {
+			vlanMap[vlanID]--
+		}
+	}
+	if !vlan.IsTrunk {
+		decrement(status.VlanMap, vlan.Start)
+		return
+	}
+	if status.NumTrunkPorts > 0 {
+		status.NumTrunkPorts--
+	}
+	for _, vlanRange := range vlan.TrunkRanges {
+		for vlanID := vlanRange.Start; vlanID <= vlanRange.End; vlanID++ {
+			decrement(status.TrunkVlanMap, vlanID)
+		}
+	}
+}
+
+// switchUplinkVlansGet returns the sorted VLANs needed on the external port
+func switchUplinkVlansGet(status *types.NetworkInstanceStatus) []uint32 {
+	var vlans []uint32
+	for vlanID := range status.VlanMap {
+		vlans = append(vlans, vlanID)
+	}
+	for vlanID := range status.TrunkVlanMap {
+		if _, ok := status.VlanMap[vlanID]; !ok {
+			vlans = append(vlans, vlanID)
+		}
+	}
+	sort.Slice(vlans, func(i, j int) bool { return vlans[i] < vlans[j] })
+	return vlans
+}
+
+// updateSwitchUplinkVlans sets the VLANs used by the apps on the external
+// ports of the bridge and on the bridge, and removes the unused ones
+func updateSwitchUplinkVlans(status *types.NetworkInstanceStatus) {
+	if status.Type != types.NetworkInstanceTypeSwitch ||
+		status.BridgeName == "" {
+		return
+	}
+	vlans := switchUplinkVlansGet(status)
+	bridge, err := netlink.LinkByName(status.BridgeName)
+	if err != nil {
+		log.Errorf("updateSwitchUplinkVlans: LinkByName(%s) failed: %v",
+			status.BridgeName, err)
+		return
+	}
+	links, err := netlink.LinkList()
+	if err != nil {
+		log.Errorf("updateSwitchUplinkVlans: LinkList failed: %v", err)
+		return
+	}
+	var ports []netlink.Link
+	for _, link := range links {
+		// The app vifs are set by domainmgr
+		if link.Attrs().MasterIndex == bridge.Attrs().Index &&
+			!strings.HasPrefix(link.Attrs().Name, "nbu") {
+			ports = append(ports, link)
+		}
+	}
+
+	add := func(vlanID uint32) error {
+		var err error
+		for _, port := range ports {
+			if e := netlink.BridgeVlanAdd(port, uint16(vlanID),
+				false, false, false, false); e != nil {
+				log.Errorf("updateSwitchUplinkVlans: vlan %d add on %s failed: %v",
+					vlanID, port.Attrs().Name, e)
+				err = e
+			}
+		}
+		if e := netlink.BridgeVlanAdd(bridge, uint16(vlanID),
+			false, false, true, false); e != nil {
+			log.Errorf("updateSwitchUplinkVlans: vlan %d add on %s failed: %v",
+				vlanID, status.BridgeName, e)
+			err = e
+		}
+		return err
+	}
+	// a VLAN which is already gone, e.g. from a port added since, is not
+	// an error
+	del := func(vlanID uint32) error {
+		var err error
+		for _, port := range ports {
+			if e := netlink.BridgeVlanDel(port, uint16(vlanID),
+				false, false, false, false); e != nil &&
+				!errors.Is(e, syscall.ENOENT) {
+				log.Errorf("updateSwitchUplinkVlans: vlan %d del on %s failed: %v",
+					vlanID, port.Attrs().Name, e)
+				err = e
+			}
+		}
+		if e := netlink.BridgeVlanDel(bridge, uint16(vlanID),
+			false, false, true, false); e != nil &&
+			!errors.Is(e, syscall.ENOENT) {
+			log.Errorf("updateSwitchUplinkVlans: vlan %d del on %s failed: %v",
+				vlanID, status.BridgeName, e)
+			err = e
+		}
+		return err
+	}
+	programmed := reconcileVlans(status.UplinkVlans, vlans, add, del)
+	if !vlansEqual(programmed, status.UplinkVlans) {
+		log.Noticef("updateSwitchUplinkVlans: %s vlans %v of %v",
+			status.BridgeName, programmed, vlans)
+	}
+	status.UplinkVlans = programmed
+}
+
+// reconcileVlans adds the wanted VLANs which are not programmed and
+// deletes the programmed ones which are not wanted. Returns the sorted
+// VLANs which are programmed afterwards: one which failed to be added is
+// left out and one which failed to be deleted is kept, so that both are
+// retried.
+func reconcileVlans(programmed, wanted []uint32,
+	add, del func(vlanID uint32) error) []uint32 {
+
+	isWanted := make(map[uint32]bool)
+	for _, vlanID := range wanted {
+		isWanted[vlanID] = true
+	}
+	isProgrammed := make(map[uint32]bool)
+	var result []uint32
+	for _, vlanID := range programmed {
+		isProgrammed[vlanID] = true
+		if isWanted[vlanID] || del(vlanID) != nil {
+			result = append(result, vlanID)
+		}
+	}
+	for _, vlanID := range wanted {
+		if !isProgrammed[vlanID] && add(vlanID) == nil {
+			result = append(result, vlanID)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func vlansEqual(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// retrySwitchUplinkVlans sets the VLANs which could not be set or removed
+// before on the switch network instances
+func retrySwitchUplinkVlans(ctx *zedrouterContext) {
+	for _, st := range ctx.pubNetworkInstanceStatus.GetAll() {
+		status := st.(types.NetworkInstanceStatus)
+		if status.Type != types.NetworkInstanceTypeSwitch ||
+			vlansEqual(status.UplinkVlans, switchUplinkVlansGet(&status)) {
+			continue
+		}
+		log.Functionf("retrySwitchUplinkVlans(%s)", status.Key())
+		updateSwitchUplinkVlans(&status)
+		publishNetworkInstanceStatus(ctx, &status)
+	}
+}
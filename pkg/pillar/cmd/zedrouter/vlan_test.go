@@ -0,0 +1,161 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+func TestSwitchVlanInfo(t *testing.T) {
+	testMatrix := map[string]struct {
+		ulConfig types.UnderlayNetworkConfig
+		expected types.VlanInfo
+	}{
+		"access": {
+			ulConfig: types.UnderlayNetworkConfig{AccessVlanID: 100,
+				TrunkVlans: []types.VlanRange{{Start: 10, End: 20}}},
+			expected: types.VlanInfo{Start: 100, End: 100},
+		},
+		"trunk all": {
+			ulConfig: types.UnderlayNetworkConfig{AccessVlanID: 1},
+			expected: types.VlanInfo{Start: 2, End: 4093, IsTrunk: true},
+		},
+		"trunk list": {
+			ulConfig: types.UnderlayNetworkConfig{
+				TrunkVlans: []types.VlanRange{{Start: 300, End: 300},
+					{Start: 10, End: 20}}},
+			expected: types.VlanInfo{Start: 10, End: 300, IsTrunk: true,
+				TrunkRanges: []types.VlanRange{{Start: 300, End: 300},
+					{Start: 10, End: 20}}},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		vlan := switchVlanInfo(&test.ulConfig)
+		if !reflect.DeepEqual(vlan, test.expected) {
+			t.Errorf("got %+v, expected %+v", vlan, test.expected)
+		}
+	}
+}
+
+func TestSwitchVlanCounts(t *testing.T) {
+	status := types.NetworkInstanceStatus{
+		NetworkInstanceInfo: types.NetworkInstanceInfo{
+			VlanMap:      make(map[uint32]uint32),
+			TrunkVlanMap: make(map[uint32]uint32),
+		},
+	}
+	vlans := []types.VlanInfo{
+		switchVlanInfo(&types.UnderlayNetworkConfig{AccessVlanID: 20}),
+		switchVlanInfo(&types.UnderlayNetworkConfig{AccessVlanID: 20}),
+		switchVlanInfo(&types.UnderlayNetworkConfig{}),
+		switchVlanInfo(&types.UnderlayNetworkConfig{
+			TrunkVlans: []types.VlanRange{{Start: 19, End: 21}}}),
+	}
+	for _, vlan := range vlans {
+		switchVlanAdd(&status, vlan)
+	}
+	if status.NumTrunkPorts != 2 || status.VlanMap[20] != 2 ||
+		len(status.TrunkVlanMap) != 3 {
+		t.Errorf("unexpected counts %d %v %v", status.NumTrunkPorts,
+			status.VlanMap, status.TrunkVlanMap)
+	}
+	expected := []uint32{19, 20, 21}
+	if uplinkVlans := switchUplinkVlansGet(&status); !reflect.DeepEqual(uplinkVlans, expected) {
+		t.Errorf("got uplink vlans %v, expected %v", uplinkVlans, expected)
+	}
+
+	switchVlanDel(&status, vlans[0])
+	switchVlanDel(&status, vlans[3])
+	expected = []uint32{20}
+	if uplinkVlans := switchUplinkVlansGet(&status); !reflect.DeepEqual(uplinkVlans, expected) {
+		t.Errorf("got uplink vlans %v, expected %v", uplinkVlans, expected)
+	}
+	switchVlanDel(&status, vlans[1])
+	switchVlanDel(&status, vlans[2])
+	if status.NumTrunkPorts != 0 || len(status.VlanMap) != 0 ||
+		len(status.TrunkVlanMap) != 0 {
+		t.Errorf("unexpected counts %d %v %v", status.NumTrunkPorts,
+			status.VlanMap, status.TrunkVlanMap)
+	}
+}
+
+func TestReconcileVlans(t *testing.T) {
+	testMatrix := map[string]struct {
+		programmed []uint32
+		wanted     []uint32
+		failAdd    []uint32
+		failDel    []uint32
+		expAdded   []uint32
+		expDeleted []uint32
+		expected   []uint32
+	}{
+		"add and delete": {
+			programmed: []uint32{10, 20},
+			wanted:     []uint32{20, 30},
+			expAdded:   []uint32{30},
+			expDeleted: []uint32{10},
+			expected:   []uint32{20, 30},
+		},
+		"unchanged": {
+			programmed: []uint32{10, 20},
+			wanted:     []uint32{10, 20},
+			expected:   []uint32{10, 20},
+		},
+		"failed add is retried": {
+			programmed: []uint32{10},
+			wanted:     []uint32{10, 20, 30},
+			failAdd:    []uint32{20},
+			expAdded:   []uint32{20, 30},
+			expected:   []uint32{10, 30},
+		},
+		"failed delete is retried": {
+			programmed: []uint32{10, 20, 30},
+			wanted:     []uint32{30},
+			failDel:    []uint32{20},
+			expDeleted: []uint32{10, 20},
+			expected:   []uint32{20, 30},
+		},
+		"nothing wanted": {
+			programmed: []uint32{10},
+			expDeleted: []uint32{10},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		var added, deleted []uint32
+		fails := func(vlanID uint32, failing []uint32) error {
+			for _, failID := range failing {
+				if vlanID == failID {
+					return errors.New("failed")
+				}
+			}
+			return nil
+		}
+		add := func(vlanID uint32) error {
+			added = append(added, vlanID)
+			return fails(vlanID, test.failAdd)
+		}
+		del := func(vlanID uint32) error {
+			deleted = append(deleted, vlanID)
+			return fails(vlanID, test.failDel)
+		}
+		programmed := reconcileVlans(test.programmed, test.wanted, add, del)
+		if !vlansEqual(programmed, test.expected) {
+			t.Errorf("%s: programmed %v, expected %v", testname, programmed,
+				test.expected)
+		}
+		if !vlansEqual(added, test.expAdded) {
+			t.Errorf("%s: added %v, expected %v", testname, added, test.expAdded)
+		}
+		if !vlansEqual(deleted, test.expDeleted) {
+			t.Errorf("%s: deleted %v, expected %v", testname, deleted,
+				test.expDeleted)
+		}
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// wireguard tunnel management routines
+
+package zedrouter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cipher"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const (
+	wireGuardVersion = "wireguard"
+	// the keys are written here only for the time of "wg set"
+	wireGuardKeyDir = runDirname + "/wireguard"
+	// a peer without a handshake for this long is not connected,
+	// see REJECT_AFTER_TIME in the WireGuard protocol
+	wireGuardHandshakeTimeout = 180 * time.Second
+	wireGuardRouteMetric      = "50"
+)
+
+// wireGuardState is kept in the OpaqueStatus of a WireGuard network instance
+type wireGuardState struct {
+	IfName string
+	UpTime time.Time
+	Config types.WireGuardConfig
+}
+
+func wireGuardConfigParse(opaqueConfig string) (types.WireGuardConfig, error) {
+	log.Functionf("wireGuardConfigParse(): parsing %s\n", opaqueConfig)
+	config := types.WireGuardConfig{}
+	if err := json.Unmarshal([]byte(opaqueConfig), &config); err != nil {
+		return config, err
+	}
+	if _, _, err := net.ParseCIDR(config.Address); err != nil {
+		return config, fmt.Errorf("invalid address %s: %v", config.Address, err)
+	}
+	if len(config.Peers) == 0 {
+		return config, errors.New("no peers")
+	}
+	peers := make(map[string]bool)
+	for _, peer := range config.Peers {
+		if err := wireGuardValidateKey(peer.PublicKey); err != nil {
+			return config, fmt.Errorf("peer %s public key: %v", peer.Name, err)
+		}
+		if peers[peer.PublicKey] {
+			return config, fmt.Errorf("duplicate peer %s", peer.PublicKey)
+		}
+		peers[peer.PublicKey] = true
+		if peer.Endpoint != "" {
+			if _, _, err := net.SplitHostPort(peer.Endpoint); err != nil {
+				return config, fmt.Errorf("peer %s endpoint: %v", peer.Name, err)
+			}
+		}
+		if len(peer.AllowedIPs) == 0 {
+			return config, fmt.Errorf("peer %s has no allowed IPs", peer.Name)
+		}
+		for _, subnet := range peer.AllowedIPs {
+			if err := vpnValidateSubnet(subnet); err != nil {
+				return config, fmt.Errorf("peer %s allowed IPs: %v", peer.Name, err)
+			}
+		}
+	}
+	return config, nil
+}
+
+// wireGuardValidateKey checks for a base64 encoded curve25519 key
+func wireGuardValidateKey(key string) error {
+	b, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return err
+	}
+	if len(b) != 32 {
+		return fmt.Errorf("key length %d, expected 32", len(b))
+	}
+	return nil
+}
+
+func wireGuardStatusParse(opaqueStatus string) (wireGuardState, error) {
+	state := wireGuardState{}
+	if err := json.Unmarshal([]byte(opaqueStatus), &state); err != nil {
+		return state, err
+	}
+	return state, nil
+}
+
+// getWireGuardKeys decrypts the keys of the network instance. There is no
+// cleartext fallback, the keys are only accepted in the cipher block.
+func getWireGuardKeys(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) (types.EncryptionBlock, error) {
+	if !status.CipherBlockStatus.IsCipher {
+		ctx.cipherMetrics.RecordFailure(log, types.NoData)
+		return types.EncryptionBlock{}, errors.New("wireguard keys not set")
+	}
+	cipherStatus, decBlock, err := cipher.GetCipherCredentials(&ctx.decryptCipherContext,
+		status.CipherBlockStatus)
+	ctx.pubCipherBlockStatus.Publish(cipherStatus.Key(), cipherStatus)
+	if err != nil {
+		ctx.cipherMetrics.RecordFailure(log, types.MissingFallback)
+		return decBlock, fmt.Errorf("wireguard keys decryption failed: %v", err)
+	}
+	if err := wireGuardValidateKey(decBlock.WireGuardPrivateKey); err != nil {
+		return decBlock, fmt.Errorf("wireguard private key: %v", err)
+	}
+	for peer, key := range decBlock.WireGuardPresharedKeys {
+		if err := wireGuardValidateKey(key); err != nil {
+			return decBlock, fmt.Errorf("wireguard preshared key of %s: %v", peer, err)
+		}
+	}
+	return decBlock, nil
+}
+
+func wireGuardNetworkInstanceCreate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("WireGuard network instance create: %s\n", status.DisplayName)
+	config, err := wireGuardConfigParse(status.OpaqueConfig)
+	if err != nil {
+		log.Warnf("WireGuard network instance create: %v\n", err)
+		return err
+	}
+	keys, err := getWireGuardKeys(ctx, status)
+	if err != nil {
+		log.Errorf("WireGuard network instance create: %v\n", err)
+		return err
+	}
+	state := wireGuardState{
+		IfName: fmt.Sprintf("wg%d", status.BridgeNum),
+		UpTime: time.Now(),
+		Config: config,
+	}
+	bytes, err := json.Marshal(state)
+	if err != nil {
+		log.Errorf("WireGuard network instance create: %v\n", err)
+		return err
+	}
+	status.OpaqueStatus = string(bytes)
+	if err := wireGuardVpnCreate(state, keys); err != nil {
+		log.Errorf("WireGuard network instance create: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func wireGuardNetworkInstanceDestroy(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("WireGuard network instance delete: %s\n", status.DisplayName)
+	state, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Warnf("WireGuard network instance delete: %v\n", err)
+		return
+	}
+	if err := wireGuardVpnDelete(state); err != nil {
+		log.Warnf("WireGuard network instance delete: %v\n", err)
+	}
+}
+
+func wireGuardNetworkInstanceActivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) error {
+
+	log.Functionf("WireGuard network instance activate: %s\n", status.DisplayName)
+	state, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Warnf("WireGuard network instance activate: %v\n", err)
+		return err
+	}
+	if err := wireGuardVpnActivate(state); err != nil {
+		log.Errorf("WireGuard network instance activate: %v\n", err)
+		return err
+	}
+	return nil
+}
+
+func wireGuardNetworkInstanceInactivate(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus) {
+
+	log.Functionf("WireGuard network instance inactivate: %s\n", status.DisplayName)
+	state, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Warnf("WireGuard network instance inactivate: %v\n", err)
+		return
+	}
+	if _, err := base.Exec(log, "ip", "link", "set", state.IfName,
+		"down").Output(); err != nil {
+		log.Warnf("WireGuard network instance inactivate: %v\n", err)
+	}
+}
+
+func wireGuardVpnCreate(state wireGuardState, keys types.EncryptionBlock) error {
+	ifName := state.IfName
+
+	if _, err := base.Exec(log, "ip", "link", "add", "dev", ifName,
+		"type", "wireguard").Output(); err != nil {
+		log.Errorf("%s for ip link add %s\n", err.Error(), ifName)
+		return err
+	}
+	if err := wireGuardVpnSetup(state, keys); err != nil {
+		// do not leave a half configured link behind
+		if delErr := wireGuardVpnDelete(state); delErr != nil {
+			log.Warnf("wireGuardVpnCreate(%s) cleanup: %v\n", ifName, delErr)
+		}
+		return err
+	}
+	log.Functionf("wireGuardVpnCreate(%s) OK\n", ifName)
+	return nil
+}
+
+// wireGuardVpnSetup configures the link added by wireGuardVpnCreate
+func wireGuardVpnSetup(state wireGuardState, keys types.EncryptionBlock) error {
+	ifName := state.IfName
+	config := state.Config
+
+	if err := wireGuardSet(state, keys); err != nil {
+		return err
+	}
+	if _, err := base.Exec(log, "ip", "addr", "add", config.Address,
+		"dev", ifName).Output(); err != nil {
+		log.Errorf("%s for ip addr add %s dev %s\n", err.Error(),
+			config.Address, ifName)
+		return err
+	}
+	args := []string{"link", "set", ifName, "up"}
+	if config.Mtu != 0 {
+		args = append(args, "mtu", strconv.Itoa(int(config.Mtu)))
+	}
+	if _, err := base.Exec(log, "ip", args...).Output(); err != nil {
+		log.Errorf("%s for ip link set %s up\n", err.Error(), ifName)
+		return err
+	}
+	return wireGuardRoutesSet(state)
+}
+
+// wireGuardSet configures the keys and the peers of the interface.
+// wg only takes the keys from files, they are removed right after.
+func wireGuardSet(state wireGuardState, keys types.EncryptionBlock) error {
+	ifName := state.IfName
+	if err := os.MkdirAll(wireGuardKeyDir, 0700); err != nil {
+		return err
+	}
+	var keyFiles []string
+	defer func() {
+		for _, keyFile := range keyFiles {
+			os.Remove(keyFile)
+		}
+	}()
+	writeKey := func(name, key string) (string, error) {
+		keyFile := filepath.Join(wireGuardKeyDir, name)
+		keyFiles = append(keyFiles, keyFile)
+		return keyFile, ioutil.WriteFile(keyFile, []byte(key+"\n"), 0600)
+	}
+
+	privateKeyFile, err := writeKey(ifName+".key", keys.WireGuardPrivateKey)
+	if err != nil {
+		return err
+	}
+	args := []string{"set", ifName, "private-key", privateKeyFile}
+	if state.Config.ListenPort != 0 {
+		args = append(args, "listen-port",
+			strconv.Itoa(int(state.Config.ListenPort)))
+	}
+	for idx, peer := range state.Config.Peers {
+		args = append(args, "peer", peer.PublicKey)
+		if psk, ok := keys.WireGuardPresharedKeys[peer.PublicKey]; ok {
+			pskFile, err := writeKey(fmt.Sprintf("%s.%d.psk", ifName, idx), psk)
+			if err != nil {
+				return err
+			}
+			args = append(args, "preshared-key", pskFile)
+		}
+		if peer.Endpoint != "" {
+			args = append(args, "endpoint", peer.Endpoint)
+		}
+		if peer.PersistentKeepalive != 0 {
+			args = append(args, "persistent-keepalive",
+				strconv.Itoa(int(peer.PersistentKeepalive)))
+		}
+		args = append(args, "allowed-ips", strings.Join(peer.AllowedIPs, ","))
+	}
+	if _, err := base.Exec(log, "wg", args...).CombinedOutput(); err != nil {
+		log.Errorf("%s for wg set %s\n", err.Error(), ifName)
+		return err
+	}
+	return nil
+}
+
+// wireGuardRoutes returns the subnets routed through the interface, the
+// connected subnet of the interface address and default routes excluded.
+// A default route would take the device traffic to the tunnel.
+func wireGuardRoutes(config types.WireGuardConfig) []string {
+	var connected string
+	if _, subnet, err := net.ParseCIDR(config.Address); err == nil {
+		connected = subnet.String()
+	}
+	var routes []string
+	for _, peer := range config.Peers {
+		for _, allowed := range peer.AllowedIPs {
+			_, subnet, err := net.ParseCIDR(allowed)
+			if err != nil {
+				continue
+			}
+			if ones, _ := subnet.Mask.Size(); ones == 0 {
+				log.Warnf("wireGuardRoutes: default route of peer %s not installed",
+					peer.Name)
+				continue
+			}
+			if subnet.String() == connected {
+				continue
+			}
+			routes = append(routes, subnet.String())
+		}
+	}
+	return routes
+}
+
+// wireGuardRoutesSet routes the peer subnets to the interface, for the
+// traffic of the apps on the network instance
+func wireGuardRoutesSet(state wireGuardState) error {
+	for _, subnet := range wireGuardRoutes(state.Config) {
+		if _, err := base.Exec(log, "ip", "route", "replace", subnet, "dev",
+			state.IfName, "metric", wireGuardRouteMetric).Output(); err != nil {
+			log.Errorf("%s for %s %s add\n", err.Error(), "iproute", subnet)
+			return err
+		}
+		log.Functionf("ipRoute(%s) %s add OK\n", state.IfName, subnet)
+	}
+	return nil
+}
+
+func wireGuardVpnDelete(state wireGuardState) error {
+	// the routes go away with the link
+	if _, err := base.Exec(log, "ip", "link", "del", "dev",
+		state.IfName).Output(); err != nil {
+		log.Errorf("%s for ip link del %s\n", err.Error(), state.IfName)
+		return err
+	}
+	log.Functionf("wireGuardVpnDelete(%s) OK\n", state.IfName)
+	return nil
+}
+
+func wireGuardVpnActivate(state wireGuardState) error {
+	if err := ipLinkInfExists(state.IfName); err != nil {
+		log.Errorf("%s for %s ipLink status", err.Error(), state.IfName)
+		return err
+	}
+	if _, err := base.Exec(log, "ip", "link", "set", state.IfName,
+		"up").Output(); err != nil {
+		log.Errorf("%s for ip link set %s up\n", err.Error(), state.IfName)
+		return err
+	}
+	return wireGuardRoutesSet(state)
+}
+
+// wireGuardDumpParse builds the VpnStatus from the "wg show <if> dump"
+// output, one connection for each peer
+func wireGuardDumpParse(state wireGuardState, out string,
+	now time.Time) (*types.VpnStatus, error) {
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	// private-key public-key listen-port fwmark
+	intf := strings.Split(lines[0], "\t")
+	if len(intf) != 4 {
+		return nil, fmt.Errorf("unexpected interface line with %d fields",
+			len(intf))
+	}
+	publicKey := intf[1]
+	listenPort, _ := strconv.ParseUint(intf[2], 10, 16)
+	localIP, localSubnet, _ := net.ParseCIDR(state.Config.Address)
+	local := types.VpnEndPoint{Id: publicKey, Port: uint32(listenPort)}
+	if localIP != nil {
+		local.IpAddr = localIP.String()
+	}
+
+	vpnStatus := &types.VpnStatus{
+		Version: wireGuardVersion,
+		UpTime:  state.UpTime,
+		IpAddrs: local.IpAddr,
+	}
+	names := make(map[string]string)
+	for _, peer := range state.Config.Peers {
+		names[peer.PublicKey] = peer.Name
+	}
+	for _, line := range lines[1:] {
+		// public-key preshared-key endpoint allowed-ips latest-handshake
+		// transfer-rx transfer-tx persistent-keepalive
+		fields := strings.Split(line, "\t")
+		if len(fields) != 8 {
+			return nil, fmt.Errorf("unexpected peer line with %d fields",
+				len(fields))
+		}
+		conn := &types.VpnConnStatus{
+			Id:      fields[0],
+			Name:    names[fields[0]],
+			Version: wireGuardVersion,
+			State:   types.VPN_CONNECTING,
+			LInfo:   local,
+			RInfo:   types.VpnEndPoint{Id: fields[0]},
+		}
+		if host, port, err := net.SplitHostPort(fields[2]); err == nil {
+			conn.RInfo.IpAddr = host
+			if p, err := strconv.ParseUint(port, 10, 16); err == nil {
+				conn.RInfo.Port = uint32(p)
+			}
+		}
+		if sec, _ := strconv.ParseInt(fields[4], 10, 64); sec != 0 {
+			conn.LastHandshake = time.Unix(sec, 0)
+			age := now.Sub(conn.LastHandshake)
+			if age < 0 {
+				age = 0
+			}
+			conn.EstTime = uint64(age.Seconds())
+			if age < wireGuardHandshakeTimeout {
+				conn.State = types.VPN_ESTABLISHED
+			}
+		}
+		if conn.State == types.VPN_ESTABLISHED {
+			vpnStatus.ActiveTunCount++
+		} else {
+			vpnStatus.ConnectingTunCount++
+		}
+		rxBytes, _ := strconv.ParseUint(fields[5], 10, 64)
+		txBytes, _ := strconv.ParseUint(fields[6], 10, 64)
+		// the peer stays installed in the kernel whatever the handshake,
+		// so the link is always installed and its counters accounted
+		link := &types.VpnLinkStatus{
+			Id:    fields[0],
+			Name:  conn.Name,
+			State: types.VPN_INSTALLED,
+			LInfo: types.VpnLinkInfo{PktStats: types.PktStats{Bytes: rxBytes}},
+			RInfo: types.VpnLinkInfo{SubNet: fields[3],
+				PktStats: types.PktStats{Bytes: txBytes}},
+		}
+		if localSubnet != nil {
+			link.LInfo.SubNet = localSubnet.String()
+		}
+		conn.Links = []*types.VpnLinkStatus{link}
+		vpnStatus.ActiveVpnConns = append(vpnStatus.ActiveVpnConns, conn)
+	}
+	return vpnStatus, nil
+}
+
+func wireGuardVpnStatusGet(ctx *zedrouterContext,
+	status *types.NetworkInstanceStatus, nis *types.NetworkInstanceMetrics) bool {
+	change := false
+	state, err := wireGuardStatusParse(status.OpaqueStatus)
+	if err != nil {
+		log.Functionf("WireGuard config absent\n")
+		return change
+	}
+	out, err := base.Exec(log, "wg", "show", state.IfName, "dump").Output()
+	if err != nil {
+		log.Errorf("%s for wg show %s\n", err.Error(), state.IfName)
+		return change
+	}
+	vpnStatus, err := wireGuardDumpParse(state, string(out), time.Now())
+	if err != nil {
+		log.Errorf("wireGuardVpnStatusGet(%s): %v\n", state.IfName, err)
+		return change
+	}
+	if change = isVpnStatusChanged(status.VpnStatus, vpnStatus); change {
+		log.Tracef("vpn state change:%v\n", vpnStatus)
+		status.VpnStatus = vpnStatus
+	}
+	publishVpnMetrics(ctx, status, vpnStatus, nis)
+	return change
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedrouter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	testWgKey1 = "xTIBA5rboUvnH4htodjb6e697QjLERt1NAB4mZqp8Dg="
+	testWgKey2 = "TrMvSoP4jYQlY6RIzBgbssQqY3vxI2Pi+y71lOWWXX0="
+)
+
+func TestWireGuardConfigParse(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "zedrouter", 0)
+	tests := []struct {
+		testname string
+		config   string
+		valid    bool
+	}{
+		{
+			testname: "valid",
+			config: `{"Address": "10.99.0.2/24", "ListenPort": 51820, "Peers": [
+				{"Name": "hub", "PublicKey": "` + testWgKey1 + `",
+				"Endpoint": "hub.example.com:51820", "AllowedIPs": ["10.99.0.0/24", "192.168.10.0/24"]}]}`,
+			valid: true,
+		},
+		{
+			testname: "no peers",
+			config:   `{"Address": "10.99.0.2/24"}`,
+		},
+		{
+			testname: "bad address",
+			config: `{"Address": "10.99.0.2", "Peers": [
+				{"PublicKey": "` + testWgKey1 + `", "AllowedIPs": ["10.99.0.0/24"]}]}`,
+		},
+		{
+			testname: "bad key",
+			config: `{"Address": "10.99.0.2/24", "Peers": [
+				{"PublicKey": "c2hvcnQ=", "AllowedIPs": ["10.99.0.0/24"]}]}`,
+		},
+		{
+			testname: "duplicate peer",
+			config: `{"Address": "10.99.0.2/24", "Peers": [
+				{"PublicKey": "` + testWgKey1 + `", "AllowedIPs": ["10.99.0.0/24"]},
+				{"PublicKey": "` + testWgKey1 + `", "AllowedIPs": ["10.98.0.0/24"]}]}`,
+		},
+		{
+			testname: "bad endpoint",
+			config: `{"Address": "10.99.0.2/24", "Peers": [
+				{"PublicKey": "` + testWgKey1 + `", "Endpoint": "hub", "AllowedIPs": ["10.99.0.0/24"]}]}`,
+		},
+		{
+			testname: "no allowed IPs",
+			config: `{"Address": "10.99.0.2/24", "Peers": [
+				{"PublicKey": "` + testWgKey1 + `"}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.testname, func(t *testing.T) {
+			_, err := wireGuardConfigParse(test.config)
+			if test.valid && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestWireGuardRoutes(t *testing.T) {
+	log = base.NewSourceLogObject(logrus.StandardLogger(), "zedrouter", 0)
+	config := types.WireGuardConfig{
+		Address: "10.99.0.2/24",
+		Peers: []types.WireGuardPeerConfig{
+			{PublicKey: testWgKey1, AllowedIPs: []string{"10.99.0.0/24", "192.168.10.0/24"}},
+			{PublicKey: testWgKey2, AllowedIPs: []string{"0.0.0.0/0", "192.168.20.1/32"}},
+		},
+	}
+	routes := wireGuardRoutes(config)
+	expected := []string{"192.168.10.0/24", "192.168.20.1/32"}
+	if len(routes) != len(expected) {
+		t.Fatalf("got %v, expected %v", routes, expected)
+	}
+	for i := range routes {
+		if routes[i] != expected[i] {
+			t.Errorf("got %v, expected %v", routes, expected)
+		}
+	}
+}
+
+func TestWireGuardDumpParse(t *testing.T) {
+	now := time.Unix(1620000000, 0)
+	state := wireGuardState{
+		IfName: "wg1",
+		Config: types.WireGuardConfig{
+			Address: "10.99.0.2/24",
+			Peers: []types.WireGuardPeerConfig{
+				{Name: "hub", PublicKey: testWgKey1},
+				{Name: "branch", PublicKey: testWgKey2},
+			},
+		},
+	}
+	dump := "cHJpdmF0ZQ==\tbG9jYWw=\t51820\toff\n" +
+		testWgKey1 + "\t(none)\t198.51.100.1:51820\t10.99.0.0/24,192.168.10.0/24\t1619999950\t1000\t2000\t25\n" +
+		testWgKey2 + "\t(none)\t(none)\t192.168.20.0/24\t0\t0\t0\toff\n"
+	vpnStatus, err := wireGuardDumpParse(state, dump, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vpnStatus.ActiveTunCount != 1 || vpnStatus.ConnectingTunCount != 1 ||
+		len(vpnStatus.ActiveVpnConns) != 2 {
+		t.Fatalf("unexpected status %+v", vpnStatus)
+	}
+	hub := vpnStatus.ActiveVpnConns[0]
+	if hub.Name != "hub" || hub.State != types.VPN_ESTABLISHED ||
+		hub.EstTime != 50 || hub.RInfo.IpAddr != "198.51.100.1" ||
+		hub.RInfo.Port != 51820 || hub.LInfo.Port != 51820 ||
+		hub.LInfo.IpAddr != "10.99.0.2" {
+		t.Errorf("unexpected hub connection %+v", hub)
+	}
+	link := hub.Links[0]
+	if link.LInfo.PktStats.Bytes != 1000 || link.RInfo.PktStats.Bytes != 2000 ||
+		link.RInfo.SubNet != "10.99.0.0/24,192.168.10.0/24" {
+		t.Errorf("unexpected hub link %+v", link)
+	}
+	branch := vpnStatus.ActiveVpnConns[1]
+	if branch.State != types.VPN_CONNECTING || !branch.LastHandshake.IsZero() {
+		t.Errorf("unexpected branch connection %+v", branch)
+	}
+
+	if _, err := wireGuardDumpParse(state, "", now); err == nil {
+		t.Errorf("expected an error for an empty dump")
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	dg "github.com/lf-edge/eve/libs/depgraph"
+	"github.com/lf-edge/eve/libs/reconciler"
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/cipher"
@@ -80,6 +82,7 @@ type zedrouterContext struct {
 	pubAppVifIPTrig           pubsub.Publication
 	pubAppContainerMetrics    pubsub.Publication
 	networkInstanceStatusMap  sync.Map
+	flowExporters             sync.Map                                     // Key is network instance UUID
 	NLaclMap                  map[uuid.UUID]map[string]types.ULNetworkACLs // app uuid plus bridge ul name
 	dnsServers                map[string][]net.IP                          // Key is ifname
 	checkNIUplinks            chan bool
@@ -92,7 +95,8 @@ type zedrouterContext struct {
 	aclog                     *logrus.Logger // App Container logger
 	disableDHCPAllOnesNetMask bool
 	flowPublishMap            map[string]time.Time
-	metricInterval            uint32 // In seconds
+	shapedIntfs               map[string]shapingConfig // Key is ifname
+	metricInterval            uint32                   // In seconds
 
 	zedcloudMetrics *zedcloud.AgentMetrics
 	cipherMetrics   *cipher.AgentMetrics
@@ -101,6 +105,12 @@ type zedrouterContext struct {
 	pubCipherBlockStatus pubsub.Publication
 	decryptCipherContext cipher.DecryptCipherContext
 	pubAppInstMetaData   pubsub.Publication
+
+	// Network instance plumbing managed using the reconciler
+	niRegistry      *reconciler.DefaultRegistry
+	niCurrentState  dg.Graph
+	niIntendedState dg.Graph
+	niRetryTimer    *time.Timer
 }
 
 var debug = false
@@ -182,6 +192,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 		aclog:              agentlog.CustomLogInit(logrus.InfoLevel),
 		NLaclMap:           make(map[uuid.UUID]map[string]types.ULNetworkACLs),
 		flowPublishMap:     make(map[string]time.Time),
+		shapedIntfs:        make(map[string]shapingConfig),
 		zedcloudMetrics:    zedcloud.NewAgentMetrics(),
 		cipherMetrics:      cipher.NewAgentMetrics(agentName),
 	}
@@ -532,6 +543,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 
 	PbrInit(&zedrouterCtx)
+	niReconcilerInit(&zedrouterCtx)
 	routeChanges := devicenetwork.RouteChangeInit(log)
 	linkChanges := devicenetwork.LinkChangeInit(log)
 
@@ -645,6 +657,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 				// could be used for a bridge.
 				maybeUpdateBridgeIPAddr(
 					&zedrouterCtx, ifname)
+				shapingLinkChange(&zedrouterCtx, ifname)
 			}
 			ps.CheckMaxTimeTopic(agentName, "linkChanges", start,
 				warningTime, errorTime)
@@ -678,6 +691,7 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			// XXX can we trigger it as part of boot? Or watch file?
 			// XXX add file watch...
 			checkAndPublishDhcpLeases(&zedrouterCtx)
+			retrySwitchUplinkVlans(&zedrouterCtx)
 
 			err = zedrouterCtx.cipherMetrics.Publish(
 				log, cipherMetricsPub, "global")
@@ -727,6 +741,12 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 			ps.CheckMaxTimeTopic(agentName, "checkAndReprogram", start,
 				warningTime, errorTime)
 
+		case <-zedrouterCtx.niRetryTimer.C:
+			start := time.Now()
+			niRetryReconcile(&zedrouterCtx)
+			ps.CheckMaxTimeTopic(agentName, "niRetryReconcile", start,
+				warningTime, errorTime)
+
 		case change := <-subNetworkInstanceConfig.MsgChan():
 			log.Functionf("NetworkInstanceConfig change at %+v", time.Now())
 			subNetworkInstanceConfig.ProcessChange(change)
@@ -1039,6 +1059,7 @@ func doActivate(ctx *zedrouterContext, config types.AppNetworkConfig,
 	}
 	status.Activated = true
 	publishAppNetworkStatus(ctx, status)
+	appNetworkShapingUpdate(ctx, status)
 	log.Functionf("doActivate done for %s\n", config.DisplayName)
 	return nil
 }
@@ -1130,21 +1151,9 @@ func appNetworkDoActivateUnderlayNetwork(
 	ulStatus.HostName = config.Key()
 
 	if netInstStatus.Type == types.NetworkInstanceTypeSwitch {
-		if ulConfig.AccessVlanID <= 1 {
-			// No valid vlan configuration on this app adapter.
-			// There are valid vlans configured on adpaters of other apps
-			// connected to this particular network instance.
-			// Make this adapter trunk port
-			ulStatus.Vlan.IsTrunk = true
-			ulStatus.Vlan.Start = 2
-			ulStatus.Vlan.End = 4093
-			netInstStatus.NumTrunkPorts++
-		} else {
-			ulStatus.Vlan.IsTrunk = false
-			ulStatus.Vlan.Start = ulConfig.AccessVlanID
-			ulStatus.Vlan.End = ulConfig.AccessVlanID
-			netInstStatus.VlanMap[ulConfig.AccessVlanID]++
-		}
+		ulStatus.Vlan = switchVlanInfo(ulConfig)
+		switchVlanAdd(netInstStatus, ulStatus.Vlan)
+		updateSwitchUplinkVlans(netInstStatus)
 	}
 
 	appID := status.UUIDandVersion.UUID
@@ -1160,11 +1169,6 @@ func appNetworkDoActivateUnderlayNetwork(
 	ulStatus.BridgeIPAddr = bridgeIPAddr
 	// appIPAddr is "" for switch NI. DHCP snoop will set AllocatedIPv4Addr later
 	ulStatus.AllocatedIPv4Addr = appIPAddr
-	hostsDirpath := runDirname + "/hosts." + bridgeName
-	if appIPAddr != "" {
-		addToHostsConfiglet(hostsDirpath, config.DisplayName,
-			[]string{appIPAddr})
-	}
 
 	// Default ipset
 	deleteDefaultIpsetConfiglet(vifName, false)
@@ -1175,6 +1179,7 @@ func appNetworkDoActivateUnderlayNetwork(
 		VifName: vifName, BridgeIP: bridgeIPAddr, AppIP: appIPAddr,
 		UpLinks: netInstStatus.IfNameList, NIType: netInstStatus.Type,
 		AppNum: int32(status.AppNum)}
+	lbACLArgs(&aclArgs, netInstStatus)
 
 	// Set up ACLs
 	ruleList, dependList, err := createACLConfiglet(ctx, aclArgs, ulConfig.ACLs)
@@ -1185,34 +1190,30 @@ func appNetworkDoActivateUnderlayNetwork(
 	}
 	setNetworkACLRules(ctx, appID, ulStatus.Name, ruleList)
 
-	if appIPAddr != "" {
-		// XXX clobber any IPv6 EID entry since same name
-		// but that's probably OK since we're doing IPv4 EIDs
-		addhostDnsmasq(bridgeName, appMac, appIPAddr,
-			config.UUIDandVersion.UUID.String())
-	}
-
 	// Look for added or deleted ipsets
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
 		netInstStatus.BridgeIPSets)
-
+	netInstStatus.BridgeIPSets = newIpsets
+	log.Functionf("set BridgeIPSets to %v for %s", newIpsets,
+		netInstStatus.Key())
 	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netInstStatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netInstStatus, hostsDirpath,
-			newIpsets, netInstStatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
+		err = reconcileNetworkInstance(ctx, netInstStatus,
+			netInstStatus.Activated)
+		if err != nil {
+			log.Errorf("appNetworkDoActivateUnderlayNetwork(%s): %v",
+				netInstStatus.Key(), err)
+		}
+	}
+
+	// Add host entry and DHCP reservation (if appIPAddr is known)
+	err = putNetworkInstanceVif(ctx, netInstStatus,
+		vifItemForUnderlay(status, ulStatus))
+	if err != nil {
+		log.Errorf("appNetworkDoActivateUnderlayNetwork(%s): %v",
+			netInstStatus.Key(), err)
 	}
 	netInstStatus.AddVif(log, vifName, appMac,
 		config.UUIDandVersion.UUID)
-	netInstStatus.BridgeIPSets = newIpsets
-	log.Functionf("set BridgeIPSets to %v for %s", newIpsets,
-		netInstStatus.Key())
 
 	// Check App Container Stats ACL need to be reinstalled
 	appStatsMayNeedReinstallACL(ctx, config)
@@ -1530,7 +1531,9 @@ func handleAppNetworkModify(ctxArg interface{}, key string,
 			ulStatus := &status.UnderlayNetworkList[i]
 			if ulConfig.Network == ulStatus.Network &&
 				ulConfig.AppIPAddr.Equal(ulStatus.AppIPAddr) &&
-				ulConfig.AppMacAddr.String() == ulStatus.AppMacAddr.String() {
+				ulConfig.AppMacAddr.String() == ulStatus.AppMacAddr.String() &&
+				ulConfig.AccessVlanID == ulStatus.AccessVlanID &&
+				cmp.Equal(ulConfig.TrunkVlans, ulStatus.TrunkVlans) {
 				// Save new config then process any ACL changes
 				ulStatus.UnderlayNetworkConfig = *ulConfig
 				doAppNetworkModifyUNetAcls(ctx, status, ulConfig,
@@ -1569,6 +1572,7 @@ func handleAppNetworkModify(ctxArg interface{}, key string,
 
 	status.PendingModify = false
 	publishAppNetworkStatus(ctx, status)
+	appNetworkShapingUpdate(ctx, status)
 	log.Functionf("handleAppNetworkModify done for %s\n", config.DisplayName)
 
 	if status != nil && status.HasError() &&
@@ -1655,6 +1659,7 @@ func doAppNetworkModifyUNetAcls(
 		VifName: ulStatus.Vif, BridgeIP: ulStatus.BridgeIPAddr, AppIP: appIPAddr,
 		UpLinks: netstatus.IfNameList, NIType: netstatus.Type,
 		AppNum: int32(status.AppNum)}
+	lbACLArgs(&aclArgs, netstatus)
 
 	// We ignore any errors in netstatus
 
@@ -1671,22 +1676,15 @@ func doAppNetworkModifyUNetAcls(
 
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
 		netstatus.BridgeIPSets)
-
-	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		hostsDirpath := runDirname + "/hosts." + bridgeName
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
 	netstatus.BridgeIPSets = newIpsets
 	log.Functionf("set BridgeIPSets to %v for %s", newIpsets, netstatus.Key())
+	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
+		err = reconcileNetworkInstance(ctx, netstatus, netstatus.Activated)
+		if err != nil {
+			log.Errorf("doAppNetworkModifyUNetAcls(%s): %v",
+				netstatus.Key(), err)
+		}
+	}
 	publishNetworkInstanceStatus(ctx, netstatus)
 
 	maybeRemoveStaleIpsets(staleIpsets)
@@ -1824,6 +1822,7 @@ func doInactivateAppNetwork(ctx *zedrouterContext,
 
 	status.Activated = false
 	publishAppNetworkStatus(ctx, status)
+	appNetworkShapingUpdate(ctx, status)
 	log.Functionf("doInactivate done for %s\n", status.DisplayName)
 }
 
@@ -1876,9 +1875,16 @@ func appNetworkDoInactivateUnderlayNetwork(
 	}
 
 	appIPAddr := ulStatus.AllocatedIPv4Addr
-	if appIPAddr != "" {
-		removehostDnsmasq(bridgeName, ulStatus.Mac,
-			appIPAddr)
+	// The VLANs are updated first so that the VLAN marking of the
+	// network instance follows the removal of the VIF
+	if netstatus.Type == types.NetworkInstanceTypeSwitch {
+		switchVlanDel(netstatus, ulStatus.Vlan)
+		updateSwitchUplinkVlans(netstatus)
+	}
+	// Remove host entry and DHCP reservation
+	if err := delNetworkInstanceVif(ctx, netstatus, ulStatus.Vif); err != nil {
+		log.Errorf("appNetworkDoInactivateUnderlayNetwork(%s): %v",
+			netstatus.Key(), err)
 	}
 
 	appID := status.UUIDandVersion.UUID
@@ -1900,37 +1906,15 @@ func appNetworkDoInactivateUnderlayNetwork(
 			status.DisplayName)
 	}
 
-	// Delete underlay hosts file for this app
-	hostsDirpath := runDirname + "/hosts." + bridgeName
-	removeFromHostsConfiglet(hostsDirpath,
-		status.DisplayName)
 	// Look for added or deleted ipsets
 	newIpsets, staleIpsets, restartDnsmasq := diffIpsets(ipsets,
 		netstatus.BridgeIPSets)
-
+	netstatus.BridgeIPSets = newIpsets
 	if restartDnsmasq && ulStatus.BridgeIPAddr != "" {
-		stopDnsmasq(bridgeName, true, false)
-		dnsServers := types.GetDNSServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		ntpServers := types.GetNTPServers(*ctx.deviceNetworkStatus,
-			netstatus.CurrentUplinkIntf)
-		createDnsmasqConfiglet(ctx, bridgeName,
-			ulStatus.BridgeIPAddr, netstatus, hostsDirpath,
-			newIpsets, netstatus.CurrentUplinkIntf,
-			dnsServers, ntpServers)
-		startDnsmasq(bridgeName)
-	}
-	if netstatus.Type == types.NetworkInstanceTypeSwitch {
-		if ulStatus.AccessVlanID <= 1 {
-			netstatus.NumTrunkPorts--
-		} else {
-			netstatus.VlanMap[ulStatus.AccessVlanID]++
-			if _, ok := netstatus.VlanMap[ulStatus.AccessVlanID]; ok {
-				netstatus.VlanMap[ulStatus.AccessVlanID]--
-				if netstatus.VlanMap[ulStatus.AccessVlanID] == 0 {
-					delete(netstatus.VlanMap, ulStatus.AccessVlanID)
-				}
-			}
+		err := reconcileNetworkInstance(ctx, netstatus, netstatus.Activated)
+		if err != nil {
+			log.Errorf("appNetworkDoInactivateUnderlayNetwork(%s): %v",
+				netstatus.Key(), err)
 		}
 	}
 	netstatus.BridgeIPSets = newIpsets
@@ -2065,13 +2049,14 @@ func handleDNSImpl(ctxArg interface{}, key string,
 	log.Functionf("handleDNSImpl: changed %v",
 		cmp.Diff(ctx.deviceNetworkStatus, status))
 
-	if isDNSServerChanged(ctx, &status) {
-		doDnsmasqRestart(ctx)
-	}
-
+	dnsServerChanged := isDNSServerChanged(ctx, &status)
 	changedDepend := changedACLDepend(ctx, *ctx.deviceNetworkStatus,
 		status)
 	*ctx.deviceNetworkStatus = status
+	// dnsmasq configuration is built from ctx.deviceNetworkStatus
+	if dnsServerChanged {
+		doDnsmasqRestart(ctx)
+	}
 	maybeHandleDNS(ctx)
 
 	deviceUpdateNIprobing(ctx, &status)
@@ -2433,7 +2418,9 @@ func doDnsmasqRestart(ctx *zedrouterContext) {
 		}
 		if status.Activated {
 			log.Functionf("restart dnsmasq on bridgename %s\n", status.BridgeName)
-			restartDnsmasq(ctx, &status)
+			if err := reconcileNetworkInstance(ctx, &status, true); err != nil {
+				log.Errorf("doDnsmasqRestart(%s): %v", status.Key(), err)
+			}
 		}
 	}
 }
@@ -10,11 +10,13 @@ import (
 	"time"
 
 	"github.com/fsnotify/fsnotify"
+	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
 	"github.com/lf-edge/eve/pkg/pillar/zfs"
 	"github.com/sirupsen/logrus"
 )
@@ -25,6 +27,8 @@ const (
 	errorTime            = 3 * time.Minute
 	warningTime          = 40 * time.Second
 	stillRunningInterval = 25 * time.Second
+	// How often we check the state of the zpools
+	zpoolStatusInterval = time.Minute
 )
 
 var (
@@ -41,7 +45,13 @@ type zVolDeviceEvent struct {
 }
 
 type zfsContext struct {
-	zVolStatusPub pubsub.Publication
+	zVolStatusPub        pubsub.Publication
+	zfsPoolStatusPub     pubsub.Publication
+	subGlobalConfig      pubsub.Subscription
+	subDiskReplaceConfig pubsub.Subscription
+	globalConfig         *types.ConfigItemValueMap
+	GCInitialized        bool
+	persistType          types.PersistType
 }
 
 // Run - an zfs run
@@ -66,7 +76,10 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	stillRunning := time.NewTicker(stillRunningInterval)
 	ps.StillRunning(agentName, warningTime, errorTime)
 
-	ctxPtr := zfsContext{}
+	ctxPtr := zfsContext{
+		globalConfig: types.DefaultConfigItemValueMap(),
+		persistType:  vault.ReadPersistType(),
+	}
 
 	if err := utils.WaitForVault(ps, log, agentName, warningTime, errorTime); err != nil {
 		log.Fatal(err)
@@ -85,6 +98,67 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 	}
 	ctxPtr.zVolStatusPub = zVolStatusPub
 
+	// Persistent to keep the last applied disk replace and scrub result
+	zfsPoolStatusPub, err := ps.NewPublication(
+		pubsub.PublicationOptions{
+			AgentName:  agentName,
+			TopicType:  types.ZFSPoolStatus{},
+			Persistent: true,
+		})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.zfsPoolStatusPub = zfsPoolStatusPub
+
+	// Look for global config such as log levels
+	subGlobalConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ConfigItemValueMap{},
+		Persistent:    true,
+		Activate:      false,
+		Ctx:           &ctxPtr,
+		CreateHandler: handleGlobalConfigCreate,
+		ModifyHandler: handleGlobalConfigModify,
+		DeleteHandler: handleGlobalConfigDelete,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.subGlobalConfig = subGlobalConfig
+	subGlobalConfig.Activate()
+
+	// Wait for the global config to know the scrub interval
+	for !ctxPtr.GCInitialized {
+		log.Functionf("waiting for GCInitialized")
+		select {
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
+		case <-stillRunning.C:
+		}
+		ps.StillRunning(agentName, warningTime, errorTime)
+	}
+	log.Functionf("processed GlobalConfig")
+
+	subDiskReplaceConfig, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:     "zedagent",
+		MyAgentName:   agentName,
+		TopicImpl:     types.ZFSDiskReplaceConfig{},
+		Activate:      false,
+		Ctx:           &ctxPtr,
+		CreateHandler: handleDiskReplaceConfigCreate,
+		ModifyHandler: handleDiskReplaceConfigModify,
+		WarningTime:   warningTime,
+		ErrorTime:     errorTime,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	ctxPtr.subDiskReplaceConfig = subDiskReplaceConfig
+	subDiskReplaceConfig.Activate()
+
 	deviceNotifyChannel := make(chan *zVolDeviceEvent)
 
 	if err := os.MkdirAll(types.ZVolDevicePrefix, os.ModeDir); err != nil {
@@ -93,17 +167,30 @@ func Run(ps *pubsub.PubSub, loggerArg *logrus.Logger, logArg *base.LogObject) in
 
 	go deviceWatcher(deviceNotifyChannel)
 
+	zpoolStatusTicker := time.NewTicker(zpoolStatusInterval)
+	if ctxPtr.persistType == types.PersistZFS {
+		updateZFSPoolStatus(&ctxPtr)
+	}
+
 	for {
 		select {
 		case event := <-deviceNotifyChannel:
-			processEvent(ctxPtr, event)
+			processEvent(&ctxPtr, event)
+		case change := <-subGlobalConfig.MsgChan():
+			subGlobalConfig.ProcessChange(change)
+		case change := <-subDiskReplaceConfig.MsgChan():
+			subDiskReplaceConfig.ProcessChange(change)
+		case <-zpoolStatusTicker.C:
+			if ctxPtr.persistType == types.PersistZFS {
+				updateZFSPoolStatus(&ctxPtr)
+			}
 		case <-stillRunning.C:
 		}
 		ps.StillRunning(agentName, warningTime, errorTime)
 	}
 }
 
-func processEvent(ctxPtr zfsContext, event *zVolDeviceEvent) {
+func processEvent(ctxPtr *zfsContext, event *zVolDeviceEvent) {
 	if event == nil {
 		return
 	}
@@ -190,3 +277,47 @@ func deviceWatcher(notifyChannel chan *zVolDeviceEvent) {
 		}
 	}
 }
+
+func handleGlobalConfigCreate(ctxArg interface{}, key string,
+	statusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigModify(ctxArg interface{}, key string,
+	statusArg interface{}, oldStatusArg interface{}) {
+	handleGlobalConfigImpl(ctxArg, key, statusArg)
+}
+
+func handleGlobalConfigImpl(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigImpl: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigImpl for %s", key)
+	var gcp *types.ConfigItemValueMap
+	debug, gcp = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	if gcp != nil {
+		ctx.globalConfig = gcp
+		ctx.GCInitialized = true
+	}
+	log.Functionf("handleGlobalConfigImpl done for %s", key)
+}
+
+func handleGlobalConfigDelete(ctxArg interface{}, key string,
+	statusArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	if key != "global" {
+		log.Functionf("handleGlobalConfigDelete: ignoring %s", key)
+		return
+	}
+	log.Functionf("handleGlobalConfigDelete for %s", key)
+	debug, _ = agentlog.HandleGlobalConfig(log, ctx.subGlobalConfig, agentName,
+		debugOverride, logger)
+	*ctx.globalConfig = *types.DefaultConfigItemValueMap()
+	log.Functionf("handleGlobalConfigDelete done for %s", key)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfsmanager
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+	"github.com/lf-edge/eve/pkg/pillar/zfs"
+)
+
+// updateZFSPoolStatus publishes the status of each zpool and starts
+// the periodic scrubs
+func updateZFSPoolStatus(ctx *zfsContext) {
+	pools, err := zfs.GetZpools(log)
+	if err != nil {
+		log.Errorf("updateZFSPoolStatus: %v", err)
+		return
+	}
+	found := make(map[string]bool)
+	for _, pool := range pools {
+		status, err := zfs.GetZpoolStatus(log, pool)
+		if err != nil {
+			log.Errorf("updateZFSPoolStatus: %v", err)
+			continue
+		}
+		found[pool] = true
+		old := lookupZFSPoolStatus(ctx, pool)
+		if old != nil {
+			// zpool status only shows the last scan which can be
+			// a resilver
+			if status.Scrub.State == types.ZFSScrubStateUnspecified {
+				status.Scrub = old.Scrub
+			}
+			status.DiskReplace = old.DiskReplace
+		}
+		maybeStartScrub(ctx, status)
+		if old == nil || !reflect.DeepEqual(*old, *status) {
+			log.Functionf("updateZFSPoolStatus: publishing %s state %d",
+				pool, status.State)
+			publishZFSPoolStatus(ctx, status)
+		}
+	}
+	for _, item := range ctx.zfsPoolStatusPub.GetAll() {
+		status := item.(types.ZFSPoolStatus)
+		if !found[status.Key()] {
+			log.Noticef("updateZFSPoolStatus: zpool %s is gone", status.Key())
+			ctx.zfsPoolStatusPub.Unpublish(status.Key())
+		}
+	}
+}
+
+// maybeStartScrub starts a scrub if the last one ended more than
+// timer.zfs.scrub.interval ago
+func maybeStartScrub(ctx *zfsContext, status *types.ZFSPoolStatus) {
+	interval := time.Duration(ctx.globalConfig.GlobalValueInt(types.ZFSScrubInterval)) * time.Second
+	if interval == 0 {
+		return
+	}
+	if status.Resilvering || status.Scrub.State == types.ZFSScrubStateInProgress {
+		return
+	}
+	if !status.Scrub.EndTime.IsZero() && time.Since(status.Scrub.EndTime) < interval {
+		return
+	}
+	log.Noticef("maybeStartScrub: starting scrub of %s, last ended %v",
+		status.PoolName, status.Scrub.EndTime)
+	if output, err := zfs.StartScrub(log, status.PoolName); err != nil {
+		log.Errorf("maybeStartScrub: scrub of %s failed: %v, output=%s",
+			status.PoolName, err, output)
+		return
+	}
+	status.Scrub = types.ZFSScrubStatus{
+		State:     types.ZFSScrubStateInProgress,
+		StartTime: time.Now(),
+	}
+}
+
+func handleDiskReplaceConfigCreate(ctxArg interface{}, key string,
+	configArg interface{}) {
+	handleDiskReplaceConfigImpl(ctxArg, key, configArg)
+}
+
+func handleDiskReplaceConfigModify(ctxArg interface{}, key string,
+	configArg interface{}, oldConfigArg interface{}) {
+	handleDiskReplaceConfigImpl(ctxArg, key, configArg)
+}
+
+// handleDiskReplaceConfigImpl replaces the disk when the counter changes.
+// The result is reported in the status of the zpool, or of the default
+// zpool if the zpool is not found.
+func handleDiskReplaceConfigImpl(ctxArg interface{}, key string,
+	configArg interface{}) {
+
+	ctx := ctxArg.(*zfsContext)
+	config := configArg.(types.ZFSDiskReplaceConfig)
+	log.Functionf("handleDiskReplaceConfigImpl(%s): %+v", key, config)
+	if config.OldDisk == "" || config.NewDisk == "" {
+		return
+	}
+	if config.Counter == lastDiskReplaceCounter(ctx) {
+		log.Functionf("handleDiskReplaceConfigImpl: counter %d already applied",
+			config.Counter)
+		return
+	}
+	pool := config.PoolName
+	if pool == "" {
+		pool = vault.DefaultZpool
+	}
+	replace := types.ZFSDiskReplaceStatus{
+		OldDisk: config.OldDisk,
+		NewDisk: config.NewDisk,
+		Counter: config.Counter,
+	}
+	status := lookupZFSPoolStatus(ctx, pool)
+	if status == nil {
+		errStr := fmt.Sprintf("cannot replace %s with %s: zpool %s not found",
+			config.OldDisk, config.NewDisk, pool)
+		log.Error(errStr)
+		status = lookupZFSPoolStatus(ctx, vault.DefaultZpool)
+		if status == nil {
+			return
+		}
+		replace.SetErrorNow(errStr)
+		status.DiskReplace = replace
+		publishZFSPoolStatus(ctx, status)
+		return
+	}
+	log.Noticef("handleDiskReplaceConfigImpl: replacing %s with %s in %s",
+		config.OldDisk, config.NewDisk, pool)
+	if output, err := zfs.ReplaceDisk(log, pool, config.OldDisk, config.NewDisk); err != nil {
+		errStr := fmt.Sprintf("cannot replace %s with %s in %s: %v, output=%s",
+			config.OldDisk, config.NewDisk, pool, err, output)
+		log.Error(errStr)
+		replace.SetErrorNow(errStr)
+	}
+	status.DiskReplace = replace
+	publishZFSPoolStatus(ctx, status)
+	// Report the resilver right away
+	updateZFSPoolStatus(ctx)
+}
+
+// lastDiskReplaceCounter returns the counter of the last applied
+// ZFSDiskReplaceConfig; at most one is outstanding across the zpools
+func lastDiskReplaceCounter(ctx *zfsContext) uint32 {
+	var counter uint32
+	for _, item := range ctx.zfsPoolStatusPub.GetAll() {
+		status := item.(types.ZFSPoolStatus)
+		if status.DiskReplace.Counter > counter {
+			counter = status.DiskReplace.Counter
+		}
+	}
+	return counter
+}
+
+func lookupZFSPoolStatus(ctx *zfsContext, pool string) *types.ZFSPoolStatus {
+	item, err := ctx.zfsPoolStatusPub.Get(pool)
+	if err != nil {
+		return nil
+	}
+	status := item.(types.ZFSPoolStatus)
+	return &status
+}
+
+func publishZFSPoolStatus(ctx *zfsContext, status *types.ZFSPoolStatus) {
+	ctx.zfsPoolStatusPub.Publish(status.Key(), *status)
+}
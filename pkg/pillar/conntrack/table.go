@@ -0,0 +1,182 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntrack
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"syscall"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// Attributes of a flow which are not defined by netlink/nl
+const (
+	ctaZone             = 18
+	ctaCountersPackets  = 1
+	ctaCountersBytes    = 2
+	ctaTimestampStart   = 1
+	ctaTimestampStop    = 2
+	nfgenmsgHeaderBytes = 4
+)
+
+// Flow is a conntrack flow with the conntrack zone it belongs to,
+// which netlink.ConntrackFlow does not have
+type Flow struct {
+	netlink.ConntrackFlow
+	Zone uint16
+}
+
+// TableList lists the flows of a conntrack table with their zones, as
+// netlink.ConntrackTableList does without the zones
+func TableList(table netlink.ConntrackTableType,
+	family netlink.InetFamily) ([]Flow, error) {
+
+	req := nl.NewNetlinkRequest((int(table)<<8)|nl.IPCTNL_MSG_CT_GET,
+		unix.NLM_F_DUMP)
+	req.AddData(&nl.Nfgenmsg{
+		NfgenFamily: uint8(family),
+		Version:     nl.NFNETLINK_V0,
+		ResId:       0,
+	})
+	msgs, err := req.Execute(unix.NETLINK_NETFILTER, 0)
+	if err != nil {
+		return nil, err
+	}
+	var flows []Flow
+	for _, msg := range msgs {
+		flow, err := parseFlow(msg)
+		if err != nil {
+			return nil, err
+		}
+		flows = append(flows, flow)
+	}
+	return flows, nil
+}
+
+// parseFlow parses a message of a conntrack table dump
+func parseFlow(msg []byte) (Flow, error) {
+	var flow Flow
+	if len(msg) < nfgenmsgHeaderBytes {
+		return flow, fmt.Errorf("conntrack message too short: %d bytes",
+			len(msg))
+	}
+	flow.FamilyType = msg[0]
+	attrs, err := nl.ParseRouteAttr(msg[nfgenmsgHeaderBytes:])
+	if err != nil {
+		return flow, err
+	}
+	for _, attr := range attrs {
+		value := attr.Value
+		switch attr.Attr.Type & nl.NLA_TYPE_MASK {
+		case nl.CTA_TUPLE_ORIG:
+			err = parseTuple(value, &flow.Forward.SrcIP, &flow.Forward.DstIP,
+				&flow.Forward.Protocol, &flow.Forward.SrcPort,
+				&flow.Forward.DstPort)
+		case nl.CTA_TUPLE_REPLY:
+			err = parseTuple(value, &flow.Reverse.SrcIP, &flow.Reverse.DstIP,
+				&flow.Reverse.Protocol, &flow.Reverse.SrcPort,
+				&flow.Reverse.DstPort)
+		case nl.CTA_COUNTERS_ORIG:
+			flow.Forward.Packets, flow.Forward.Bytes, err =
+				parsePair(value, ctaCountersPackets, ctaCountersBytes)
+		case nl.CTA_COUNTERS_REPLY:
+			flow.Reverse.Packets, flow.Reverse.Bytes, err =
+				parsePair(value, ctaCountersPackets, ctaCountersBytes)
+		case nl.CTA_TIMESTAMP:
+			flow.TimeStart, flow.TimeStop, err =
+				parsePair(value, ctaTimestampStart, ctaTimestampStop)
+		case nl.CTA_MARK:
+			if len(value) >= 4 {
+				flow.Mark = binary.BigEndian.Uint32(value)
+			}
+		case nl.CTA_TIMEOUT:
+			if len(value) >= 4 {
+				flow.TimeOut = binary.BigEndian.Uint32(value)
+			}
+		case ctaZone:
+			if len(value) >= 2 {
+				flow.Zone = binary.BigEndian.Uint16(value)
+			}
+		}
+		if err != nil {
+			return flow, err
+		}
+	}
+	return flow, nil
+}
+
+// parseTuple parses the addresses, the protocol and the ports of a tuple
+func parseTuple(data []byte, srcIP, dstIP *net.IP, proto *uint8,
+	srcPort, dstPort *uint16) error {
+
+	attrs, err := nl.ParseRouteAttr(data)
+	if err != nil {
+		return err
+	}
+	for _, attr := range attrs {
+		nested, err := nl.ParseRouteAttr(attr.Value)
+		if err != nil {
+			return err
+		}
+		switch attr.Attr.Type & nl.NLA_TYPE_MASK {
+		case nl.CTA_TUPLE_IP:
+			for _, ipAttr := range nested {
+				ip := net.IP(append([]byte{}, ipAttr.Value...))
+				switch ipAttr.Attr.Type & nl.NLA_TYPE_MASK {
+				case nl.CTA_IP_V4_SRC, nl.CTA_IP_V6_SRC:
+					*srcIP = ip
+				case nl.CTA_IP_V4_DST, nl.CTA_IP_V6_DST:
+					*dstIP = ip
+				}
+			}
+		case nl.CTA_TUPLE_PROTO:
+			for _, protoAttr := range nested {
+				value := protoAttr.Value
+				switch protoAttr.Attr.Type & nl.NLA_TYPE_MASK {
+				case nl.CTA_PROTO_NUM:
+					if len(value) >= 1 {
+						*proto = value[0]
+					}
+				case nl.CTA_PROTO_SRC_PORT:
+					if len(value) >= 2 {
+						*srcPort = binary.BigEndian.Uint16(value)
+					}
+				case nl.CTA_PROTO_DST_PORT:
+					if len(value) >= 2 {
+						*dstPort = binary.BigEndian.Uint16(value)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// parsePair parses two nested 64 bit attributes, such as the packets and
+// the bytes of the counters
+func parsePair(data []byte, firstType, secondType uint16) (first,
+	second uint64, err error) {
+
+	var attrs []syscall.NetlinkRouteAttr
+	attrs, err = nl.ParseRouteAttr(data)
+	if err != nil {
+		return
+	}
+	for _, attr := range attrs {
+		if len(attr.Value) < 8 {
+			continue
+		}
+		switch attr.Attr.Type & nl.NLA_TYPE_MASK {
+		case firstType:
+			first = binary.BigEndian.Uint64(attr.Value)
+		case secondType:
+			second = binary.BigEndian.Uint64(attr.Value)
+		}
+	}
+	return
+}
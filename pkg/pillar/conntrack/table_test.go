@@ -0,0 +1,99 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package conntrack
+
+import (
+	"encoding/binary"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/vishvananda/netlink/nl"
+)
+
+func be16(v uint16) []byte {
+	b := make([]byte, 2)
+	binary.BigEndian.PutUint16(b, v)
+	return b
+}
+
+func be32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return b
+}
+
+func be64(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
+
+func testTuple(attrType int, src, dst string, sport, dport uint16) *nl.RtAttr {
+	tuple := nl.NewRtAttr(attrType|int(nl.NLA_F_NESTED), nil)
+	ip := tuple.AddRtAttr(nl.CTA_TUPLE_IP|int(nl.NLA_F_NESTED), nil)
+	ip.AddRtAttr(nl.CTA_IP_V4_SRC, net.ParseIP(src).To4())
+	ip.AddRtAttr(nl.CTA_IP_V4_DST, net.ParseIP(dst).To4())
+	proto := tuple.AddRtAttr(nl.CTA_TUPLE_PROTO|int(nl.NLA_F_NESTED), nil)
+	proto.AddRtAttr(nl.CTA_PROTO_NUM, []byte{syscall.IPPROTO_TCP})
+	proto.AddRtAttr(nl.CTA_PROTO_SRC_PORT, be16(sport))
+	proto.AddRtAttr(nl.CTA_PROTO_DST_PORT, be16(dport))
+	return tuple
+}
+
+func testPair(attrType int, firstType, secondType int,
+	first, second uint64) *nl.RtAttr {
+
+	pair := nl.NewRtAttr(attrType|int(nl.NLA_F_NESTED), nil)
+	pair.AddRtAttr(firstType, be64(first))
+	pair.AddRtAttr(secondType, be64(second))
+	return pair
+}
+
+func TestParseFlow(t *testing.T) {
+	msg := []byte{syscall.AF_INET, nl.NFNETLINK_V0, 0, 0}
+	for _, attr := range []*nl.RtAttr{
+		testTuple(nl.CTA_TUPLE_ORIG, "10.1.0.2", "192.168.1.1", 40000, 80),
+		testTuple(nl.CTA_TUPLE_REPLY, "192.168.1.1", "10.1.0.2", 80, 40000),
+		nl.NewRtAttr(nl.CTA_STATUS, be32(0x19e)),
+		nl.NewRtAttr(nl.CTA_TIMEOUT, be32(100)),
+		nl.NewRtAttr(nl.CTA_MARK, be32(0x5000007)),
+		testPair(nl.CTA_COUNTERS_ORIG, ctaCountersPackets, ctaCountersBytes,
+			3, 180),
+		testPair(nl.CTA_COUNTERS_REPLY, ctaCountersPackets, ctaCountersBytes,
+			2, 120),
+		nl.NewRtAttr(ctaZone, be16(100)),
+		testPair(nl.CTA_TIMESTAMP, ctaTimestampStart, ctaTimestampStop,
+			1000, 2000),
+	} {
+		msg = append(msg, attr.Serialize()...)
+	}
+
+	flow, err := parseFlow(msg)
+	if err != nil {
+		t.Fatalf("parseFlow failed: %v", err)
+	}
+	if flow.FamilyType != syscall.AF_INET || flow.Zone != 100 ||
+		flow.Mark != 0x5000007 || flow.TimeOut != 100 ||
+		flow.TimeStart != 1000 || flow.TimeStop != 2000 {
+		t.Errorf("wrong flow %+v", flow)
+	}
+	if !flow.Forward.SrcIP.Equal(net.ParseIP("10.1.0.2")) ||
+		!flow.Forward.DstIP.Equal(net.ParseIP("192.168.1.1")) ||
+		flow.Forward.Protocol != syscall.IPPROTO_TCP ||
+		flow.Forward.SrcPort != 40000 || flow.Forward.DstPort != 80 ||
+		flow.Forward.Packets != 3 || flow.Forward.Bytes != 180 {
+		t.Errorf("wrong forward tuple %+v", flow.Forward)
+	}
+	if !flow.Reverse.SrcIP.Equal(net.ParseIP("192.168.1.1")) ||
+		!flow.Reverse.DstIP.Equal(net.ParseIP("10.1.0.2")) ||
+		flow.Reverse.SrcPort != 80 || flow.Reverse.DstPort != 40000 ||
+		flow.Reverse.Packets != 2 || flow.Reverse.Bytes != 120 {
+		t.Errorf("wrong reverse tuple %+v", flow.Reverse)
+	}
+
+	if _, err := parseFlow(msg[:2]); err == nil {
+		t.Errorf("short message parsed")
+	}
+}
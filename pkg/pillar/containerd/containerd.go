@@ -14,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"google.golang.org/grpc/connectivity"
@@ -30,6 +31,7 @@ import (
 	"github.com/containerd/typeurl"
 	"github.com/lf-edge/edge-containers/pkg/resolver"
 	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/utils"
 	"github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/identity"
 	"github.com/vishvananda/netlink"
@@ -37,6 +39,7 @@ import (
 	v1stat "github.com/containerd/cgroups/stats/v1"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	spec "github.com/opencontainers/image-spec/specs-go/v1"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -72,6 +75,9 @@ const (
 
 	// default signal to kill tasks
 	defaultSignal = "SIGTERM"
+
+	// default time limit for an executable started in a container
+	defaultExecTimeout = 30 * time.Second
 )
 
 var (
@@ -412,6 +418,58 @@ func (client *Client) CtrGetContainerMetrics(ctx context.Context, containerID st
 	}
 }
 
+// ContainerStats converts the cgroup metrics of a task into the
+// AppContainerStats reported for the container
+func ContainerStats(name string, metric *v1stat.Metrics) types.AppContainerStats {
+	stats := types.AppContainerStats{ContainerName: name}
+	if metric == nil {
+		return stats
+	}
+	if metric.Pids != nil {
+		stats.Pids = uint32(metric.Pids.Current)
+		stats.PidsLimit = metric.Pids.Limit
+	}
+	if metric.CPU != nil && metric.CPU.Usage != nil {
+		stats.CPUTotal = metric.CPU.Usage.Total
+	}
+	if metric.Memory != nil && metric.Memory.Usage != nil {
+		stats.UsedMem = uint32(utils.RoundToMbytes(metric.Memory.Usage.Usage))
+		stats.AllocatedMem = uint32(utils.RoundToMbytes(metric.Memory.HierarchicalMemoryLimit))
+	}
+	if metric.Blkio != nil {
+		for _, entry := range metric.Blkio.IoServiceBytesRecursive {
+			switch strings.ToLower(entry.Op) {
+			case "read":
+				stats.ReadBytes += entry.Value
+			case "write":
+				stats.WriteBytes += entry.Value
+			}
+		}
+		stats.ReadBytes = utils.RoundToMbytes(stats.ReadBytes)
+		stats.WriteBytes = utils.RoundToMbytes(stats.WriteBytes)
+		for _, entry := range metric.Blkio.IoServicedRecursive {
+			switch strings.ToLower(entry.Op) {
+			case "read":
+				stats.ReadOps += entry.Value
+			case "write":
+				stats.WriteOps += entry.Value
+			}
+		}
+	}
+	for _, h := range metric.Hugetlb {
+		if h == nil {
+			continue
+		}
+		stats.Hugepages = append(stats.Hugepages, types.HugepageStats{
+			PageSize: h.Pagesize,
+			Usage:    h.Usage,
+			MaxUsage: h.Max,
+			Failcnt:  h.Failcnt,
+		})
+	}
+	return stats
+}
+
 // CtrContainerInfo returns PID, exit code and status of a container's main task
 // Status can be one of the: created, running, pausing, paused, stopped, unknown
 // For tasks that are in the running, pausing or paused state the PID is also provided
@@ -512,12 +570,41 @@ func (client *Client) CtrStartTask(ctx context.Context, domainName string) error
 	return task.Start(ctx)
 }
 
+// CtrUpdateTaskCPUs restricts the running task to the CPUs in cpulist format
+func (client *Client) CtrUpdateTaskCPUs(ctx context.Context, domainName string, cpus string) error {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return fmt.Errorf("CtrUpdateTaskCPUs: exception while verifying ctrd client: %s", err.Error())
+	}
+	ctr, err := client.CtrLoadContainer(ctx, domainName)
+	if err != nil {
+		return err
+	}
+
+	task, err := ctr.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	return task.Update(ctx, containerd.WithResources(&specs.LinuxResources{
+		CPU: &specs.LinuxCPU{Cpus: cpus},
+	}))
+}
+
 // CtrExec starts the executable in a running user container
 func (client *Client) CtrExec(ctx context.Context, domainName string, args []string) (string, string, error) {
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("CtrExec: exception while verifying ctrd client: %s", err.Error())
 	}
-	return client.ctrExec(ctx, domainName, args)
+	return client.ctrExec(ctx, domainName, args, defaultExecTimeout)
+}
+
+// CtrExecWithTimeout starts the executable in a running user container
+// and kills it if it does not exit within the timeout
+func (client *Client) CtrExecWithTimeout(ctx context.Context, domainName string, args []string, timeout time.Duration) (string, string, error) {
+	if err := client.verifyCtr(ctx, true); err != nil {
+		return "", "", fmt.Errorf("CtrExecWithTimeout: exception while verifying ctrd client: %s", err.Error())
+	}
+	return client.ctrExec(ctx, domainName, args, timeout)
 }
 
 // CtrSystemExec starts the executable in a running system (EVE's) container
@@ -525,7 +612,7 @@ func (client *Client) CtrSystemExec(ctx context.Context, domainName string, args
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("CtrSystemExec: exception while verifying ctrd client: %s", err.Error())
 	}
-	return client.ctrExec(ctx, domainName, args)
+	return client.ctrExec(ctx, domainName, args, defaultExecTimeout)
 }
 
 // CtrStopContainer stops (kills) the main task in the container
@@ -639,7 +726,7 @@ func (client *Client) CtrNewSystemServicesCtxWithLease() (context.Context, conte
 // Util methods
 
 // ctrExec starts the executable in a running container and attaches its logging to memlogd
-func (client *Client) ctrExec(ctx context.Context, domainName string, args []string) (string, string, error) {
+func (client *Client) ctrExec(ctx context.Context, domainName string, args []string, timeout time.Duration) (string, string, error) {
 	if err := client.verifyCtr(ctx, true); err != nil {
 		return "", "", fmt.Errorf("ctrExec: exception while verifying ctrd client: %s", err.Error())
 	}
@@ -687,7 +774,7 @@ func (client *Client) ctrExec(ctx context.Context, domainName string, args []str
 	}
 
 	// block until the process exits or the timer fires
-	timer := time.NewTimer(30 * time.Second)
+	timer := time.NewTimer(timeout)
 	select {
 	case status := <-statusC:
 		if code, _, e := status.Result(); e == nil && code != 0 {
@@ -697,6 +784,10 @@ func (client *Client) ctrExec(ctx context.Context, domainName string, args []str
 		}
 	case <-timer.C:
 		err = fmt.Errorf("execution timed out")
+		// make sure the process is gone before deleting it
+		if e := process.Kill(ctx, syscall.SIGKILL); e == nil {
+			<-statusC
+		}
 	}
 
 	st, ee := process.Status(ctx)
@@ -3,9 +3,18 @@
 
 package containerd
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
 
 // bind mount a namespace file
 func bindNS(ns string, path string, pid int) error {
 	return fmt.Errorf("bindNS is not implemented on Mac OS X")
 }
+
+// deviceFromPath returns the OCI device node for a character or block device
+func deviceFromPath(path string) (*specs.LinuxDevice, error) {
+	return nil, fmt.Errorf("deviceFromPath is not implemented on Mac OS X")
+}
@@ -8,6 +8,8 @@ import (
 	"golang.org/x/sys/unix"
 	"os"
 	"path/filepath"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
 // bind mount a namespace file
@@ -32,3 +34,30 @@ func bindNS(ns string, path string, pid int) error {
 	}
 	return nil
 }
+
+// deviceFromPath returns the OCI device node for a character or block device
+func deviceFromPath(path string) (*specs.LinuxDevice, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return nil, fmt.Errorf("deviceFromPath: %s: %v", path, err)
+	}
+	var devType string
+	switch stat.Mode & unix.S_IFMT {
+	case unix.S_IFCHR:
+		devType = "c"
+	case unix.S_IFBLK:
+		devType = "b"
+	default:
+		return nil, fmt.Errorf("deviceFromPath: %s is not a device", path)
+	}
+	mode := os.FileMode(stat.Mode &^ unix.S_IFMT)
+	return &specs.LinuxDevice{
+		Path:     path,
+		Type:     devType,
+		Major:    int64(unix.Major(uint64(stat.Rdev))),
+		Minor:    int64(unix.Minor(uint64(stat.Rdev))),
+		FileMode: &mode,
+		UID:      &stat.Uid,
+		GID:      &stat.Gid,
+	}, nil
+}
@@ -15,7 +15,11 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"testing"
+
+	v1stat "github.com/containerd/cgroups/stats/v1"
+	"github.com/lf-edge/eve/pkg/pillar/types"
 )
 
 const (
@@ -116,3 +120,50 @@ func TestGetSnapshotID(t *testing.T) {
 		})
 	}
 }
+
+func TestContainerStats(t *testing.T) {
+	metric := &v1stat.Metrics{
+		Pids: &v1stat.PidsStat{Current: 12, Limit: 100},
+		CPU:  &v1stat.CPUStat{Usage: &v1stat.CPUUsage{Total: 5000}},
+		Memory: &v1stat.MemoryStat{
+			Usage:                   &v1stat.MemoryEntry{Usage: 10 << 20},
+			HierarchicalMemoryLimit: 256 << 20,
+		},
+		Blkio: &v1stat.BlkIOStat{
+			IoServiceBytesRecursive: []*v1stat.BlkIOEntry{
+				{Op: "Read", Value: 3 << 20},
+				{Op: "Write", Value: 1 << 20},
+				{Op: "Total", Value: 4 << 20},
+			},
+			IoServicedRecursive: []*v1stat.BlkIOEntry{
+				{Op: "Read", Value: 30},
+				{Op: "Write", Value: 7},
+			},
+		},
+		Hugetlb: []*v1stat.HugetlbStat{
+			{Pagesize: "2MB", Usage: 4 << 20, Max: 8 << 20, Failcnt: 1},
+		},
+	}
+	stats := ContainerStats("app", metric)
+	expected := types.AppContainerStats{
+		ContainerName: "app",
+		Pids:          12,
+		PidsLimit:     100,
+		CPUTotal:      5000,
+		UsedMem:       10,
+		AllocatedMem:  256,
+		ReadBytes:     3,
+		WriteBytes:    1,
+		ReadOps:       30,
+		WriteOps:      7,
+		Hugepages: []types.HugepageStats{
+			{PageSize: "2MB", Usage: 4 << 20, MaxUsage: 8 << 20, Failcnt: 1},
+		},
+	}
+	if !reflect.DeepEqual(stats, expected) {
+		t.Errorf("ContainerStats got %+v, expected %+v", stats, expected)
+	}
+	if stats := ContainerStats("empty", nil); stats.ContainerName != "empty" {
+		t.Errorf("ContainerStats(nil) got %+v", stats)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
@@ -26,6 +27,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
 )
 
 const eveScript = "/bin/eve"
@@ -58,6 +60,9 @@ type OCISpec interface {
 	AdjustMemLimit(types.DomainConfig, int64)
 	UpdateVifList([]types.VifInfo)
 	UpdateFromDomain(*types.DomainConfig)
+	UpdateCPUs(string)
+	UpdateContainerResources(*types.DomainConfig)
+	UpdateDevices([]string) error
 	UpdateFromVolume(string) error
 	UpdateMounts([]types.DiskStatus) error
 	UpdateEnvVar(map[string]string)
@@ -301,6 +306,115 @@ func (s *ociSpec) UpdateFromDomain(dom *types.DomainConfig) {
 	s.Annotations[EVEOCIVNCPasswordLabel] = dom.VncPasswd
 }
 
+// UpdateCPUs restricts the container to the CPUs in cpulist format
+// using the cpuset cgroup
+func (s *ociSpec) UpdateCPUs(cpus string) {
+	if s.Linux == nil || cpus == "" {
+		return
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	if s.Linux.Resources.CPU == nil {
+		s.Linux.Resources.CPU = &specs.LinuxCPU{}
+	}
+	s.Linux.Resources.CPU.Cpus = cpus
+}
+
+// UpdateContainerResources sets the block IO, PIDs and hugepage cgroup
+// limits of a container app instance. Throttles for block devices which
+// do not exist on this device are skipped.
+func (s *ociSpec) UpdateContainerResources(dom *types.DomainConfig) {
+	if s.Linux == nil {
+		return
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	r := s.Linux.Resources
+	if dom.BlkioWeight != 0 || len(dom.BlkioThrottles) != 0 {
+		if r.BlockIO == nil {
+			r.BlockIO = &specs.LinuxBlockIO{}
+		}
+		if dom.BlkioWeight != 0 {
+			w := dom.BlkioWeight
+			r.BlockIO.Weight = &w
+		}
+		for _, t := range dom.BlkioThrottles {
+			dev, err := deviceFromPath(t.Device)
+			if err == nil && dev.Type != "b" {
+				err = fmt.Errorf("%s is not a block device", t.Device)
+			}
+			if err != nil {
+				logrus.Warnf("UpdateContainerResources(%s): skipping blkio throttle: %v",
+					s.name, err)
+				continue
+			}
+			r.BlockIO.ThrottleReadBpsDevice = appendThrottle(
+				r.BlockIO.ThrottleReadBpsDevice, dev, t.ReadBps)
+			r.BlockIO.ThrottleWriteBpsDevice = appendThrottle(
+				r.BlockIO.ThrottleWriteBpsDevice, dev, t.WriteBps)
+			r.BlockIO.ThrottleReadIOPSDevice = appendThrottle(
+				r.BlockIO.ThrottleReadIOPSDevice, dev, t.ReadIops)
+			r.BlockIO.ThrottleWriteIOPSDevice = appendThrottle(
+				r.BlockIO.ThrottleWriteIOPSDevice, dev, t.WriteIops)
+		}
+	}
+	if dom.PidsLimit > 0 {
+		r.Pids = &specs.LinuxPids{Limit: dom.PidsLimit}
+	}
+	for _, h := range dom.HugepageLimits {
+		r.HugepageLimits = append(r.HugepageLimits, specs.LinuxHugepageLimit{
+			Pagesize: h.PageSize,
+			Limit:    h.Limit,
+		})
+	}
+}
+
+func appendThrottle(list []specs.LinuxThrottleDevice, dev *specs.LinuxDevice,
+	rate uint64) []specs.LinuxThrottleDevice {
+
+	if rate == 0 {
+		return list
+	}
+	t := specs.LinuxThrottleDevice{Rate: rate}
+	t.Major = dev.Major
+	t.Minor = dev.Minor
+	return append(list, t)
+}
+
+// UpdateDevices passes the given device nodes (e.g., /dev/ttyS1) through
+// to the container. Once there is at least one, the device cgroup is
+// switched from allowing all devices to only allowing these ones in
+// addition to the runtime defaults (/dev/null, /dev/tty and friends)
+func (s *ociSpec) UpdateDevices(paths []string) error {
+	if s.Linux == nil || len(paths) == 0 {
+		return nil
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	s.Linux.Resources.Devices = []specs.LinuxDeviceCgroup{
+		{Allow: false, Access: "rwm"},
+	}
+	for _, path := range paths {
+		dev, err := deviceFromPath(path)
+		if err != nil {
+			return err
+		}
+		s.Linux.Devices = append(s.Linux.Devices, *dev)
+		s.Linux.Resources.Devices = append(s.Linux.Resources.Devices,
+			specs.LinuxDeviceCgroup{
+				Allow:  true,
+				Type:   dev.Type,
+				Major:  &dev.Major,
+				Minor:  &dev.Minor,
+				Access: "rwm",
+			})
+	}
+	return nil
+}
+
 // UpdateFromVolume updates values in the OCI spec based on the location
 // of an EVE volume. EVE volume's are expected to be structured as directories
 // in the filesystem with either config.json containing the full OCI runtime
@@ -328,6 +442,54 @@ func (s *ociSpec) UpdateFromVolume(volume string) error {
 	return s.updateFromImageConfig(imgInfo.Config)
 }
 
+// dockerImageConfig has the fields of the Docker image config which
+// are not in the OCI image config
+type dockerImageConfig struct {
+	Config struct {
+		Healthcheck *dockerHealthConfig `json:"Healthcheck,omitempty"`
+	} `json:"config"`
+}
+
+// dockerHealthConfig is the HEALTHCHECK of a Docker image
+type dockerHealthConfig struct {
+	Test        []string      `json:",omitempty"`
+	Interval    time.Duration `json:",omitempty"`
+	Timeout     time.Duration `json:",omitempty"`
+	StartPeriod time.Duration `json:",omitempty"`
+	Retries     int           `json:",omitempty"`
+}
+
+// GetImageHealthCheck returns the HEALTHCHECK of the container image saved
+// in the volume, or nil if there is none
+func GetImageHealthCheck(volume string) (*types.HealthCheckConfig, error) {
+	data, err := ioutil.ReadFile(filepath.Join(volume, imageConfigFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseImageHealthCheck(data)
+}
+
+func parseImageHealthCheck(data []byte) (*types.HealthCheckConfig, error) {
+	var config dockerImageConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parseImageHealthCheck: %v", err)
+	}
+	hc := config.Config.Healthcheck
+	if hc == nil || len(hc.Test) == 0 {
+		return nil, nil
+	}
+	return &types.HealthCheckConfig{
+		Test:        hc.Test,
+		Interval:    hc.Interval,
+		Timeout:     hc.Timeout,
+		StartPeriod: hc.StartPeriod,
+		Retries:     uint32(hc.Retries),
+	}, nil
+}
+
 // UpdateFromImageConfig updates values in the OCI spec based
 // on the values provided in the Image Config section as per:
 //    https://github.com/opencontainers/image-spec/blob/master/config.md
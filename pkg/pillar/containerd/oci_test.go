@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	zconfig "github.com/lf-edge/eve/api/go/config"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -846,3 +847,90 @@ func deepCopy(in interface{}) interface{} {
 	val = val.Elem()
 	return val.Interface()
 }
+
+func TestParseImageHealthCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	hc, err := parseImageHealthCheck([]byte(`{"architecture": "amd64", "config": {"Env": ["PATH=/bin"],
+		"Healthcheck": {"Test": ["CMD-SHELL", "curl -f http://localhost/"],
+		"Interval": 10000000000, "StartPeriod": 5000000000, "Retries": 5}}}`))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hc).To(Equal(&types.HealthCheckConfig{
+		Test:        []string{"CMD-SHELL", "curl -f http://localhost/"},
+		Interval:    10 * time.Second,
+		StartPeriod: 5 * time.Second,
+		Retries:     5,
+	}))
+
+	hc, err = parseImageHealthCheck([]byte(`{"architecture": "amd64", "config": {"Env": ["PATH=/bin"]}}`))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(hc).To(BeNil())
+
+	_, err = parseImageHealthCheck([]byte(`{"config": `))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestUpdateContainerResources(t *testing.T) {
+	client := &Client{}
+	spec, err := client.NewOciSpec("test")
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	conf := &types.DomainConfig{
+		VmConfig: types.VmConfig{
+			BlkioWeight: 500,
+			BlkioThrottles: []types.BlkioThrottle{
+				// neither is a block device so both get skipped
+				{Device: "/dev/null", ReadBps: 1024},
+				{Device: "/dev/no-such-disk", WriteIops: 10},
+			},
+			PidsLimit: 100,
+			HugepageLimits: []types.HugepageLimit{
+				{PageSize: "2MB", Limit: 64 << 20},
+			},
+		},
+	}
+	spec.UpdateContainerResources(conf)
+
+	r := spec.Get().Linux.Resources
+	assert.Equal(t, uint16(500), *r.BlockIO.Weight)
+	assert.Empty(t, r.BlockIO.ThrottleReadBpsDevice)
+	assert.Empty(t, r.BlockIO.ThrottleWriteIOPSDevice)
+	assert.Equal(t, int64(100), r.Pids.Limit)
+	assert.Equal(t, []specs.LinuxHugepageLimit{{Pagesize: "2MB", Limit: 64 << 20}},
+		r.HugepageLimits)
+
+	dev := &specs.LinuxDevice{Type: "b", Major: 8, Minor: 0}
+	throttles := appendThrottle(nil, dev, 0)
+	assert.Empty(t, throttles)
+	throttles = appendThrottle(throttles, dev, 2048)
+	assert.Equal(t, 1, len(throttles))
+	assert.Equal(t, int64(8), throttles[0].Major)
+	assert.Equal(t, uint64(2048), throttles[0].Rate)
+}
+
+func TestUpdateDevices(t *testing.T) {
+	client := &Client{}
+	spec, err := client.NewOciSpec("test")
+	if err != nil {
+		t.Fatalf("failed to create default OCI spec %v", err)
+	}
+	// no devices keeps all devices allowed
+	assert.NoError(t, spec.UpdateDevices(nil))
+	assert.Nil(t, spec.Get().Linux.Resources.Devices)
+
+	assert.Error(t, spec.UpdateDevices([]string{"/dev/no-such-tty"}))
+
+	spec, _ = client.NewOciSpec("test")
+	assert.NoError(t, spec.UpdateDevices([]string{"/dev/null"}))
+	s := spec.Get()
+	assert.Equal(t, 1, len(s.Linux.Devices))
+	assert.Equal(t, "/dev/null", s.Linux.Devices[0].Path)
+	assert.Equal(t, "c", s.Linux.Devices[0].Type)
+	assert.Equal(t, int64(1), s.Linux.Devices[0].Major)
+	assert.Equal(t, int64(3), s.Linux.Devices[0].Minor)
+	assert.Equal(t, 2, len(s.Linux.Resources.Devices))
+	assert.False(t, s.Linux.Resources.Devices[0].Allow)
+	assert.True(t, s.Linux.Resources.Devices[1].Allow)
+	assert.Equal(t, int64(3), *s.Linux.Resources.Devices[1].Minor)
+}
@@ -19,7 +19,10 @@ const (
 	// PbrLocalOrigPrio : IP rule priority for locally generated packets
 	PbrLocalOrigPrio = 15000
 	// PbrNatOutGatewayPrio : IP rule priority for packets destined to gateway(bridge ip) coming from apps.
-	PbrNatOutGatewayPrio = 9999
+	PbrNatOutGatewayPrio = 9998
+	// PbrNatOutLBPrio : IP rule priority for packets coming from apps marked with an uplink
+	// by the load balancing of the network instance
+	PbrNatOutLBPrio = 9999
 	// PbrNatOutPrio : IP rule priority for packets destined to internet coming from apps
 	PbrNatOutPrio = 10000
 	// PbrNatInPrio : IP rule priority for external packets coming in towards apps
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/faultinject"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 )
@@ -93,6 +94,10 @@ func (hdl *ExecuteHandle) Execute(args ExecuteArgs) (string, error) {
 		Combined:  args.CombinedOutput,
 		DontWait:  args.DontWait,
 	}
+	if err := faultinject.ExecFaultFor(hdl.caller, args.Command); err != nil {
+		hdl.log.Warnf("Execute: %v", err)
+		return "", err
+	}
 	hdl.log.Functionf("publish %+v", config)
 	hdl.pubExecConfig.Publish(config.Key(), config)
 	if args.DontWait {
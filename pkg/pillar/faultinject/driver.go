@@ -0,0 +1,84 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinject
+
+import (
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// Driver for pubsub which wraps another driver and delays or drops the
+// publications as requested by the active scenario
+type Driver struct {
+	Driver pubsub.Driver // The wrapped driver which does the actual work
+	Log    *base.LogObject
+}
+
+// Publisher return a `pubsub.DriverPublisher` which injects the faults
+// before passing the operations to the wrapped driver
+func (d *Driver) Publisher(global bool, name, topic string, persistent bool, updaterList *pubsub.Updaters, restarted pubsub.Restarted, differ pubsub.Differ) (pubsub.DriverPublisher, error) {
+	pub, err := d.Driver.Publisher(global, name, topic, persistent,
+		updaterList, restarted, differ)
+	if err != nil {
+		return nil, err
+	}
+	return &Publisher{
+		DriverPublisher: pub,
+		log:             d.Log,
+		name:            name,
+		topic:           topic,
+	}, nil
+}
+
+// Subscriber return the `pubsub.DriverSubscriber` of the wrapped driver
+func (d *Driver) Subscriber(global bool, name, topic string, persistent bool, C chan pubsub.Change) (pubsub.DriverSubscriber, error) {
+	return d.Driver.Subscriber(global, name, topic, persistent, C)
+}
+
+// DefaultName default name for an agent when none is provided
+func (d *Driver) DefaultName() string {
+	return d.Driver.DefaultName()
+}
+
+// Publisher wraps a `pubsub.DriverPublisher` and injects the faults
+type Publisher struct {
+	pubsub.DriverPublisher
+	log   *base.LogObject
+	name  string
+	topic string
+}
+
+// Publish the key and value unless dropped
+func (p *Publisher) Publish(key string, item []byte) error {
+	if p.inject("Publish", key) {
+		return nil
+	}
+	return p.DriverPublisher.Publish(key, item)
+}
+
+// Unpublish the key unless dropped
+func (p *Publisher) Unpublish(key string) error {
+	if p.inject("Unpublish", key) {
+		return nil
+	}
+	return p.DriverPublisher.Unpublish(key)
+}
+
+// inject sleeps for the delay and returns true if the operation should
+// be dropped
+func (p *Publisher) inject(op string, key string) bool {
+	delay, drop := PubsubFaultFor(p.name, p.topic)
+	if delay != 0 {
+		p.log.Warnf("faultinject: delaying %s(%s/%s/%s) by %v",
+			op, p.name, p.topic, key, delay)
+		time.Sleep(delay)
+	}
+	if drop {
+		p.log.Warnf("faultinject: dropping %s(%s/%s/%s)",
+			op, p.name, p.topic, key)
+	}
+	return drop
+}
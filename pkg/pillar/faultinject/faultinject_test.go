@@ -0,0 +1,177 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinject
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseScenario(t *testing.T) {
+	testMatrix := map[string]struct {
+		data      string
+		expectErr bool
+	}{
+		"empty": {
+			data: `{}`,
+		},
+		"all": {
+			data: `{"pubsub": [{"agent": "volumemgr", "topic": "VolumeStatus", "delayMs": 100}],
+				"zedcloud": [{"url": "/config", "statusCode": 503, "percent": 50}],
+				"persist": {"fillPercent": 95},
+				"exec": [{"command": "/usr/bin/qemu-img"}]}`,
+		},
+		"bad json": {
+			data:      `{"pubsub": `,
+			expectErr: true,
+		},
+		"pubsub without fault": {
+			data:      `{"pubsub": [{"topic": "VolumeStatus"}]}`,
+			expectErr: true,
+		},
+		"pubsub bad percent": {
+			data:      `{"pubsub": [{"drop": true, "percent": 101}]}`,
+			expectErr: true,
+		},
+		"zedcloud not an error": {
+			data:      `{"zedcloud": [{"url": "/config", "statusCode": 200}]}`,
+			expectErr: true,
+		},
+		"persist bad percent": {
+			data:      `{"persist": {"fillPercent": 200}}`,
+			expectErr: true,
+		},
+		"exec without command": {
+			data:      `{"exec": [{"error": "failed"}]}`,
+			expectErr: true,
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		_, err := ParseScenario([]byte(test.data))
+		if test.expectErr && err == nil {
+			t.Errorf("%s: expected an error", testname)
+		} else if !test.expectErr && err != nil {
+			t.Errorf("%s: unexpected error %v", testname, err)
+		}
+	}
+}
+
+func TestFaultFor(t *testing.T) {
+	SetScenario(&Scenario{
+		Pubsub: []PubsubFault{
+			{Agent: "volumemgr", Topic: "VolumeStatus", DelayMs: 100},
+			{Topic: "AppNetworkStatus", Drop: true},
+		},
+		Zedcloud: []ZedcloudFault{
+			{Agent: "zedagent", URL: "/config", StatusCode: 503},
+		},
+		Exec: []ExecFault{
+			{Command: "/usr/bin/qemu-img", Error: "no space"},
+		},
+	})
+	defer SetScenario(nil)
+
+	if delay, drop := PubsubFaultFor("volumemgr", "VolumeStatus"); delay != 100*time.Millisecond || drop {
+		t.Errorf("volumemgr VolumeStatus: got %v %t", delay, drop)
+	}
+	if delay, drop := PubsubFaultFor("zedmanager", "VolumeStatus"); delay != 0 || drop {
+		t.Errorf("zedmanager VolumeStatus: got %v %t", delay, drop)
+	}
+	if delay, drop := PubsubFaultFor("zedrouter", "AppNetworkStatus"); delay != 0 || !drop {
+		t.Errorf("zedrouter AppNetworkStatus: got %v %t", delay, drop)
+	}
+	if code := ZedcloudFaultFor("zedagent", "https://zedcloud/api/v2/edgedevice/config"); code != 503 {
+		t.Errorf("zedagent config: got %d", code)
+	}
+	if code := ZedcloudFaultFor("zedagent/scope", "https://zedcloud/api/v2/edgedevice/config"); code != 503 {
+		t.Errorf("zedagent/scope config: got %d", code)
+	}
+	if code := ZedcloudFaultFor("zedagent", "https://zedcloud/api/v2/edgedevice/info"); code != 0 {
+		t.Errorf("zedagent info: got %d", code)
+	}
+	if err := ExecFaultFor("volumemgr", "/usr/bin/qemu-img"); err == nil {
+		t.Errorf("volumemgr qemu-img: expected an error")
+	}
+	if err := ExecFaultFor("volumemgr", "/usr/bin/qemu"); err != nil {
+		t.Errorf("volumemgr qemu: unexpected error %v", err)
+	}
+
+	SetScenario(nil)
+	if _, drop := PubsubFaultFor("zedrouter", "AppNetworkStatus"); drop {
+		t.Errorf("no scenario: unexpected drop")
+	}
+}
+
+func TestFillBytes(t *testing.T) {
+	testMatrix := map[string]struct {
+		total, free uint64
+		percent     uint8
+		expected    uint64
+	}{
+		"already used": {total: 1000, free: 100, percent: 50, expected: 0},
+		"half":         {total: 1000, free: 1000, percent: 50, expected: 500},
+		"some used":    {total: 1000, free: 800, percent: 90, expected: 700},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		got := fillBytes(test.total, test.free, test.percent)
+		if got != test.expected {
+			t.Errorf("%s: expected %d got %d", testname, test.expected, got)
+		}
+	}
+}
+
+func TestFillDiskStop(t *testing.T) {
+	stop := make(chan struct{})
+	close(stop)
+	filename := filepath.Join(t.TempDir(), "fill")
+	written, err := FillDisk(filename, 100, stop)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != 0 {
+		t.Errorf("wrote %d bytes after stop", written)
+	}
+}
+
+type countingPublisher struct {
+	pubsub.EmptyDriverPublisher
+	published int
+}
+
+func (p *countingPublisher) Publish(key string, item []byte) error {
+	p.published++
+	return nil
+}
+
+func TestPublisherDrop(t *testing.T) {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	wrapped := &countingPublisher{}
+	pub := &Publisher{
+		DriverPublisher: wrapped,
+		log:             log,
+		name:            "zedrouter",
+		topic:           "AppNetworkStatus",
+	}
+	if err := pub.Publish("key", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	SetScenario(&Scenario{Pubsub: []PubsubFault{
+		{Agent: "zedrouter", Drop: true},
+	}})
+	defer SetScenario(nil)
+	if err := pub.Publish("key", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+	if wrapped.published != 1 {
+		t.Errorf("expected 1 publish got %d", wrapped.published)
+	}
+}
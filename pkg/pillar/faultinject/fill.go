@@ -0,0 +1,66 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package faultinject
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// fillChunkSize is the size of each write to the fill file
+const fillChunkSize = 1024 * 1024
+
+// FillDisk grows filename until percent of the filesystem it is on is
+// used, or until stop is closed. The file is written with random data
+// since /persist might be compressed. Returns the number of bytes written.
+func FillDisk(filename string, percent uint8, stop <-chan struct{}) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(filepath.Dir(filename), &stat); err != nil {
+		return 0, err
+	}
+	total := stat.Blocks * uint64(stat.Bsize)
+	free := stat.Bavail * uint64(stat.Bsize)
+	needed := fillBytes(total, free, percent)
+	if needed == 0 {
+		return 0, nil
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	buf := make([]byte, fillChunkSize)
+	var written uint64
+	for written < needed {
+		select {
+		case <-stop:
+			return written, f.Sync()
+		default:
+		}
+		size := needed - written
+		if size > fillChunkSize {
+			size = fillChunkSize
+		}
+		rand.Read(buf[:size])
+		n, err := f.Write(buf[:size])
+		written += uint64(n)
+		if err != nil {
+			return written, fmt.Errorf("fill %s: %v", filename, err)
+		}
+	}
+	return written, f.Sync()
+}
+
+// fillBytes returns how much to write so that percent of total is used
+func fillBytes(total, free uint64, percent uint8) uint64 {
+	used := total - free
+	target := total / 100 * uint64(percent)
+	if target <= used {
+		return 0
+	}
+	return target - used
+}
@@ -0,0 +1,217 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package faultinject injects failures described by a Scenario into the
+// pubsub publications, the zedcloud sends and the execlib commands of the
+// agents running in the same process, and fills /persist. This is used to
+// test the recovery paths.
+//
+// On a device the faultinjection agent loads the scenario file when the
+// debug.enable.fault.injection global setting is set. Go tests can call
+// SetScenario directly and SetScenario(nil) when done.
+package faultinject
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scenario describes the faults to inject. A fault with an empty Agent
+// applies to all agents.
+type Scenario struct {
+	Pubsub   []PubsubFault   `json:"pubsub,omitempty"`
+	Zedcloud []ZedcloudFault `json:"zedcloud,omitempty"`
+	Persist  *PersistFault   `json:"persist,omitempty"`
+	Exec     []ExecFault     `json:"exec,omitempty"`
+}
+
+// PubsubFault delays and/or drops the Publish and Unpublish of a topic
+type PubsubFault struct {
+	Agent   string `json:"agent,omitempty"` // Publishing agent
+	Topic   string `json:"topic,omitempty"` // E.g., "VolumeStatus"; empty for all
+	DelayMs uint32 `json:"delayMs,omitempty"`
+	Drop    bool   `json:"drop,omitempty"`
+	Percent uint8  `json:"percent,omitempty"` // Of the matching operations; zero means all
+}
+
+// ZedcloudFault fails the sends to the controller with an HTTP status code
+type ZedcloudFault struct {
+	Agent      string `json:"agent,omitempty"`
+	URL        string `json:"url,omitempty"` // Substring of the URL e.g., "/config"
+	StatusCode int    `json:"statusCode"`
+	Percent    uint8  `json:"percent,omitempty"`
+}
+
+// PersistFault fills /persist until FillPercent of it is used
+type PersistFault struct {
+	FillPercent uint8 `json:"fillPercent"`
+}
+
+// ExecFault fails the execlib commands
+type ExecFault struct {
+	Agent   string `json:"agent,omitempty"` // Caller of execlib
+	Command string `json:"command"`         // Exact match e.g., "/usr/bin/qemu-img"
+	Error   string `json:"error,omitempty"`
+	Percent uint8  `json:"percent,omitempty"`
+}
+
+var (
+	lock     sync.Mutex
+	scenario *Scenario
+	random   = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// LoadScenario reads and validates a scenario file
+func LoadScenario(filename string) (*Scenario, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseScenario(data)
+}
+
+// ParseScenario parses and validates a json encoded scenario
+func ParseScenario(data []byte) (*Scenario, error) {
+	var s Scenario
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("cannot parse scenario: %v", err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Validate checks the values in the scenario
+func (s Scenario) Validate() error {
+	for _, f := range s.Pubsub {
+		if f.DelayMs == 0 && !f.Drop {
+			return fmt.Errorf("pubsub fault for %s/%s has neither delayMs nor drop",
+				f.Agent, f.Topic)
+		}
+		if f.Percent > 100 {
+			return fmt.Errorf("pubsub fault for %s/%s: percent %d is above 100",
+				f.Agent, f.Topic, f.Percent)
+		}
+	}
+	for _, f := range s.Zedcloud {
+		if f.StatusCode < 400 || f.StatusCode > 599 {
+			return fmt.Errorf("zedcloud fault for %s: statusCode %d is not an HTTP error",
+				f.URL, f.StatusCode)
+		}
+		if f.Percent > 100 {
+			return fmt.Errorf("zedcloud fault for %s: percent %d is above 100",
+				f.URL, f.Percent)
+		}
+	}
+	if s.Persist != nil && s.Persist.FillPercent > 100 {
+		return fmt.Errorf("persist fault: fillPercent %d is above 100",
+			s.Persist.FillPercent)
+	}
+	for _, f := range s.Exec {
+		if f.Command == "" {
+			return fmt.Errorf("exec fault has no command")
+		}
+		if f.Percent > 100 {
+			return fmt.Errorf("exec fault for %s: percent %d is above 100",
+				f.Command, f.Percent)
+		}
+	}
+	return nil
+}
+
+// SetScenario activates the scenario; nil deactivates the fault injection
+func SetScenario(s *Scenario) {
+	lock.Lock()
+	defer lock.Unlock()
+	scenario = s
+}
+
+// GetScenario returns the active scenario or nil
+func GetScenario() *Scenario {
+	lock.Lock()
+	defer lock.Unlock()
+	return scenario
+}
+
+// PubsubFaultFor returns the delay and whether to drop the publication of
+// the topic by the agent
+func PubsubFaultFor(agent, topic string) (time.Duration, bool) {
+	lock.Lock()
+	defer lock.Unlock()
+	if scenario == nil {
+		return 0, false
+	}
+	for _, f := range scenario.Pubsub {
+		if !matchAgent(f.Agent, agent) || (f.Topic != "" && f.Topic != topic) {
+			continue
+		}
+		if !hit(f.Percent) {
+			return 0, false
+		}
+		return time.Duration(f.DelayMs) * time.Millisecond, f.Drop
+	}
+	return 0, false
+}
+
+// ZedcloudFaultFor returns the HTTP status code to fail the send to the
+// URL with, or zero
+func ZedcloudFaultFor(agent, url string) int {
+	lock.Lock()
+	defer lock.Unlock()
+	if scenario == nil {
+		return 0
+	}
+	for _, f := range scenario.Zedcloud {
+		if !matchAgent(f.Agent, agent) || !strings.Contains(url, f.URL) {
+			continue
+		}
+		if !hit(f.Percent) {
+			return 0
+		}
+		return f.StatusCode
+	}
+	return 0
+}
+
+// ExecFaultFor returns the error to fail the command with, or nil
+func ExecFaultFor(agent, command string) error {
+	lock.Lock()
+	defer lock.Unlock()
+	if scenario == nil {
+		return nil
+	}
+	for _, f := range scenario.Exec {
+		if !matchAgent(f.Agent, agent) || f.Command != command {
+			continue
+		}
+		if !hit(f.Percent) {
+			return nil
+		}
+		errStr := f.Error
+		if errStr == "" {
+			errStr = "injected failure"
+		}
+		return fmt.Errorf("%s: %s", command, errStr)
+	}
+	return nil
+}
+
+// matchAgent matches the agent name which might have a scope
+// e.g., "zedagent/scope"
+func matchAgent(pattern, agent string) bool {
+	return pattern == "" || pattern == strings.Split(agent, "/")[0]
+}
+
+// hit returns true for percent of the calls; zero means all
+func hit(percent uint8) bool {
+	if percent == 0 || percent >= 100 {
+		return true
+	}
+	return random.Intn(100) < int(percent)
+}
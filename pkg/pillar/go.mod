@@ -22,6 +22,8 @@ require (
 	github.com/jackwakefield/gopac v1.0.2
 	github.com/lf-edge/edge-containers v0.0.0-20210630151415-7dbb4f290dab
 	github.com/lf-edge/eve/api/go v0.0.0-00010101000000-000000000000
+	github.com/lf-edge/eve/libs/depgraph v0.0.0-00010101000000-000000000000
+	github.com/lf-edge/eve/libs/reconciler v0.0.0-00010101000000-000000000000
 	github.com/lf-edge/eve/libs/zedUpload v0.0.0-20210120050122-276fea8f6efd
 	github.com/onsi/gomega v1.10.3
 	github.com/opencontainers/go-digest v1.0.0
@@ -45,6 +47,10 @@ replace github.com/lf-edge/eve/api/go => ../../api/go
 
 replace github.com/lf-edge/eve/libs/zedUpload => ../../libs/zedUpload
 
+replace github.com/lf-edge/eve/libs/depgraph => ../../libs/depgraph
+
+replace github.com/lf-edge/eve/libs/reconciler => ../../libs/reconciler
+
 replace git.apache.org/thrift.git => github.com/apache/thrift v0.12.0
 
 // because containerd
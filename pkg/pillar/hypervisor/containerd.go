@@ -87,6 +87,11 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	if err != nil {
 		return logError("setting up OCI spec for domain %s failed %v", status.DomainName, err)
 	}
+	spec.UpdateCPUs(config.CPUs)
+	spec.UpdateContainerResources(&config)
+	if err := spec.UpdateDevices(containerDevices(config, aa)); err != nil {
+		return logError("passing devices to domain %s failed %v", status.DomainName, err)
+	}
 
 	vifsTaskResolv := filepath.Join(vifsDir, status.DomainName, "etc", "resolv.conf")
 	err = os.MkdirAll(filepath.Dir(vifsTaskResolv), 0755)
@@ -113,6 +118,27 @@ func (ctx ctrdContext) Setup(status types.DomainStatus, config types.DomainConfi
 	return nil
 }
 
+// containerDevices returns the device nodes of the adapters assigned
+// to the domain which can be passed through to a container.
+// Only serial ports are supported for now.
+func containerDevices(config types.DomainConfig, aa *types.AssignableAdapters) []string {
+	var devices []string
+	if aa == nil {
+		return devices
+	}
+	for _, adapter := range config.IoAdapterList {
+		for _, ib := range aa.LookupIoBundleAny(adapter.Name) {
+			if ib == nil || ib.UsedByUUID != config.UUIDandVersion.UUID {
+				continue
+			}
+			if ib.Serial != "" {
+				devices = addNoDuplicate(devices, ib.Serial)
+			}
+		}
+	}
+	return devices
+}
+
 func (ctx ctrdContext) Create(domainName string, cfgFilename string, config *types.DomainConfig) (int, error) {
 	// if we are here we may need to get rid of the wedged, stale task just in case
 	// we are ignoring error here since it is cheaper to always call this as opposed
@@ -226,6 +252,56 @@ func (ctx ctrdContext) PCISameController(id1 string, id2 string) bool {
 	return types.PCISameController(id1, id2)
 }
 
+// Snapshot is not supported for containers
+func (ctx ctrdContext) Snapshot(domainName string, snapshotName string) error {
+	return fmt.Errorf("snapshot of domain %s is not supported", domainName)
+}
+
+// Restore is not supported for containers
+func (ctx ctrdContext) Restore(domainName string, snapshotName string) error {
+	return fmt.Errorf("restore of domain %s is not supported", domainName)
+}
+
+// DeleteSnapshot is not supported for containers
+func (ctx ctrdContext) DeleteSnapshot(domainName string, snapshotName string) error {
+	return fmt.Errorf("snapshots of domain %s are not supported", domainName)
+}
+
+// PinCPUs updates the cpuset cgroup of the running task
+func (ctx ctrdContext) PinCPUs(domainName string, cpus string, exclusive bool) ([]int, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	if err := ctx.ctrdClient.CtrUpdateTaskCPUs(ctrdCtx, domainName, cpus); err != nil {
+		return nil, logError("failed to pin domain %s to CPUs %s: %v", domainName, cpus, err)
+	}
+	return nil, nil
+}
+
+// Exec runs the command in the task and returns its combined output
+func (ctx ctrdContext) Exec(domainName string, args []string, timeout time.Duration) (string, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	stdOut, stdErr, err := ctx.ctrdClient.CtrExecWithTimeout(ctrdCtx, domainName, args, timeout)
+	return stdOut + stdErr, err
+}
+
+// ContainerStats returns the cgroup usage of the task
+func (ctx ctrdContext) ContainerStats(domainName string) (*types.AppContainerStats, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	metric, err := ctx.ctrdClient.CtrGetContainerMetrics(ctrdCtx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	_, _, status, err := ctx.ctrdClient.CtrContainerInfo(ctrdCtx, domainName)
+	if err != nil {
+		return nil, err
+	}
+	stats := containerd.ContainerStats(domainName, metric)
+	stats.Status = status
+	return &stats, nil
+}
+
 func (ctx ctrdContext) GetHostCPUMem() (types.HostMemory, error) {
 	return selfDomCPUMem()
 }
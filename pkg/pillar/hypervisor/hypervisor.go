@@ -25,6 +25,13 @@ type Hypervisor interface {
 	GetDomsCPUMem() (map[string]types.DomainMetric, error)
 
 	GetCapabilities() (*types.Capabilities, error)
+
+	// Snapshot saves the memory and disk state of a running domain
+	// under snapshotName replacing any snapshot with the same name.
+	// Restore reverts a running domain to the snapshot.
+	Snapshot(domainName string, snapshotName string) error
+	Restore(domainName string, snapshotName string) error
+	DeleteSnapshot(domainName string, snapshotName string) error
 }
 
 type hypervisorDesc struct {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
 	"runtime"
 	"strings"
 	"text/template"
@@ -17,6 +18,7 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/containerd"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 //TBD: Have a better way to calculate this number.
@@ -461,6 +463,10 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 		// we need to set product_name to support cloud-init
 		dmArgs = append(dmArgs, "-smbios", "type=1,product=OpenStack Compute")
 	}
+	if config.MetaDataType == types.MetaDataEC2 {
+		// cloud-init identifies the Ec2 datasource using the manufacturer
+		dmArgs = append(dmArgs, "-smbios", "type=1,manufacturer=Amazon EC2")
+	}
 
 	os.MkdirAll(kvmStateDir+domainName, 0777)
 
@@ -478,6 +484,7 @@ func (ctx kvmContext) Setup(status types.DomainStatus, config types.DomainConfig
 	if err = spec.AddLoader("/containers/services/xen-tools"); err != nil {
 		return logError("failed to add kvm hypervisor loader to domain %s: %v", status.DomainName, err)
 	}
+	spec.UpdateCPUs(config.CPUs)
 
 	/* 2.5 % of total memory */
 	qemuOverHead := int64(config.Memory) * 1024 * 25 / 1000
@@ -807,6 +814,107 @@ func (ctx kvmContext) Cleanup(domainName string) error {
 	return nil
 }
 
+// Exec is not supported since the task runs qemu and not the domain
+func (ctx kvmContext) Exec(domainName string, args []string, timeout time.Duration) (string, error) {
+	return "", fmt.Errorf("exec in domain %s is not supported by kvm", domainName)
+}
+
+// ContainerStats is not supported since the task runs qemu and not the domain
+func (ctx kvmContext) ContainerStats(domainName string) (*types.AppContainerStats, error) {
+	return nil, fmt.Errorf("container stats for domain %s are not supported by kvm", domainName)
+}
+
+// Matches snapshot names which can be passed to savevm/loadvm/delvm
+var kvmSnapshotNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+func checkSnapshotName(snapshotName string) error {
+	if !kvmSnapshotNameRegexp.MatchString(snapshotName) {
+		return fmt.Errorf("invalid snapshot name %q", snapshotName)
+	}
+	return nil
+}
+
+// Snapshot saves the memory and disk state of the domain using savevm.
+// This requires all writable disks to be in qcow2 format.
+func (ctx kvmContext) Snapshot(domainName string, snapshotName string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	logrus.Infof("taking snapshot %s of KVM domain %s", snapshotName, domainName)
+	if err := execSaveVM(getQmpExecutorSocket(domainName), snapshotName); err != nil {
+		return logError("failed to save domain %s to snapshot %s: %v", domainName, snapshotName, err)
+	}
+	return nil
+}
+
+// Restore reverts the domain to the snapshot using loadvm. The domain
+// keeps running (or stays paused) after the restore.
+func (ctx kvmContext) Restore(domainName string, snapshotName string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	logrus.Infof("restoring KVM domain %s to snapshot %s", domainName, snapshotName)
+	if err := execLoadVM(getQmpExecutorSocket(domainName), snapshotName); err != nil {
+		return logError("failed to restore domain %s to snapshot %s: %v", domainName, snapshotName, err)
+	}
+	return nil
+}
+
+// DeleteSnapshot removes the snapshot from all disks using delvm
+func (ctx kvmContext) DeleteSnapshot(domainName string, snapshotName string) error {
+	if err := checkSnapshotName(snapshotName); err != nil {
+		return err
+	}
+	if err := execDelVM(getQmpExecutorSocket(domainName), snapshotName); err != nil {
+		return logError("failed to delete snapshot %s of domain %s: %v", snapshotName, domainName, err)
+	}
+	return nil
+}
+
+// PinCPUs restricts qemu to the CPUs using the cpuset cgroup. For
+// exclusive CPUs, if there are enough of them, each vCPU thread is pinned
+// to its own CPU; otherwise the affinity of each vCPU thread is set to all
+// of the CPUs so that it floats over them.
+func (ctx kvmContext) PinCPUs(domainName string, cpus string, exclusive bool) ([]int, error) {
+	if _, err := ctx.ctrdContext.PinCPUs(domainName, cpus, exclusive); err != nil {
+		return nil, err
+	}
+	cpuList, err := types.ParseCPUList(cpus)
+	if err != nil {
+		return nil, err
+	}
+	threads, err := getVCPUThreadIDs(getQmpExecutorSocket(domainName))
+	if err != nil {
+		return nil, logError("failed to get vCPU threads of domain %s: %v", domainName, err)
+	}
+	if !exclusive || len(cpuList) < len(threads) {
+		// Undo any earlier one to one pinning
+		var set unix.CPUSet
+		for _, cpu := range cpuList {
+			set.Set(cpu)
+		}
+		for i, tid := range threads {
+			if err := unix.SchedSetaffinity(tid, &set); err != nil {
+				return nil, logError("failed to set affinity of vCPU %d of domain %s to CPUs %s: %v",
+					i, domainName, cpus, err)
+			}
+		}
+		return nil, nil
+	}
+	vcpus := make([]int, len(threads))
+	for i, tid := range threads {
+		var set unix.CPUSet
+		set.Set(cpuList[i])
+		if err := unix.SchedSetaffinity(tid, &set); err != nil {
+			return nil, logError("failed to pin vCPU %d of domain %s to CPU %d: %v",
+				i, domainName, cpuList[i], err)
+		}
+		vcpus[i] = cpuList[i]
+	}
+	logrus.Infof("pinned vCPUs of domain %s to CPUs %v", domainName, vcpus)
+	return vcpus, nil
+}
+
 func (ctx kvmContext) PCIReserve(long string) error {
 	logrus.Infof("PCIReserve long addr is %s", long)
 
@@ -8,14 +8,17 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/sirupsen/logrus"
 )
 
 type domState struct {
-	id     int
-	config string
-	state  types.SwState
+	id        int
+	config    string
+	state     types.SwState
+	snapshots map[string]types.SwState
+	cpus      string
 }
 
 type nullContext struct {
@@ -82,7 +85,8 @@ func (ctx nullContext) Create(domainName string, cfgFilename string, config *typ
 
 	// calls to Create are serialized in the consumer: no need to worry about locking
 	ctx.domCounter++
-	ctx.doms[domainName] = &domState{id: ctx.domCounter, config: string(configContent), state: types.HALTED}
+	ctx.doms[domainName] = &domState{id: ctx.domCounter, config: string(configContent), state: types.HALTED,
+		snapshots: map[string]types.SwState{}}
 
 	return ctx.domCounter, nil
 }
@@ -127,6 +131,40 @@ func (ctx nullContext) Info(domainName string) (int, types.SwState, error) {
 	}
 }
 
+// Exec succeeds for any running domain
+func (ctx nullContext) Exec(domainName string, args []string, timeout time.Duration) (string, error) {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return "", fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if dom.state != types.RUNNING {
+		return "", fmt.Errorf("null domain %s is not running", domainName)
+	}
+	return "", nil
+}
+
+// ContainerStats returns empty stats for any running domain
+func (ctx nullContext) ContainerStats(domainName string) (*types.AppContainerStats, error) {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return nil, fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if dom.state != types.RUNNING {
+		return nil, fmt.Errorf("null domain %s is not running", domainName)
+	}
+	return &types.AppContainerStats{ContainerName: domainName, Status: "running"}, nil
+}
+
+// PinCPUs records the CPUs of the domain
+func (ctx nullContext) PinCPUs(domainName string, cpus string, exclusive bool) ([]int, error) {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return nil, fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	dom.cpus = cpus
+	return nil, nil
+}
+
 func (ctx nullContext) PCIReserve(long string) error {
 	if ctx.PCI[long] {
 		return fmt.Errorf("PCI %s is already reserved", long)
@@ -149,6 +187,43 @@ func (ctx nullContext) PCISameController(id1 string, id2 string) bool {
 	return types.PCISameController(id1, id2)
 }
 
+// Snapshot records the state of the running null domain
+func (ctx nullContext) Snapshot(domainName string, snapshotName string) error {
+	if dom, found := ctx.doms[domainName]; found && dom.state == types.RUNNING {
+		dom.snapshots[snapshotName] = dom.state
+		return nil
+	} else {
+		return fmt.Errorf("null domain %s doesn't exist or is not running", domainName)
+	}
+}
+
+// Restore returns the null domain to the recorded state
+func (ctx nullContext) Restore(domainName string, snapshotName string) error {
+	dom, found := ctx.doms[domainName]
+	if !found || dom.state != types.RUNNING {
+		return fmt.Errorf("null domain %s doesn't exist or is not running", domainName)
+	}
+	if state, found := dom.snapshots[snapshotName]; found {
+		dom.state = state
+		return nil
+	} else {
+		return fmt.Errorf("null domain %s has no snapshot %s", domainName, snapshotName)
+	}
+}
+
+// DeleteSnapshot forgets the recorded state
+func (ctx nullContext) DeleteSnapshot(domainName string, snapshotName string) error {
+	dom, found := ctx.doms[domainName]
+	if !found {
+		return fmt.Errorf("null domain %s doesn't exist", domainName)
+	}
+	if _, found := dom.snapshots[snapshotName]; !found {
+		return fmt.Errorf("null domain %s has no snapshot %s", domainName, snapshotName)
+	}
+	delete(dom.snapshots, snapshotName)
+	return nil
+}
+
 func (ctx nullContext) GetHostCPUMem() (types.HostMemory, error) {
 	return selfDomCPUMem()
 }
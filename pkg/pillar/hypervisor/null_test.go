@@ -118,3 +118,39 @@ serial = ['pty']
 		t.Errorf("Delete domain failed %v", err)
 	}
 }
+
+func TestNullSnapshot(t *testing.T) {
+	conf, err := ioutil.TempFile("", "config")
+	if err != nil {
+		t.Fatalf("Can't create config file for a domain %v", err)
+	}
+	defer os.Remove(conf.Name())
+	conf.Close()
+
+	if _, err := hyper.Task(testDom).Create("test.snap", conf.Name(), &types.DomainConfig{}); err != nil {
+		t.Fatalf("Create domain test failed %v", err)
+	}
+	defer hyper.Task(testDom).Delete("test.snap")
+
+	if err := hyper.Snapshot("test.snap", "snap1"); err == nil {
+		t.Errorf("Snapshot should've failed for a domain that is not running")
+	}
+	if err := hyper.Task(testDom).Start("test.snap"); err != nil {
+		t.Fatalf("Couldn't start a domain %v", err)
+	}
+	if err := hyper.Snapshot("test.snap", "snap1"); err != nil {
+		t.Errorf("Snapshot failed %v", err)
+	}
+	if err := hyper.Restore("test.snap", "snap2"); err == nil {
+		t.Errorf("Restore should've failed for a non-existent snapshot")
+	}
+	if err := hyper.Restore("test.snap", "snap1"); err != nil {
+		t.Errorf("Restore failed %v", err)
+	}
+	if err := hyper.DeleteSnapshot("test.snap", "snap1"); err != nil {
+		t.Errorf("DeleteSnapshot failed %v", err)
+	}
+	if err := hyper.Restore("test.snap", "snap1"); err == nil {
+		t.Errorf("Restore should've failed for a deleted snapshot")
+	}
+}
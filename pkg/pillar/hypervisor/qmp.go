@@ -6,6 +6,7 @@ import (
 	"github.com/digitalocean/go-qemu/qmp"
 	"github.com/sirupsen/logrus"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -66,6 +67,72 @@ func execVNCPassword(socket string, password string) error {
 	return err
 }
 
+// execHumanMonitorCmd runs an HMP command such as savevm for which there
+// is no QMP equivalent in all qemu versions. HMP reports errors as text.
+func execHumanMonitorCmd(socket string, cmdLine string) error {
+	cmd, err := json.Marshal(map[string]interface{}{
+		"execute":   "human-monitor-command",
+		"arguments": map[string]string{"command-line": cmdLine},
+	})
+	if err != nil {
+		return err
+	}
+	raw, err := execRawCmd(socket, string(cmd))
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Return string `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return err
+	}
+	if output := strings.TrimSpace(result.Return); output != "" {
+		return fmt.Errorf("%s: %s", cmdLine, output)
+	}
+	return nil
+}
+
+// execSaveVM saves the memory and device state and takes internal
+// snapshots of all the writable (qcow2) disks
+func execSaveVM(socket string, name string) error {
+	return execHumanMonitorCmd(socket, "savevm "+name)
+}
+
+func execLoadVM(socket string, name string) error {
+	return execHumanMonitorCmd(socket, "loadvm "+name)
+}
+
+func execDelVM(socket string, name string) error {
+	return execHumanMonitorCmd(socket, "delvm "+name)
+}
+
+// getVCPUThreadIDs returns the host thread ids of the vCPUs ordered by
+// vCPU index
+func getVCPUThreadIDs(socket string) ([]int, error) {
+	raw, err := execRawCmd(socket, `{ "execute": "query-cpus-fast" }`)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		Return []struct {
+			CPUIndex int `json:"cpu-index"`
+			ThreadID int `json:"thread-id"`
+		} `json:"return"`
+	}
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return nil, err
+	}
+	threads := make([]int, len(result.Return))
+	for _, cpu := range result.Return {
+		if cpu.CPUIndex < 0 || cpu.CPUIndex >= len(threads) {
+			return nil, fmt.Errorf("unexpected cpu-index %d", cpu.CPUIndex)
+		}
+		threads[cpu.CPUIndex] = cpu.ThreadID
+	}
+	return threads, nil
+}
+
 func getQemuStatus(socket string) (string, error) {
 	if raw, err := execRawCmd(socket, `{ "execute": "query-status" }`); err == nil {
 		var result struct {
@@ -15,6 +15,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -487,6 +488,19 @@ func (ctx xenContext) Stop(domainName string, force bool) error {
 	return nil
 }
 
+// PinCPUs pins all the vCPUs of the domain to the physical CPUs
+func (ctx xenContext) PinCPUs(domainName string, cpus string, exclusive bool) ([]int, error) {
+	ctrdCtx, done := ctx.ctrdClient.CtrNewUserServicesCtx()
+	defer done()
+	stdOut, stdErr, err := ctx.ctrdClient.CtrExec(ctrdCtx, domainName,
+		[]string{"xl", "vcpu-pin", domainName, "all", cpus})
+	if err != nil {
+		return nil, logError("xl vcpu-pin %s %s failed: %s %s %v",
+			domainName, cpus, stdOut, stdErr, err)
+	}
+	return nil, nil
+}
+
 func (ctx xenContext) Delete(domainName string) (result error) {
 	// regardless of happens to everything else, we have to try and delete the task
 	defer func() {
@@ -519,6 +533,16 @@ func (ctx xenContext) Cleanup(domainName string) error {
 	return nil
 }
 
+// Exec is not supported since the task runs the xen tools and not the domain
+func (ctx xenContext) Exec(domainName string, args []string, timeout time.Duration) (string, error) {
+	return "", fmt.Errorf("exec in domain %s is not supported by xen", domainName)
+}
+
+// ContainerStats is not supported since the task runs the xen tools and not the domain
+func (ctx xenContext) ContainerStats(domainName string) (*types.AppContainerStats, error) {
+	return nil, fmt.Errorf("container stats for domain %s are not supported by xen", domainName)
+}
+
 func (ctx xenContext) Info(domainName string) (int, types.SwState, error) {
 	// first we ask for the task status
 	effectiveDomainID, effectiveDomainState, err := ctx.ctrdContext.Info(domainName)
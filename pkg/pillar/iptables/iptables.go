@@ -82,6 +82,37 @@ func Ip6tableCmd(log *base.LogObject, args ...string) error {
 	return err
 }
 
+// EbtablesCmd logs the command string if log is set
+func EbtablesCmd(log *base.LogObject, args ...string) error {
+	cmd := "ebtables"
+	var out []byte
+	var err error
+	// wait for the lock like iptables -w
+	args = append([]string{"--concurrent"}, args...)
+	if log != nil {
+		log.Functionf("Calling command %s %v\n", cmd, args)
+		out, err = base.Exec(log, cmd, args...).CombinedOutput()
+	} else {
+		out, err = base.Exec(log, cmd, args...).Output()
+	}
+	if err != nil {
+		errStr := fmt.Sprintf("ebtables command %s failed %s output %s",
+			args, err, out)
+		if log != nil {
+			log.Errorln(errStr)
+		}
+		return errors.New(errStr)
+	}
+	return nil
+}
+
+// VlanZonesChain is the chain of the raw table which puts the flows of each
+// VLAN of the switch network instances into their own conntrack zone.
+// The frames are marked with their VLAN by ebtables before they reach
+// iptables. The chain is the first rule of PREROUTING, the ACL rules which
+// are inserted there go below it.
+const VlanZonesChain = "vlan-zones"
+
 func IptablesInit(log *base.LogObject) {
 	// Avoid adding nat rule multiple times as we restart by flushing first
 	IptableCmd(log, "-t", "nat", "-F", "POSTROUTING")
@@ -90,6 +121,22 @@ func IptablesInit(log *base.LogObject) {
 	Ip6tableCmd(log, "-F", "PREROUTING", "-t", "mangle")
 
 	IptableCmd(log, "-F", "POSTROUTING", "-t", "mangle")
+
+	// The zone is the VLAN ID in the mark, which is then cleared since
+	// the mark is used by the ACLs
+	for _, cmd := range []func(*base.LogObject, ...string) error{
+		IptableCmd, Ip6tableCmd} {
+		// The chain may not exist, do not log failures
+		cmd(nil, "-t", "raw", "-D", "PREROUTING", "-j", VlanZonesChain)
+		cmd(nil, "-t", "raw", "-F", VlanZonesChain)
+		cmd(nil, "-t", "raw", "-X", VlanZonesChain)
+		cmd(log, "-t", "raw", "-N", VlanZonesChain)
+		cmd(log, "-t", "raw", "-A", VlanZonesChain, "-m", "mark", "!",
+			"--mark", "0", "-j", "CT", "--zone", "mark")
+		cmd(log, "-t", "raw", "-A", VlanZonesChain, "-m", "mark", "!",
+			"--mark", "0", "-j", "MARK", "--set-mark", "0")
+		cmd(log, "-t", "raw", "-I", "PREROUTING", "1", "-j", VlanZonesChain)
+	}
 }
 
 func FetchIprulesCounters(log *base.LogObject) []AclCounters {
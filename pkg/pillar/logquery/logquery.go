@@ -0,0 +1,323 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package logquery filters the device and app logs kept by newlogd under
+// types.NewlogDir, both the gzip files and the temporary files which are
+// still being written. newlogd serves queries on SocketPath; Run can also
+// be used to read the files directly when newlogd is not running.
+package logquery
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/api/go/logs"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+// SocketPath is where newlogd listens for queries
+const SocketPath = "/run/newlogd/query.sock"
+
+// AllApps as Query.AppUUID selects the logs of all apps
+const AllApps = "all"
+
+// Query selects log entries. Zero values do not filter.
+type Query struct {
+	Start   time.Time
+	End     time.Time
+	Sources []string // e.g. zedagent, kernel
+	// Level is the least severe level returned, e.g. warning
+	Level string
+	// AppUUID selects the logs of the app, or of all apps if AllApps,
+	// instead of the device logs
+	AppUUID string
+	Regex   string // matched against the content
+	Limit   int    // maximum number of entries
+}
+
+// Result is one line of the response to a query. The last line has
+// Error set if the query failed.
+type Result struct {
+	AppUUID string         `json:",omitempty"`
+	Entry   *logs.LogEntry `json:",omitempty"`
+	Error   string         `json:",omitempty"`
+}
+
+// severities from the most severe; syslog and logrus names
+var severityRank = map[string]int{
+	"emerg":   0,
+	"panic":   0,
+	"alert":   1,
+	"crit":    2,
+	"fatal":   2,
+	"err":     3,
+	"error":   3,
+	"warning": 4,
+	"warn":    4,
+	"notice":  5,
+	"info":    6,
+	"debug":   7,
+	"trace":   8,
+}
+
+// unknown severities are treated as info
+const defaultRank = 6
+
+func rank(severity string) int {
+	if r, ok := severityRank[strings.ToLower(severity)]; ok {
+		return r
+	}
+	return defaultRank
+}
+
+// filter is a compiled Query
+type filter struct {
+	q       Query
+	re      *regexp.Regexp
+	maxRank int
+	sources map[string]bool
+}
+
+func newFilter(q Query) (*filter, error) {
+	f := &filter{q: q, maxRank: -1}
+	if q.Regex != "" {
+		re, err := regexp.Compile(q.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("bad regex: %v", err)
+		}
+		f.re = re
+	}
+	if q.Level != "" {
+		r, ok := severityRank[strings.ToLower(q.Level)]
+		if !ok {
+			return nil, fmt.Errorf("unknown level %s", q.Level)
+		}
+		f.maxRank = r
+	}
+	if len(q.Sources) != 0 {
+		f.sources = make(map[string]bool)
+		for _, s := range q.Sources {
+			f.sources[s] = true
+		}
+	}
+	return f, nil
+}
+
+func (f *filter) match(entry *logs.LogEntry) bool {
+	if !f.q.Start.IsZero() || !f.q.End.IsZero() {
+		if entry.Timestamp == nil {
+			return false
+		}
+		t := time.Unix(entry.Timestamp.Seconds, int64(entry.Timestamp.Nanos))
+		if !f.q.Start.IsZero() && t.Before(f.q.Start) {
+			return false
+		}
+		if !f.q.End.IsZero() && t.After(f.q.End) {
+			return false
+		}
+	}
+	if f.sources != nil && !f.sources[entry.Source] {
+		return false
+	}
+	if f.maxRank >= 0 && rank(entry.Severity) > f.maxRank {
+		return false
+	}
+	if f.re != nil && !f.re.MatchString(entry.Content) {
+		return false
+	}
+	return true
+}
+
+// logFile is a gzip or temporary log file
+type logFile struct {
+	path    string
+	appUUID string
+	time    time.Time // when the gzip file was written; mtime of a temp file
+	gzipped bool
+}
+
+// parseName returns whether the file is a device or app log file and the
+// app UUID and the time in the name of a gzip file.
+// dev.log.<msec>.gz, app.[skipTx.]<uuid>.log.<msec>.gz
+// dev.log.<random>, app.<uuid>.log<random>
+func parseName(name string) (ok bool, appUUID string, msec int64) {
+	var rest string
+	switch {
+	case strings.HasPrefix(name, types.DevPrefix):
+		rest = strings.TrimPrefix(name, types.DevPrefix)
+	case strings.HasPrefix(name, types.AppPrefix):
+		rest = strings.TrimPrefix(name, types.AppPrefix)
+		rest = strings.TrimPrefix(rest, "skipTx.")
+		i := strings.Index(rest, ".log")
+		if i <= 0 {
+			return false, "", 0
+		}
+		appUUID = rest[:i]
+		rest = strings.TrimPrefix(rest[i+len(".log"):], ".")
+	default:
+		return false, "", 0
+	}
+	if strings.HasSuffix(rest, ".gz") {
+		msec, _ = strconv.ParseInt(strings.TrimSuffix(rest, ".gz"), 10, 64)
+	}
+	return true, appUUID, msec
+}
+
+func (f *filter) wantApp(appUUID string) bool {
+	switch f.q.AppUUID {
+	case "":
+		return appUUID == ""
+	case AllApps:
+		return appUUID != ""
+	default:
+		return appUUID == f.q.AppUUID
+	}
+}
+
+// files returns the log files under dir which can have matching entries,
+// the gzip files ordered by time followed by the temporary files
+func (f *filter) files(dir string) ([]logFile, error) {
+	var gzipFiles, tmpFiles []logFile
+	gzipDirs := []string{
+		filepath.Base(types.NewlogKeepSentQueueDir),
+		"failedUpload",
+		filepath.Base(types.NewlogUploadDevDir),
+		filepath.Base(types.NewlogUploadAppDir),
+	}
+	for _, d := range gzipDirs {
+		infos, err := ioutil.ReadDir(filepath.Join(dir, d))
+		if err != nil {
+			continue
+		}
+		for _, info := range infos {
+			ok, appUUID, msec := parseName(info.Name())
+			if !ok || msec == 0 || !f.wantApp(appUUID) {
+				continue
+			}
+			t := time.Unix(0, msec*int64(time.Millisecond))
+			// all the entries were logged before the file was written
+			if !f.q.Start.IsZero() && t.Before(f.q.Start) {
+				continue
+			}
+			gzipFiles = append(gzipFiles, logFile{
+				path:    filepath.Join(dir, d, info.Name()),
+				appUUID: appUUID,
+				time:    t,
+				gzipped: true,
+			})
+		}
+	}
+	infos, err := ioutil.ReadDir(filepath.Join(dir, filepath.Base(types.NewlogCollectDir)))
+	if err != nil && len(gzipFiles) == 0 {
+		return nil, err
+	}
+	for _, info := range infos {
+		if !info.Mode().IsRegular() {
+			// e.g. the current.device.log symlink
+			continue
+		}
+		ok, appUUID, msec := parseName(info.Name())
+		if !ok || msec != 0 || !f.wantApp(appUUID) {
+			continue
+		}
+		if !f.q.Start.IsZero() && info.ModTime().Before(f.q.Start) {
+			continue
+		}
+		tmpFiles = append(tmpFiles, logFile{
+			path:    filepath.Join(dir, filepath.Base(types.NewlogCollectDir), info.Name()),
+			appUUID: appUUID,
+			time:    info.ModTime(),
+		})
+	}
+	sort.SliceStable(gzipFiles, func(i, j int) bool {
+		return gzipFiles[i].time.Before(gzipFiles[j].time)
+	})
+	sort.SliceStable(tmpFiles, func(i, j int) bool {
+		return tmpFiles[i].time.Before(tmpFiles[j].time)
+	})
+	return append(gzipFiles, tmpFiles...), nil
+}
+
+// errLimit stops the scan when Query.Limit entries were returned
+var errLimit = fmt.Errorf("limit reached")
+
+// scan calls emit for the matching entries in the file. A file which
+// was removed in the meantime, e.g. after being compressed, is skipped.
+func (f *filter) scan(lf logFile, count *int, emit func(Result) error) error {
+	fd, err := os.Open(lf.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer fd.Close()
+	var r io.Reader = fd
+	if lf.gzipped {
+		gr, err := gzip.NewReader(fd)
+		if err != nil {
+			return fmt.Errorf("%s: %v", lf.path, err)
+		}
+		defer gr.Close()
+		r = gr
+	}
+	br := bufio.NewReader(r)
+	// the first line of a temporary file is the metadata
+	first := !lf.gzipped
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 && !first {
+			var entry logs.LogEntry
+			if json.Unmarshal(line, &entry) == nil && f.match(&entry) {
+				if err := emit(Result{AppUUID: lf.appUUID, Entry: &entry}); err != nil {
+					return err
+				}
+				*count++
+				if f.q.Limit > 0 && *count >= f.q.Limit {
+					return errLimit
+				}
+			}
+		}
+		first = false
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", lf.path, err)
+		}
+	}
+}
+
+// Run calls emit for the entries under dir, normally types.NewlogDir,
+// which match the query. It stops at the first error returned by emit.
+func Run(q Query, dir string, emit func(Result) error) error {
+	f, err := newFilter(q)
+	if err != nil {
+		return err
+	}
+	files, err := f.files(dir)
+	if err != nil {
+		return err
+	}
+	count := 0
+	for _, lf := range files {
+		if err := f.scan(lf, &count, emit); err != nil {
+			if err == errLimit {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logquery
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/lf-edge/eve/api/go/logs"
+)
+
+const testApp = "f7cd0a1e-1c1e-4c51-9b9d-1b5d6a4ef7d1"
+
+var baseTime = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func testEntry(sec int, severity, source, content string) string {
+	entry := logs.LogEntry{
+		Severity:  severity,
+		Source:    source,
+		Content:   content,
+		Timestamp: &timestamp.Timestamp{Seconds: baseTime.Unix() + int64(sec)},
+	}
+	b, _ := json.Marshal(&entry)
+	return string(b) + "\n"
+}
+
+func writeGzip(t *testing.T, name string, lines ...string) {
+	fd, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gw := gzip.NewWriter(fd)
+	for _, l := range lines {
+		if _, err := gw.Write([]byte(l)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := gw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fd.Close()
+}
+
+// newlogDir creates the files of newlogd with device logs at seconds
+// 0-3 in gzip files, 4-5 in a temporary file and app logs at 10 and 11
+func newlogDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "logquery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range []string{"collect", "devUpload", "appUpload", "keepSentQueue", "failedUpload"} {
+		if err := os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	msec := func(sec int) int64 {
+		return baseTime.Add(time.Duration(sec)*time.Second).UnixNano() / int64(time.Millisecond)
+	}
+	writeGzip(t, filepath.Join(dir, "keepSentQueue", fmt.Sprintf("dev.log.%d.gz", msec(2))),
+		testEntry(0, "info", "zedagent", "first"),
+		testEntry(1, "error", "nim", "port eth0 failed"))
+	writeGzip(t, filepath.Join(dir, "devUpload", fmt.Sprintf("dev.log.%d.gz", msec(4))),
+		testEntry(2, "warning", "zedagent", "slow"),
+		testEntry(3, "debug", "zedagent", "detail"))
+	writeGzip(t, filepath.Join(dir, "appUpload", fmt.Sprintf("app.%s.log.%d.gz", testApp, msec(11))),
+		testEntry(10, "info", "guest_vm-app1", "app started"))
+	tmp := "{\"devID\":\"x\"}\n" +
+		testEntry(4, "err", "kernel", "oops") +
+		testEntry(5, "info", "zedagent", "last")
+	if err := ioutil.WriteFile(filepath.Join(dir, "collect", "dev.log.123456"), []byte(tmp), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tmp = "app1\n" + testEntry(11, "info", "guest_vm-app1", "app running")
+	if err := ioutil.WriteFile(filepath.Join(dir, "collect", "app."+testApp+".log654321"), []byte(tmp), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("dev.log.123456", filepath.Join(dir, "collect", "current.device.log")); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func contents(t *testing.T, q Query, dir string) []string {
+	var got []string
+	err := Run(q, dir, func(r Result) error {
+		got = append(got, r.Entry.Content)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestRun(t *testing.T) {
+	dir := newlogDir(t)
+	defer os.RemoveAll(dir)
+
+	testMatrix := map[string]struct {
+		q        Query
+		expected []string
+	}{
+		"all device logs": {
+			expected: []string{"first", "port eth0 failed", "slow", "detail", "oops", "last"},
+		},
+		"time range": {
+			q: Query{
+				Start: baseTime.Add(2 * time.Second),
+				End:   baseTime.Add(4 * time.Second),
+			},
+			expected: []string{"slow", "detail", "oops"},
+		},
+		"source": {
+			q:        Query{Sources: []string{"nim", "kernel"}},
+			expected: []string{"port eth0 failed", "oops"},
+		},
+		"level": {
+			q:        Query{Level: "warning"},
+			expected: []string{"port eth0 failed", "slow", "oops"},
+		},
+		"regex": {
+			q:        Query{Regex: "^(first|last)$"},
+			expected: []string{"first", "last"},
+		},
+		"limit": {
+			q:        Query{Limit: 2},
+			expected: []string{"first", "port eth0 failed"},
+		},
+		"app": {
+			q:        Query{AppUUID: testApp},
+			expected: []string{"app started", "app running"},
+		},
+		"all apps": {
+			q:        Query{AppUUID: AllApps, Regex: "running"},
+			expected: []string{"app running"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Run(testname, func(t *testing.T) {
+			got := contents(t, test.q, dir)
+			if fmt.Sprint(got) != fmt.Sprint(test.expected) {
+				t.Errorf("got %v, expected %v", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestBadQuery(t *testing.T) {
+	dir := newlogDir(t)
+	defer os.RemoveAll(dir)
+	for _, q := range []Query{{Regex: "("}, {Level: "loud"}} {
+		err := Run(q, dir, func(r Result) error { return nil })
+		if err == nil {
+			t.Errorf("expected an error for %+v", q)
+		}
+	}
+}
+
+func TestSocket(t *testing.T) {
+	dir := newlogDir(t)
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "query.sock")
+	listener, err := Listen(socketPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				_ = Serve(conn, dir)
+			}(conn)
+		}
+	}()
+
+	var got []string
+	err = Send(socketPath, Query{AppUUID: testApp}, func(r Result) error {
+		if r.AppUUID != testApp {
+			t.Errorf("unexpected app %s", r.AppUUID)
+		}
+		got = append(got, r.Entry.Content)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 {
+		t.Errorf("unexpected entries %v", got)
+	}
+	err = Send(socketPath, Query{Regex: "("}, func(r Result) error { return nil })
+	if err == nil {
+		t.Errorf("expected the error of the query")
+	}
+}
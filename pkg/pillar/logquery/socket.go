@@ -0,0 +1,73 @@
+// Copyright (c) 2021 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package logquery
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+)
+
+// Serve reads one Query from conn and writes the Results as JSON lines
+func Serve(conn net.Conn, dir string) error {
+	defer conn.Close()
+	var q Query
+	if err := json.NewDecoder(conn).Decode(&q); err != nil {
+		return fmt.Errorf("bad query: %v", err)
+	}
+	w := bufio.NewWriter(conn)
+	enc := json.NewEncoder(w)
+	err := Run(q, dir, func(r Result) error {
+		return enc.Encode(r)
+	})
+	if err != nil {
+		// best effort; the client may be gone
+		_ = enc.Encode(Result{Error: err.Error()})
+	}
+	if ferr := w.Flush(); ferr != nil && err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// Listen removes a stale socket and listens on socketPath
+func Listen(socketPath string) (net.Listener, error) {
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return net.Listen("unix", socketPath)
+}
+
+// Send sends the query to the server on socketPath and calls handler
+// for each entry of the response
+func Send(socketPath string, q Query, handler func(Result) error) error {
+	conn, err := net.Dial("unix", socketPath)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := json.NewEncoder(conn).Encode(q); err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bufio.NewReader(conn))
+	for {
+		var r Result
+		if err := dec.Decode(&r); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if r.Error != "" {
+			return errors.New(r.Error)
+		}
+		if err := handler(r); err != nil {
+			return err
+		}
+	}
+}
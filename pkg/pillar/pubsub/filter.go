@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// FieldsChanged returns a SubChangeFilter which reports a change only if
+// any of the named fields differ between the old and new item.
+// Nested fields can be specified using a dot e.g. "TestResults.LastFailed".
+// A field which does not exist is treated as changed.
+func FieldsChanged(fields ...string) SubChangeFilter {
+	return func(key string, status interface{}, oldStatus interface{}) bool {
+		newVal := reflect.ValueOf(status)
+		oldVal := reflect.ValueOf(oldStatus)
+		for _, field := range fields {
+			newField, ok1 := fieldByPath(newVal, field)
+			oldField, ok2 := fieldByPath(oldVal, field)
+			if !ok1 || !ok2 {
+				return true
+			}
+			if !cmp.Equal(newField.Interface(), oldField.Interface()) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// fieldByPath looks up an exported (possibly nested) struct field
+func fieldByPath(val reflect.Value, path string) (reflect.Value, bool) {
+	for _, name := range strings.Split(path, ".") {
+		for val.Kind() == reflect.Ptr || val.Kind() == reflect.Interface {
+			if val.IsNil() {
+				return val, false
+			}
+			val = val.Elem()
+		}
+		if val.Kind() != reflect.Struct {
+			return val, false
+		}
+		val = val.FieldByName(name)
+		if !val.IsValid() || !val.CanInterface() {
+			return val, false
+		}
+	}
+	return val, true
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type filterItem struct {
+	Name  string
+	State int
+	Inner struct {
+		Count int
+	}
+}
+
+func TestSubscriptionFilters(t *testing.T) {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	ps := pubsub.New(&pubsub.EmptyDriver{}, logger, log)
+
+	_, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:  "agent",
+		TopicImpl:  filterItem{},
+		KeyPattern: "[",
+	})
+	assert.Error(t, err)
+
+	var created, modified, deleted []string
+	sub, err := ps.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName:    "agent",
+		TopicImpl:    filterItem{},
+		KeyPrefix:    "app-",
+		KeyPattern:   "^app-[0-9]+$",
+		ChangeFilter: pubsub.FieldsChanged("State", "Inner.Count"),
+		CreateHandler: func(ctx interface{}, key string, status interface{}) {
+			created = append(created, key)
+		},
+		ModifyHandler: func(ctx interface{}, key string, status interface{},
+			oldStatus interface{}) {
+			modified = append(modified, key)
+		},
+		DeleteHandler: func(ctx interface{}, key string, status interface{}) {
+			deleted = append(deleted, key)
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewSubscription failed: %s", err)
+	}
+	modify := func(key string, item filterItem) {
+		b, err := json.Marshal(item)
+		if err != nil {
+			t.Fatalf("json.Marshal failed: %s", err)
+		}
+		sub.ProcessChange(pubsub.Change{Operation: pubsub.Modify,
+			Key: key, Value: b})
+	}
+
+	modify("app-1", filterItem{Name: "a"})
+	modify("app-x", filterItem{Name: "b"})
+	modify("other-2", filterItem{Name: "c"})
+	assert.Equal(t, []string{"app-1"}, created)
+	assert.Equal(t, 1, len(sub.GetAll()))
+
+	// Name is not a watched field; the collection is still updated
+	modify("app-1", filterItem{Name: "changed"})
+	assert.Empty(t, modified)
+	item, err := sub.Get("app-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "changed", item.(filterItem).Name)
+
+	modify("app-1", filterItem{Name: "changed", State: 1})
+	next := filterItem{Name: "changed", State: 1}
+	next.Inner.Count = 2
+	modify("app-1", next)
+	assert.Equal(t, []string{"app-1", "app-1"}, modified)
+
+	sub.ProcessChange(pubsub.Change{Operation: pubsub.Delete, Key: "other-2"})
+	sub.ProcessChange(pubsub.Change{Operation: pubsub.Delete, Key: "app-1"})
+	assert.Equal(t, []string{"app-1"}, deleted)
+	assert.Empty(t, sub.GetAll())
+}
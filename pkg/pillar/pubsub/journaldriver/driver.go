@@ -0,0 +1,139 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package journaldriver provides a pubsub driver which wraps another driver
+// (usually the socketdriver) and records every Publish, Unpublish and
+// Restart into a rotating journal. The journal can later be replayed
+// into a PubSub to reconstruct the sequence of events seen by the agents.
+// Since the journal is not encrypted only the topics on an allow-list, see
+// DefaultTopics, are recorded.
+package journaldriver
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// DefaultTopics are the topics recorded by zedbox. These are statuses
+// without cipher blocks, credentials or cloud-init user data; the configs
+// from the controller, the DevicePortConfig with its WiFi credentials and
+// the NetworkInstanceStatus with the config of VPNs for example are not
+// recorded.
+var DefaultTopics = []string{
+	"AppInstanceStatus",
+	"AppNetworkStatus",
+	"BaseOsStatus",
+	"BlobStatus",
+	"ContentTreeStatus",
+	"DomainStatus",
+	"DownloaderStatus",
+	"NodeAgentStatus",
+	"VerifyImageStatus",
+	"VolumeStatus",
+	"ZbootStatus",
+	"ZedAgentStatus",
+}
+
+// JournalDriver driver for pubsub which records publications into a Journal
+type JournalDriver struct {
+	Driver  pubsub.Driver // The wrapped driver which does the actual work
+	Journal *Journal
+	Log     *base.LogObject
+	Topics  []string // The topics which are recorded
+}
+
+// Publisher return a `pubsub.DriverPublisher` which records the operations
+// before passing them to the wrapped driver
+func (d *JournalDriver) Publisher(global bool, name, topic string, persistent bool, updaterList *pubsub.Updaters, restarted pubsub.Restarted, differ pubsub.Differ) (pubsub.DriverPublisher, error) {
+	pub, err := d.Driver.Publisher(global, name, topic, persistent,
+		updaterList, restarted, differ)
+	if err != nil || !d.recorded(topic) {
+		return pub, err
+	}
+	return &Publisher{
+		DriverPublisher: pub,
+		journal:         d.Journal,
+		log:             d.Log,
+		global:          global,
+		name:            name,
+		topic:           topic,
+		persistent:      persistent,
+	}, nil
+}
+
+// Subscriber return the `pubsub.DriverSubscriber` of the wrapped driver
+func (d *JournalDriver) Subscriber(global bool, name, topic string, persistent bool, C chan pubsub.Change) (pubsub.DriverSubscriber, error) {
+	return d.Driver.Subscriber(global, name, topic, persistent, C)
+}
+
+func (d *JournalDriver) recorded(topic string) bool {
+	for _, t := range d.Topics {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// DefaultName default name for an agent when none is provided
+func (d *JournalDriver) DefaultName() string {
+	return d.Driver.DefaultName()
+}
+
+// Publisher wraps a `pubsub.DriverPublisher` and records the operations
+type Publisher struct {
+	pubsub.DriverPublisher
+	journal    *Journal
+	log        *base.LogObject
+	global     bool
+	name       string
+	topic      string
+	persistent bool
+}
+
+// Publish records the key and value and publishes them
+func (p *Publisher) Publish(key string, item []byte) error {
+	event := p.event(OpPublish)
+	event.Key = key
+	event.Value = item
+	p.record(event)
+	return p.DriverPublisher.Publish(key, item)
+}
+
+// Unpublish records the key and unpublishes it
+func (p *Publisher) Unpublish(key string) error {
+	event := p.event(OpUnpublish)
+	event.Key = key
+	p.record(event)
+	return p.DriverPublisher.Unpublish(key)
+}
+
+// Restart records the restartCounter and sets it
+func (p *Publisher) Restart(restartCounter int) error {
+	event := p.event(OpRestart)
+	event.RestartCounter = restartCounter
+	p.record(event)
+	return p.DriverPublisher.Restart(restartCounter)
+}
+
+func (p *Publisher) event(op Operation) Event {
+	return Event{
+		Time:       time.Now(),
+		Op:         op,
+		Agent:      strings.Split(p.name, "/")[0],
+		Name:       p.name,
+		Topic:      p.topic,
+		Global:     p.global,
+		Persistent: p.persistent,
+	}
+}
+
+// record failures are logged but do not affect the publication
+func (p *Publisher) record(event Event) {
+	if err := p.journal.Record(event); err != nil {
+		p.log.Errorf("journaldriver(%s): %v", p.name, err)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package journaldriver
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const journalFilename = "journal.log"
+
+// Operation recorded in the journal
+type Operation string
+
+const (
+	// OpPublish is a Publish of a key
+	OpPublish Operation = "publish"
+	// OpUnpublish is an Unpublish of a key
+	OpUnpublish Operation = "unpublish"
+	// OpRestart is a change of the restart counter
+	OpRestart Operation = "restart"
+)
+
+// Event is one recorded pubsub operation
+type Event struct {
+	Time           time.Time       `json:"time"`
+	Op             Operation       `json:"op"`
+	Agent          string          `json:"agent"`
+	Name           string          `json:"name"` // As passed to the driver
+	Topic          string          `json:"topic"`
+	Global         bool            `json:"global,omitempty"`
+	Persistent     bool            `json:"persistent,omitempty"`
+	Key            string          `json:"key,omitempty"`
+	Value          json.RawMessage `json:"value,omitempty"`
+	RestartCounter int             `json:"restartCounter,omitempty"`
+}
+
+// Journal is a rotating file of events with one json encoded event per line.
+// The current file is journal.log in the directory; when it reaches
+// maxFileSize it is renamed to journal.log.1 and older files are shifted,
+// keeping at most maxFiles rotated files.
+type Journal struct {
+	dirname     string
+	maxFileSize int64
+	maxFiles    int
+
+	lock sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewJournal opens (or creates) the journal in dirname
+func NewJournal(dirname string, maxFileSize int64, maxFiles int) (*Journal, error) {
+	if err := os.MkdirAll(dirname, 0700); err != nil {
+		return nil, fmt.Errorf("NewJournal(%s): %v", dirname, err)
+	}
+	j := &Journal{
+		dirname:     dirname,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}
+	if err := j.open(); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+func (j *Journal) open() error {
+	filename := filepath.Join(j.dirname, journalFilename)
+	file, err := os.OpenFile(filename,
+		os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("open(%s): %v", filename, err)
+	}
+	st, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("open(%s): %v", filename, err)
+	}
+	j.file = file
+	j.size = st.Size()
+	return nil
+}
+
+// rotate is called with lock held
+func (j *Journal) rotate() error {
+	if err := j.file.Close(); err != nil {
+		return err
+	}
+	current := filepath.Join(j.dirname, journalFilename)
+	for i := j.maxFiles; i > 0; i-- {
+		from := current
+		if i > 1 {
+			from = fmt.Sprintf("%s.%d", current, i-1)
+		}
+		to := fmt.Sprintf("%s.%d", current, i)
+		if _, err := os.Stat(from); err != nil {
+			continue
+		}
+		if err := os.Rename(from, to); err != nil {
+			return err
+		}
+	}
+	if j.maxFiles <= 0 {
+		os.Remove(current)
+	}
+	return j.open()
+}
+
+// Record appends the event to the journal
+func (j *Journal) Record(event Event) error {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("Record: %v", err)
+	}
+	b = append(b, '\n')
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.file == nil {
+		return fmt.Errorf("Record: journal %s is closed", j.dirname)
+	}
+	if j.size != 0 && j.size+int64(len(b)) > j.maxFileSize {
+		if err := j.rotate(); err != nil {
+			return fmt.Errorf("Record: rotate failed: %v", err)
+		}
+	}
+	n, err := j.file.Write(b)
+	j.size += int64(n)
+	return err
+}
+
+// Close the journal
+func (j *Journal) Close() error {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+// Files returns the journal files in dirname, the oldest first
+func Files(dirname string) ([]string, error) {
+	current := filepath.Join(dirname, journalFilename)
+	if _, err := os.Stat(current); err != nil {
+		return nil, err
+	}
+	files := []string{current}
+	for i := 1; ; i++ {
+		filename := fmt.Sprintf("%s.%d", current, i)
+		if _, err := os.Stat(filename); err != nil {
+			break
+		}
+		files = append([]string{filename}, files...)
+	}
+	return files, nil
+}
+
+// ReadEvents calls fn for each event read from r. Stops at the first
+// error returned by fn.
+func ReadEvents(r io.Reader, fn func(Event) error) error {
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, err := reader.ReadBytes('\n')
+		if len(line) != 0 {
+			var event Event
+			if jerr := json.Unmarshal(line, &event); jerr != nil {
+				if err == io.EOF {
+					// Truncated last line, e.g. due to a crash
+					return nil
+				}
+				return fmt.Errorf("line %d: %v", lineNum, jerr)
+			}
+			if ferr := fn(event); ferr != nil {
+				return ferr
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ReadFiles calls fn for each event in the files, in the given order
+func ReadFiles(files []string, fn func(Event) error) error {
+	for _, filename := range files {
+		f, err := os.Open(filename)
+		if err != nil {
+			return err
+		}
+		err = ReadEvents(f, fn)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %v", filename, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package journaldriver_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/journaldriver"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	FieldA string
+}
+
+type otherItem struct {
+	Secret string
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "journaldriver_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "test", 1234)
+	journalDir := filepath.Join(tmpDir, "journal")
+	journal, err := journaldriver.NewJournal(journalDir, 1024*1024, 2)
+	if err != nil {
+		t.Fatalf("NewJournal failed: %s", err)
+	}
+	driver := journaldriver.JournalDriver{
+		Driver: &socketdriver.SocketDriver{
+			Logger:  logger,
+			Log:     log,
+			RootDir: filepath.Join(tmpDir, "record"),
+		},
+		Journal: journal,
+		Log:     log,
+		Topics:  []string{"item"},
+	}
+	ps := pubsub.New(&driver, logger, log)
+	pub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: item{},
+	})
+	if err != nil {
+		t.Fatalf("NewPublication failed: %s", err)
+	}
+	assert.NoError(t, pub.Publish("key1", item{FieldA: "a"}))
+	assert.NoError(t, pub.Publish("key2", item{FieldA: "b"}))
+	assert.NoError(t, pub.Unpublish("key1"))
+	assert.NoError(t, pub.SignalRestarted())
+	pub.Close()
+	// Topics which are not on the list are not recorded
+	otherPub, err := ps.NewPublication(pubsub.PublicationOptions{
+		AgentName: "testagent",
+		TopicType: otherItem{},
+	})
+	if err != nil {
+		t.Fatalf("NewPublication failed: %s", err)
+	}
+	assert.NoError(t, otherPub.Publish("key1", otherItem{Secret: "s"}))
+	otherPub.Close()
+	journal.Close()
+
+	files, err := journaldriver.Files(journalDir)
+	assert.NoError(t, err)
+	var ops []journaldriver.Operation
+	err = journaldriver.ReadFiles(files, func(event journaldriver.Event) error {
+		assert.Equal(t, "testagent", event.Agent)
+		assert.Equal(t, "item", event.Topic)
+		ops = append(ops, event.Op)
+		return nil
+	})
+	assert.NoError(t, err)
+	// Close unpublishes key2 and clears restarted
+	assert.Equal(t, []journaldriver.Operation{
+		journaldriver.OpPublish, journaldriver.OpPublish,
+		journaldriver.OpUnpublish, journaldriver.OpRestart,
+		journaldriver.OpUnpublish, journaldriver.OpRestart,
+	}, ops)
+
+	// Replay all but the Close events into a new PubSub
+	replayPs := pubsub.New(
+		&socketdriver.SocketDriver{
+			Logger:  logger,
+			Log:     log,
+			RootDir: filepath.Join(tmpDir, "replay"),
+		},
+		logger, log)
+	replayer := journaldriver.NewReplayer(replayPs)
+	defer replayer.Close()
+	var replayed int
+	count, err := replayer.Replay(files, journaldriver.ReplayOptions{
+		Filter: func(event journaldriver.Event) bool {
+			replayed++
+			return replayed <= 4
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	sub, err := replayPs.NewSubscription(pubsub.SubscriptionOptions{
+		AgentName: "testagent",
+		TopicImpl: item{},
+		Activate:  true,
+	})
+	if err != nil {
+		t.Fatalf("NewSubscription failed: %s", err)
+	}
+	timeout := time.After(10 * time.Second)
+	for !sub.Synchronized() || !sub.Restarted() {
+		select {
+		case change := <-sub.MsgChan():
+			sub.ProcessChange(change)
+		case <-timeout:
+			t.Fatalf("Timeout waiting for replayed events")
+		}
+	}
+	items := sub.GetAll()
+	assert.Equal(t, 1, len(items))
+	assert.Equal(t, item{FieldA: "b"}, items["key2"])
+}
+
+func TestRotate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "journaldriver_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	journal, err := journaldriver.NewJournal(tmpDir, 200, 2)
+	if err != nil {
+		t.Fatalf("NewJournal failed: %s", err)
+	}
+	for i := 0; i < 10; i++ {
+		err := journal.Record(journaldriver.Event{
+			Op:    journaldriver.OpPublish,
+			Name:  "testagent/item",
+			Topic: "item",
+			Key:   "key",
+		})
+		assert.NoError(t, err)
+	}
+	journal.Close()
+	files, err := journaldriver.Files(tmpDir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(tmpDir, "journal.log.2"),
+		filepath.Join(tmpDir, "journal.log.1"),
+		filepath.Join(tmpDir, "journal.log"),
+	}, files)
+}
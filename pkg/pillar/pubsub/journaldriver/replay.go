@@ -0,0 +1,95 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package journaldriver
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+)
+
+// ReplayOptions control Replay
+type ReplayOptions struct {
+	// Speed scales the time between recorded events; 2 replays twice
+	// as fast as recorded. Zero replays without any delays.
+	Speed float64
+	// Filter selects the events to replay; nil replays all events
+	Filter func(Event) bool
+}
+
+// Replayer publishes recorded events into a PubSub
+type Replayer struct {
+	ps   *pubsub.PubSub
+	pubs map[string]*pubsub.RawPublication
+}
+
+// NewReplayer returns a Replayer which publishes into ps
+func NewReplayer(ps *pubsub.PubSub) *Replayer {
+	return &Replayer{
+		ps:   ps,
+		pubs: make(map[string]*pubsub.RawPublication),
+	}
+}
+
+// Apply publishes a single event
+func (r *Replayer) Apply(event Event) error {
+	pub, ok := r.pubs[event.Name]
+	if !ok {
+		var err error
+		pub, err = r.ps.NewRawPublication(event.Global, event.Name,
+			event.Topic, event.Persistent)
+		if err != nil {
+			return fmt.Errorf("Apply(%s): %v", event.Name, err)
+		}
+		r.pubs[event.Name] = pub
+	}
+	switch event.Op {
+	case OpPublish:
+		return pub.Publish(event.Key, event.Value)
+	case OpUnpublish:
+		// Errors are ignored since the key might have been published
+		// before the oldest journal file was written.
+		pub.Unpublish(event.Key)
+		return nil
+	case OpRestart:
+		return pub.Restart(event.RestartCounter)
+	default:
+		return fmt.Errorf("Apply(%s): unknown operation %s",
+			event.Name, event.Op)
+	}
+}
+
+// Close all publications created by the Replayer
+func (r *Replayer) Close() {
+	for name, pub := range r.pubs {
+		pub.Close()
+		delete(r.pubs, name)
+	}
+}
+
+// Replay publishes the events from the journal files into the Replayer.
+// Returns the number of replayed events.
+func (r *Replayer) Replay(files []string, opts ReplayOptions) (int, error) {
+	count := 0
+	var prevTime time.Time
+	err := ReadFiles(files, func(event Event) error {
+		if opts.Filter != nil && !opts.Filter(event) {
+			return nil
+		}
+		if opts.Speed > 0 && !prevTime.IsZero() {
+			delay := event.Time.Sub(prevTime)
+			if delay > 0 {
+				time.Sleep(time.Duration(float64(delay) / opts.Speed))
+			}
+		}
+		prevTime = event.Time
+		if err := r.Apply(event); err != nil {
+			return err
+		}
+		count++
+		return nil
+	})
+	return count, err
+}
@@ -235,21 +235,7 @@ func (pub *PublicationImpl) Close() error {
 // updatersNotify send a notification to all the matching channels which does not yet
 // have one queued.
 func (pub *PublicationImpl) updatersNotify(name string) {
-	pub.updaterList.lock.Lock()
-	for _, nn := range pub.updaterList.servers {
-		if nn.name != name {
-			continue
-		}
-		select {
-		case nn.ch <- Notify{}:
-			pub.log.Tracef("updaterNotify sent to %s/%d\n",
-				nn.name, nn.instance)
-		default:
-			pub.log.Tracef("updaterNotify NOT sent to %s/%d\n",
-				nn.name, nn.instance)
-		}
-	}
-	pub.updaterList.lock.Unlock()
+	pub.updaterList.notify(pub.log, name)
 }
 
 // Only reads json files. Sets restarted if that file was found and contains
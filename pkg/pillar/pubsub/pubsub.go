@@ -9,6 +9,7 @@ package pubsub
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"time"
 
 	"github.com/lf-edge/eve/pkg/pillar/base"
@@ -31,6 +32,15 @@ type SubscriptionOptions struct {
 	Ctx            interface{}
 	Persistent     bool
 	MyAgentName    string // For logging
+	// KeyPrefix if set only keys with this prefix are added to the
+	// collection and passed to the handlers
+	KeyPrefix string
+	// KeyPattern if set only keys matching this regular expression
+	// are added to the collection and passed to the handlers
+	KeyPattern string
+	// ChangeFilter if set is called before ModifyHandler and the handler
+	// is skipped if it returns false. The collection is updated regardless.
+	ChangeFilter SubChangeFilter
 }
 
 // SubCreateHandler is a handler to handle creates
@@ -41,6 +51,10 @@ type SubCreateHandler func(ctx interface{}, key string, status interface{})
 type SubModifyHandler func(ctx interface{}, key string, status interface{},
 	oldStatus interface{})
 
+// SubChangeFilter reports whether a modification is relevant to the agent
+type SubChangeFilter func(key string, status interface{},
+	oldStatus interface{}) bool
+
 // SubDeleteHandler is a handler to handle delete
 type SubDeleteHandler func(ctx interface{}, key string, status interface{})
 
@@ -97,6 +111,16 @@ func (p *PubSub) NewSubscription(options SubscriptionOptions) (Subscription, err
 			topic)
 	}
 
+	var keyRegexp *regexp.Regexp
+	if options.KeyPattern != "" {
+		var err error
+		keyRegexp, err = regexp.Compile(options.KeyPattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid KeyPattern for topic %s: %v",
+				topic, err)
+		}
+	}
+
 	// Need some buffering to make sure that when we Close the subscription
 	// the goroutines exit
 	changes := make(chan Change, 3)
@@ -121,6 +145,9 @@ func (p *PubSub) NewSubscription(options SubscriptionOptions) (Subscription, err
 		log:                 p.log,
 		myAgentName:         options.MyAgentName,
 		ps:                  p,
+		keyPrefix:           options.KeyPrefix,
+		keyRegexp:           keyRegexp,
+		changeFilter:        options.ChangeFilter,
 	}
 	name := sub.nameString()
 	global := options.AgentName == ""
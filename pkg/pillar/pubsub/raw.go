@@ -0,0 +1,170 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package pubsub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// RawPublication publishes already marshalled json values for a topic
+// without knowing the type behind the topic. Subscribers see the same
+// messages as if the values were published using a Publication.
+// This is used to replay recorded pubsub events.
+type RawPublication struct {
+	name           string
+	topic          string
+	persistent     bool
+	lock           sync.Mutex
+	items          LocalCollection
+	restartCounter int
+	updaterList    *Updaters
+	log            *base.LogObject
+
+	driver DriverPublisher
+}
+
+// NewRawPublication creates a new RawPublication for the given name
+// (as reported by the driver) and topic.
+func (p *PubSub) NewRawPublication(global bool, name, topic string,
+	persistent bool) (*RawPublication, error) {
+
+	if p.updaterList == nil {
+		p.updaterList = &Updaters{}
+	}
+	pub := &RawPublication{
+		name:        name,
+		topic:       topic,
+		persistent:  persistent,
+		items:       make(LocalCollection),
+		updaterList: p.updaterList,
+		log:         p.log,
+	}
+	driver, err := p.driver.Publisher(global, name, topic, persistent,
+		p.updaterList, pub, pub)
+	if err != nil {
+		return nil, err
+	}
+	pub.driver = driver
+	items, restartCounter, err := driver.Load()
+	if err != nil {
+		pub.log.Error(err)
+	} else {
+		for key, val := range items {
+			pub.items[key] = val
+		}
+		pub.restartCounter = restartCounter
+	}
+	if err := driver.Start(); err != nil {
+		return nil, err
+	}
+	return pub, nil
+}
+
+// Publish a key with the json value
+func (pub *RawPublication) Publish(key string, val []byte) error {
+	pub.lock.Lock()
+	if old, ok := pub.items[key]; ok && bytes.Equal(old, val) {
+		pub.lock.Unlock()
+		return nil
+	}
+	pub.items[key] = val
+	pub.lock.Unlock()
+	pub.updaterList.notify(pub.log, pub.name)
+	return pub.driver.Publish(key, val)
+}
+
+// Unpublish a key
+func (pub *RawPublication) Unpublish(key string) error {
+	pub.lock.Lock()
+	if _, ok := pub.items[key]; !ok {
+		pub.lock.Unlock()
+		errStr := fmt.Sprintf("Unpublish(%s/%s): key does not exist",
+			pub.name, key)
+		return errors.New(errStr)
+	}
+	delete(pub.items, key)
+	pub.lock.Unlock()
+	pub.updaterList.notify(pub.log, pub.name)
+	return pub.driver.Unpublish(key)
+}
+
+// Restart sets the restart counter; zero clears the restarted state
+func (pub *RawPublication) Restart(restartCounter int) error {
+	pub.lock.Lock()
+	if pub.restartCounter == restartCounter {
+		pub.lock.Unlock()
+		return nil
+	}
+	pub.restartCounter = restartCounter
+	pub.lock.Unlock()
+	pub.updaterList.notify(pub.log, pub.name)
+	return pub.driver.Restart(restartCounter)
+}
+
+// Close the publication
+func (pub *RawPublication) Close() error {
+	if !pub.persistent {
+		pub.lock.Lock()
+		var keys []string
+		for key := range pub.items {
+			keys = append(keys, key)
+		}
+		pub.lock.Unlock()
+		for _, key := range keys {
+			pub.Unpublish(key)
+		}
+	}
+	pub.Restart(0)
+	return pub.driver.Stop()
+}
+
+// IsRestarted has this publication been set to "restarted"
+func (pub *RawPublication) IsRestarted() bool {
+	return pub.RestartCounter() != 0
+}
+
+// RestartCounter number of times this this publication been set to "restarted"
+func (pub *RawPublication) RestartCounter() int {
+	pub.lock.Lock()
+	defer pub.lock.Unlock()
+	return pub.restartCounter
+}
+
+// DetermineDiffs update a provided LocalCollection to the current state,
+// and return the deleted keys before the added/modified ones
+func (pub *RawPublication) DetermineDiffs(localCollection LocalCollection) []string {
+	var keys []string
+	dirname := fmt.Sprintf("%s/%s", pub.driver.LargeDirName(), pub.name)
+	pub.lock.Lock()
+	defer pub.lock.Unlock()
+	// Look for deleted
+	for localKey := range localCollection {
+		if _, ok := pub.items[localKey]; !ok {
+			delete(localCollection, localKey)
+			keys = append(keys, localKey)
+		}
+	}
+	// Look for new/changed
+	for originKey, originb := range pub.items {
+		local := lookupLocal(localCollection, originKey)
+		if local != nil && bytes.Equal(originb, local) {
+			continue
+		}
+		// Extract large fields and save to file
+		b, err := writeAndRemoveLarge(pub.log, originb, dirname)
+		if err != nil {
+			pub.log.Errorf("DetermineDiffs(%s): key %s: %v",
+				pub.name, originKey, err)
+			continue
+		}
+		localCollection[originKey] = b
+		keys = append(keys, originKey)
+	}
+	return keys
+}
@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,6 +43,9 @@ type SubscriptionImpl struct {
 	log          *base.LogObject
 	myAgentName  string // For logging
 	ps           *PubSub
+	keyPrefix    string
+	keyRegexp    *regexp.Regexp
+	changeFilter SubChangeFilter
 }
 
 // MsgChan return the Message Channel for the Subscription.
@@ -196,6 +201,17 @@ func (sub *SubscriptionImpl) nameString() string {
 	return name
 }
 
+// keyMatches returns false if the key is excluded by KeyPrefix or KeyPattern
+func (sub *SubscriptionImpl) keyMatches(key string) bool {
+	if sub.keyPrefix != "" && !strings.HasPrefix(key, sub.keyPrefix) {
+		return false
+	}
+	if sub.keyRegexp != nil && !sub.keyRegexp.MatchString(key) {
+		return false
+	}
+	return true
+}
+
 func (sub *SubscriptionImpl) dump(infoStr string) {
 	name := sub.nameString()
 	sub.log.Tracef("dump(%s) %s\n", name, infoStr)
@@ -218,6 +234,11 @@ func handleModify(ctxArg interface{}, key string, itemcb []byte) {
 	sub := ctxArg.(*SubscriptionImpl)
 	name := sub.nameString()
 	sub.log.Tracef("pubsub.handleModify(%s) key %s\n", name, key)
+	if !sub.keyMatches(key) {
+		sub.log.Tracef("pubsub.handleModify(%s) key %s filtered\n",
+			name, key)
+		return
+	}
 	// Any large items which were stored separately?
 	itemcb, err := readAddLarge(sub.log, itemcb)
 	if err != nil {
@@ -267,6 +288,10 @@ func handleModify(ctxArg interface{}, key string, itemcb []byte) {
 		if sub.CreateHandler != nil {
 			(sub.CreateHandler)(sub.userCtx, key, newItem)
 		}
+	} else if sub.changeFilter != nil &&
+		!(sub.changeFilter)(key, newItem, m) {
+		sub.log.Tracef("pubsub.handleModify(%s/%s) change filtered\n",
+			name, key)
 	} else {
 		if sub.ModifyHandler != nil {
 			(sub.ModifyHandler)(sub.userCtx, key, newItem, m)
@@ -279,6 +304,9 @@ func handleDelete(ctxArg interface{}, key string) {
 	sub := ctxArg.(*SubscriptionImpl)
 	name := sub.nameString()
 	sub.log.Tracef("pubsub.handleDelete(%s) key %s\n", name, key)
+	if !sub.keyMatches(key) {
+		return
+	}
 
 	m, ok := sub.km.key.Load(key)
 	if !ok {
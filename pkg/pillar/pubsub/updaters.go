@@ -46,3 +46,23 @@ func (u *Updaters) Remove(log *base.LogObject, updater chan Notify) {
 	u.servers = servers
 	u.lock.Unlock()
 }
+
+// notify sends a notification to all the matching channels which do not yet
+// have one queued.
+func (u *Updaters) notify(log *base.LogObject, name string) {
+	u.lock.Lock()
+	for _, nn := range u.servers {
+		if nn.name != name {
+			continue
+		}
+		select {
+		case nn.ch <- Notify{}:
+			log.Tracef("updaterNotify sent to %s/%d\n",
+				nn.name, nn.instance)
+		default:
+			log.Tracef("updaterNotify NOT sent to %s/%d\n",
+				nn.name, nn.instance)
+		}
+	}
+	u.lock.Unlock()
+}
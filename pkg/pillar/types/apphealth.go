@@ -0,0 +1,159 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Defaults for HealthCheckConfig as in Docker
+const (
+	DefaultHealthCheckInterval = 30 * time.Second
+	DefaultHealthCheckTimeout  = 30 * time.Second
+	DefaultHealthCheckRetries  = 3
+)
+
+// HealthCheckConfig is a health check run inside a container domain.
+// It follows the Docker HEALTHCHECK and comes from the controller or
+// from the container image.
+type HealthCheckConfig struct {
+	// ["CMD", executable, args...], ["CMD-SHELL", command] or ["NONE"]
+	Test        []string
+	Interval    time.Duration
+	Timeout     time.Duration
+	StartPeriod time.Duration
+	Retries     uint32
+}
+
+// Command returns the command to run or nil if the health check is
+// disabled or invalid
+func (hc HealthCheckConfig) Command() []string {
+	if len(hc.Test) < 2 {
+		return nil
+	}
+	switch hc.Test[0] {
+	case "CMD":
+		return hc.Test[1:]
+	case "CMD-SHELL":
+		return []string{"/bin/sh", "-c", hc.Test[1]}
+	default:
+		return nil
+	}
+}
+
+// IntervalOrDefault returns the time between checks
+func (hc HealthCheckConfig) IntervalOrDefault() time.Duration {
+	if hc.Interval == 0 {
+		return DefaultHealthCheckInterval
+	}
+	return hc.Interval
+}
+
+// TimeoutOrDefault returns the time limit for a check
+func (hc HealthCheckConfig) TimeoutOrDefault() time.Duration {
+	if hc.Timeout == 0 {
+		return DefaultHealthCheckTimeout
+	}
+	return hc.Timeout
+}
+
+// RetriesOrDefault returns the number of consecutive failures to be unhealthy
+func (hc HealthCheckConfig) RetriesOrDefault() uint32 {
+	if hc.Retries == 0 {
+		return DefaultHealthCheckRetries
+	}
+	return hc.Retries
+}
+
+// RestartPolicy determines what domainmgr does when a domain exits or
+// becomes unhealthy. Must match the values in the proto definition.
+type RestartPolicy uint8
+
+// The restart policies
+const (
+	// RestartPolicyUnspecified retries a failed boot after
+	// timer.boot.retry and reports a crash as an error
+	RestartPolicyUnspecified RestartPolicy = iota
+	RestartPolicyNever
+	RestartPolicyOnFailure
+	RestartPolicyAlways
+	// RestartPolicyOnUnhealthy is RestartPolicyOnFailure plus a restart
+	// when the health check reports unhealthy
+	RestartPolicyOnUnhealthy
+)
+
+// String returns the string name
+func (policy RestartPolicy) String() string {
+	switch policy {
+	case RestartPolicyUnspecified:
+		return "unspecified"
+	case RestartPolicyNever:
+		return "never"
+	case RestartPolicyOnFailure:
+		return "on-failure"
+	case RestartPolicyAlways:
+		return "always"
+	case RestartPolicyOnUnhealthy:
+		return "on-unhealthy"
+	default:
+		return fmt.Sprintf("Unknown RestartPolicy %d", policy)
+	}
+}
+
+// RestartOnExit returns true if a domain which exited should be restarted
+func (policy RestartPolicy) RestartOnExit(failed bool) bool {
+	switch policy {
+	case RestartPolicyAlways:
+		return true
+	case RestartPolicyOnFailure, RestartPolicyOnUnhealthy:
+		return failed
+	default:
+		return false
+	}
+}
+
+// HealthState of a domain as determined by the health check.
+// Must match the values in the proto definition.
+type HealthState uint8
+
+// The health states
+const (
+	HealthStateNone HealthState = iota // No health check
+	HealthStateStarting
+	HealthStateHealthy
+	HealthStateUnhealthy
+)
+
+// String returns the string name
+func (state HealthState) String() string {
+	switch state {
+	case HealthStateNone:
+		return "none"
+	case HealthStateStarting:
+		return "starting"
+	case HealthStateHealthy:
+		return "healthy"
+	case HealthStateUnhealthy:
+		return "unhealthy"
+	default:
+		return fmt.Sprintf("Unknown HealthState %d", state)
+	}
+}
+
+// HealthStatus is the result of the health checks of a domain
+type HealthStatus struct {
+	State         HealthState
+	Since         time.Time // When State changed
+	FailingStreak uint32    // Consecutive failed checks
+	LastError     string    // Output of the last failed check
+}
+
+// Equal compares the health ignoring the time zone of Since
+func (health HealthStatus) Equal(other HealthStatus) bool {
+	return health.State == other.State &&
+		health.Since.Equal(other.Since) &&
+		health.FailingStreak == other.FailingStreak &&
+		health.LastError == other.LastError
+}
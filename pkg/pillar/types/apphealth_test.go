@@ -0,0 +1,58 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHealthCheckCommand(t *testing.T) {
+	testMatrix := map[string]struct {
+		test     []string
+		expected []string
+	}{
+		"cmd": {
+			test:     []string{"CMD", "/bin/check", "-q"},
+			expected: []string{"/bin/check", "-q"},
+		},
+		"shell": {
+			test:     []string{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+			expected: []string{"/bin/sh", "-c", "curl -f http://localhost/ || exit 1"},
+		},
+		"none": {
+			test: []string{"NONE"},
+		},
+		"empty cmd": {
+			test: []string{"CMD"},
+		},
+		"unknown": {
+			test: []string{"RUN", "/bin/check"},
+		},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		hc := HealthCheckConfig{Test: test.test}
+		if cmd := hc.Command(); !reflect.DeepEqual(cmd, test.expected) {
+			t.Errorf("%s: expected %v got %v", testname, test.expected, cmd)
+		}
+	}
+}
+
+func TestRestartOnExit(t *testing.T) {
+	testMatrix := map[RestartPolicy][2]bool{
+		// Restart after success, after failure
+		RestartPolicyUnspecified: {false, false},
+		RestartPolicyNever:       {false, false},
+		RestartPolicyOnFailure:   {false, true},
+		RestartPolicyAlways:      {true, true},
+		RestartPolicyOnUnhealthy: {false, true},
+	}
+	for policy, expected := range testMatrix {
+		if policy.RestartOnExit(false) != expected[0] ||
+			policy.RestartOnExit(true) != expected[1] {
+			t.Errorf("%s: expected %v", policy, expected)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/lf-edge/eve/pkg/pillar/base"
+)
+
+// CASFsckMetrics are the results of the periodic check of the CAS
+// content store done by volumemgr. The counters are totals since boot
+// while the Last* fields are from the most recent check.
+type CASFsckMetrics struct {
+	RunCount            uint64
+	FailureCount        uint64 // Checks which could not list the content
+	LastRun             time.Time
+	LastDuration        time.Duration
+	LastError           string
+	LastBlobs           uint64
+	LastBlobBytes       uint64
+	LastMissingBlobs    uint64
+	LastCorruptBlobs    uint64
+	LastOrphanBlobs     uint64
+	LastOrphanBytes     uint64
+	LastOrphanSnapshots uint64
+	CorruptBlobs        uint64 // Total found
+	RemovedBlobs        uint64
+	RemovedBytes        uint64
+	RemovedSnapshots    uint64
+}
+
+// Key - key for pubsub
+func (metrics CASFsckMetrics) Key() string {
+	return "global"
+}
+
+// LogCreate :
+func (metrics CASFsckMetrics) LogCreate(logBase *base.LogObject) {
+	logObject := base.NewLogObject(logBase, base.CASFsckMetricsLogType, "",
+		nilUUID, metrics.LogKey())
+	if logObject == nil {
+		return
+	}
+	logObject.Metricf("CAS fsck metric create")
+}
+
+// LogModify :
+func (metrics CASFsckMetrics) LogModify(logBase *base.LogObject, old interface{}) {
+	logObject := base.EnsureLogObject(logBase, base.CASFsckMetricsLogType, "",
+		nilUUID, metrics.LogKey())
+
+	oldMetrics, ok := old.(CASFsckMetrics)
+	if !ok {
+		logObject.Clone().Fatalf("LogModify: Old object interface passed is not of CASFsckMetrics type")
+	}
+	logObject.CloneAndAddField("diff", cmp.Diff(oldMetrics, metrics)).
+		Metricf("CAS fsck metric modify")
+}
+
+// LogDelete :
+func (metrics CASFsckMetrics) LogDelete(logBase *base.LogObject) {
+	logObject := base.EnsureLogObject(logBase, base.CASFsckMetricsLogType, "",
+		nilUUID, metrics.LogKey())
+	logObject.Metricf("CAS fsck metric delete")
+
+	base.DeleteLogObject(logBase, metrics.LogKey())
+}
+
+// LogKey :
+func (metrics CASFsckMetrics) LogKey() string {
+	return string(base.CASFsckMetricsLogType) + "-" + metrics.Key()
+}
@@ -143,4 +143,7 @@ type EncryptionBlock struct {
 	WifiUserName      string // If the authentication type is EAP
 	WifiPassword      string
 	ProtectedUserData string
+	// WireGuard keys, base64 encoded
+	WireGuardPrivateKey    string
+	WireGuardPresharedKeys map[string]string // keyed by the peer public key
 }
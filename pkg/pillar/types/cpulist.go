@@ -0,0 +1,73 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxCPUs bounds the CPU numbers accepted by ParseCPUList, so that a
+// range from the controller can not make us expand billions of CPUs.
+// It is the largest NR_CPUS of the Linux kernel.
+const MaxCPUs = 8192
+
+// ParseCPUList parses a list of CPUs in the Linux cpulist format
+// e.g. "1,2" or "0-3,6" and returns the sorted unique CPU numbers
+func ParseCPUList(cpuList string) ([]int, error) {
+	seen := make(map[int]bool)
+	var cpus []int
+	for _, elem := range strings.Split(cpuList, ",") {
+		elem = strings.TrimSpace(elem)
+		if elem == "" {
+			continue
+		}
+		first, last := elem, elem
+		if i := strings.Index(elem, "-"); i >= 0 {
+			first, last = elem[:i], elem[i+1:]
+		}
+		start, err := strconv.Atoi(strings.TrimSpace(first))
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid CPU %q in %q", first, cpuList)
+		}
+		end, err := strconv.Atoi(strings.TrimSpace(last))
+		if err != nil || end < start {
+			return nil, fmt.Errorf("invalid CPU range %q in %q", elem, cpuList)
+		}
+		if end >= MaxCPUs {
+			return nil, fmt.Errorf("CPU %d in %q is above the maximum of %d",
+				end, cpuList, MaxCPUs-1)
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			if !seen[cpu] {
+				seen[cpu] = true
+				cpus = append(cpus, cpu)
+			}
+		}
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FormatCPUList returns the cpulist format of the CPUs e.g. "0-3,6"
+func FormatCPUList(cpus []int) string {
+	sorted := append([]int{}, cpus...)
+	sort.Ints(sorted)
+	var elems []string
+	for i := 0; i < len(sorted); {
+		j := i
+		for j+1 < len(sorted) && sorted[j+1] <= sorted[j]+1 {
+			j++
+		}
+		if sorted[i] == sorted[j] {
+			elems = append(elems, strconv.Itoa(sorted[i]))
+		} else {
+			elems = append(elems, fmt.Sprintf("%d-%d", sorted[i], sorted[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(elems, ",")
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCPUList(t *testing.T) {
+	testMatrix := map[string]struct {
+		cpuList     string
+		expected    []int
+		expectError bool
+	}{
+		"empty":      {cpuList: "", expected: nil},
+		"single":     {cpuList: "3", expected: []int{3}},
+		"list":       {cpuList: "2,1", expected: []int{1, 2}},
+		"range":      {cpuList: "0-3,6", expected: []int{0, 1, 2, 3, 6}},
+		"overlap":    {cpuList: "1-3, 2", expected: []int{1, 2, 3}},
+		"bad number": {cpuList: "a", expectError: true},
+		"negative":   {cpuList: "-1", expectError: true},
+		"bad range":  {cpuList: "3-1", expectError: true},
+		"huge range": {cpuList: "0-2147483647", expectError: true},
+		"huge cpu":   {cpuList: "8192", expectError: true},
+		"max cpu":    {cpuList: "8191", expected: []int{8191}},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		cpus, err := ParseCPUList(test.cpuList)
+		if test.expectError {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, cpus)
+	}
+}
+
+func TestFormatCPUList(t *testing.T) {
+	assert.Equal(t, "", FormatCPUList(nil))
+	assert.Equal(t, "2", FormatCPUList([]int{2}))
+	assert.Equal(t, "0-3,6", FormatCPUList([]int{6, 0, 1, 2, 3}))
+	assert.Equal(t, "1,3,5-6", FormatCPUList([]int{1, 3, 5, 6}))
+}
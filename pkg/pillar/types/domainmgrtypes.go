@@ -43,6 +43,61 @@ type DomainConfig struct {
 
 	// MetaDataType for select type of metadata service for app
 	MetaDataType MetaDataType
+
+	// SnapshotCmd and RestoreCmd request a snapshot of the memory and
+	// disk state of the running domain, or a restore to such a snapshot,
+	// when their Counter is increased
+	SnapshotCmd DomainSnapshotCmd
+	RestoreCmd  DomainSnapshotCmd
+
+	// HealthCheck overrides the HEALTHCHECK of the container image
+	HealthCheck   *HealthCheckConfig
+	RestartPolicy RestartPolicy
+}
+
+// DomainSnapshotCmd is a request to take or restore the named snapshot.
+// For a restore an empty Name means the most recent snapshot.
+type DomainSnapshotCmd struct {
+	Counter uint32
+	Name    string
+}
+
+// DomainSnapshot is a snapshot of a running domain
+type DomainSnapshot struct {
+	Name      string
+	CreatedAt time.Time
+}
+
+// DomainSnapshotStatus reports the snapshots of a running domain and the
+// outcome of the last snapshot or restore request. The snapshots are
+// deleted when the domain is halted.
+type DomainSnapshotStatus struct {
+	Snapshots       []DomainSnapshot
+	SnapshotCounter uint32 // Last SnapshotCmd.Counter handled
+	RestoreCounter  uint32 // Last RestoreCmd.Counter handled
+	// InProgress is set while a snapshot or restore of the named snapshot
+	// is performed, e.g. "snapshot snapshot-1"
+	InProgress   string
+	RestoredFrom string // Name of the snapshot of the last restore
+	RestoredAt   time.Time
+	Error        string // Error from the last snapshot or restore
+	ErrorTime    time.Time
+}
+
+// LookupSnapshot returns the named snapshot; the most recent if name is empty
+func (snapStatus DomainSnapshotStatus) LookupSnapshot(name string) *DomainSnapshot {
+	if name == "" {
+		if len(snapStatus.Snapshots) == 0 {
+			return nil
+		}
+		return &snapStatus.Snapshots[len(snapStatus.Snapshots)-1]
+	}
+	for i := range snapStatus.Snapshots {
+		if snapStatus.Snapshots[i].Name == name {
+			return &snapStatus.Snapshots[i]
+		}
+	}
+	return nil
 }
 
 // MetaDataType of metadata service for app
@@ -55,6 +110,7 @@ const (
 	MetaDataNone
 	MetaDataOpenStack
 	MetaDataDriveMultipart // Process multipart MIME for application
+	MetaDataEC2            // EC2 compatible metadata service with IMDSv2 tokens
 )
 
 // String returns the string name
@@ -68,6 +124,8 @@ func (metaDataType MetaDataType) String() string {
 		return "MetaDataOpenStack"
 	case MetaDataDriveMultipart:
 		return "MetaDataDriveMultipart"
+	case MetaDataEC2:
+		return "MetaDataEC2"
 	default:
 		return fmt.Sprintf("Unknown MetaDataType %d", metaDataType)
 	}
@@ -215,6 +273,27 @@ type VmConfig struct {
 	VncDisplay         uint32
 	VncPasswd          string
 	DisableLogs        bool
+	// cgroup controls only applied to container (NOHYPER) app instances
+	BlkioWeight    uint16 // default 0 i.e. not set
+	BlkioThrottles []BlkioThrottle
+	PidsLimit      int64 // default 0 i.e. unlimited
+	HugepageLimits []HugepageLimit
+}
+
+// BlkioThrottle limits the IO rate of a container on one block device.
+// Zero rates are not limited.
+type BlkioThrottle struct {
+	Device    string // E.g., "/dev/sda"
+	ReadBps   uint64
+	WriteBps  uint64
+	ReadIops  uint64
+	WriteIops uint64
+}
+
+// HugepageLimit limits the hugetlb usage of a container for one page size
+type HugepageLimit struct {
+	PageSize string // E.g., "2MB"
+	Limit    uint64 // in bytes
 }
 
 type VmMode uint8
@@ -237,6 +316,17 @@ type Task interface {
 	Delete(string) error
 	Info(string) (int, SwState, error)
 	Cleanup(string) error
+	// PinCPUs restricts a running domain to the CPUs in cpulist format.
+	// The vCPUs of a domain with exclusive CPUs may be pinned one to one,
+	// in which case the physical CPU of each vCPU is returned; otherwise
+	// each vCPU may run on any of the CPUs.
+	PinCPUs(string, string, bool) ([]int, error)
+	// Exec runs a command with a time limit inside a running container
+	// domain and returns its output. Not supported for VMs.
+	Exec(string, []string, time.Duration) (string, error)
+	// ContainerStats returns the cgroup usage of a running container
+	// domain. Not supported for VMs.
+	ContainerStats(string) (*AppContainerStats, error)
 }
 
 type DomainStatus struct {
@@ -267,6 +357,22 @@ type DomainStatus struct {
 	OCIConfigDir   string            // folder holding an OCI Image config for this domain (empty string means no config)
 	EnvVariables   map[string]string // List of environment variables to be set in container
 	VmConfig                         // From DomainConfig
+	Snapshot       DomainSnapshotStatus
+	CPUPlacement   CPUPlacement
+	// ImageHealthCheck is the HEALTHCHECK of the container image
+	ImageHealthCheck *HealthCheckConfig
+	Health           HealthStatus
+	RestartCount     uint32        // Restarts due to the RestartPolicy
+	RestartBackoff   time.Duration // Delay before the next restart
+}
+
+// CPUPlacement is the set of physical CPUs a domain runs on.
+// Domains which specify VmConfig.CPUs get those CPUs exclusively, the
+// others share the CPUs which are neither exclusive nor reserved for EVE.
+type CPUPlacement struct {
+	Exclusive bool
+	CPUs      string // cpulist format e.g. "2-3,6"
+	VCPUs     []int  // Physical CPU of each vCPU if pinned one to one
 }
 
 func (status DomainStatus) Key() string {
@@ -349,11 +455,27 @@ func (status DomainStatus) LogKey() string {
 	return string(base.DomainStatusLogType) + "-" + status.Key()
 }
 
+// VlanRange : VLAN IDs from Start to End, both included
+type VlanRange struct {
+	Start uint32
+	End   uint32
+}
+
 // VlanInfo :
 type VlanInfo struct {
 	Start   uint32
 	End     uint32
 	IsTrunk bool
+	// TrunkRanges restrict a trunk port to these VLANs if set
+	TrunkRanges []VlanRange
+}
+
+// VlanRanges returns the VLANs of the port
+func (vlan VlanInfo) VlanRanges() []VlanRange {
+	if vlan.IsTrunk && len(vlan.TrunkRanges) != 0 {
+		return vlan.TrunkRanges
+	}
+	return []VlanRange{{Start: vlan.Start, End: vlan.End}}
 }
 
 type VifInfo struct {
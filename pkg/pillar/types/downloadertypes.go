@@ -111,6 +111,8 @@ type DownloaderStatus struct {
 	Progress      uint      // In percent i.e., 0-100, given by CurrentSize/ExpectedSize
 	ModTime       time.Time
 	ContentType   string // content-type header, if provided
+	// Parts is the progress of the parts of a ranged download
+	Parts []DownloadPartStatus
 	// ErrorAndTime provides SetErrorNow() and ClearError()
 	ErrorAndTime
 	RetryCount int
@@ -118,6 +120,14 @@ type DownloaderStatus struct {
 	OrigError string
 }
 
+// DownloadPartStatus is the progress of one part (byte range) of a
+// download which is fetched in parallel parts
+type DownloadPartStatus struct {
+	Index       int64
+	CurrentSize int64
+	TotalSize   int64
+}
+
 func (status DownloaderStatus) Key() string {
 	return status.ImageSha256
 }
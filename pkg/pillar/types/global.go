@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
@@ -130,6 +131,10 @@ const (
 	VdiskGCTime GlobalSettingKey = "timer.gc.vdisk"
 	// DeferContentDelete global setting key
 	DeferContentDelete GlobalSettingKey = "timer.defer.content.delete"
+	// CASFsckInterval global setting key; zero disables the check
+	CASFsckInterval GlobalSettingKey = "timer.cas.fsck.interval"
+	// ZFSScrubInterval global setting key; zero disables the scrubs
+	ZFSScrubInterval GlobalSettingKey = "timer.zfs.scrub.interval"
 	// DownloadRetryTime global setting key
 	DownloadRetryTime GlobalSettingKey = "timer.download.retry"
 	// DownloadStalledTime global setting key
@@ -161,28 +166,67 @@ const (
 	VaultReadyCutOffTime GlobalSettingKey = "timer.vault.ready.cutoff"
 	// LogRemainToSendMBytes Max gzip log files remain on device to be sent in Mbytes
 	LogRemainToSendMBytes GlobalSettingKey = "newlog.gzipfiles.ondisk.maxmegabytes"
+	// LogRateLimitDevSource global setting key; the messages per second
+	// written for each device log source, zero disables the limit
+	LogRateLimitDevSource GlobalSettingKey = "newlog.ratelimit.source.persecond"
+	// LogRateLimitApp global setting key; the messages per second written
+	// for each app, zero disables the limit
+	LogRateLimitApp GlobalSettingKey = "newlog.ratelimit.app.persecond"
+	// LogRateLimitBurst global setting key; the seconds worth of messages
+	// which a source can log at once before it is limited
+	LogRateLimitBurst GlobalSettingKey = "newlog.ratelimit.burst.seconds"
+	// LogRateLimitSample global setting key; one of every N messages over
+	// the limit is still written, zero drops all of them
+	LogRateLimitSample GlobalSettingKey = "newlog.ratelimit.sample"
 
 	// ForceFallbackCounter global setting key
 	ForceFallbackCounter = "force.fallback.counter"
 
+	// ZFSSnapshotMaxCount global setting key; the maximum number of
+	// snapshots of a volume
+	ZFSSnapshotMaxCount GlobalSettingKey = "storage.zfs.snapshot.max.count"
+	// ZFSSnapshotMinFreePercent global setting key; no new snapshots are
+	// taken when less of the pool is available
+	ZFSSnapshotMinFreePercent GlobalSettingKey = "storage.zfs.snapshot.min.free.percent"
+
 	// DownloadMaxPortCost global setting key controls
 	// how the EVE microservices will use free and non-free (e.g., WWAN)
 	// ports for image downloads.
 	DownloadMaxPortCost GlobalSettingKey = "network.download.max.cost"
+	// DownloadParallelParts global setting key; the number of parts
+	// (byte ranges) of an image which are downloaded in parallel
+	DownloadParallelParts GlobalSettingKey = "network.download.parallel.parts"
 
 	// Bool Items
 	// UsbAccess global setting key
 	UsbAccess GlobalSettingKey = "debug.enable.usb"
 	// VgaAccess global setting to enable host VGA console if it is not assigned to an application
 	VgaAccess GlobalSettingKey = "debug.enable.vga"
+	// FaultInjection global setting key; inject the faults described in
+	// FaultInjectionScenarioFile
+	FaultInjection GlobalSettingKey = "debug.enable.fault.injection"
+	// DownloadPeerSharing global setting key; download blobs from other EVE
+	// devices on the local network which have DownloadPeerServe set
+	DownloadPeerSharing GlobalSettingKey = "network.download.peer.sharing"
+	// DownloadPeerServe global setting key; advertise and serve the blobs
+	// of this device to other EVE devices on the local network
+	DownloadPeerServe GlobalSettingKey = "network.download.peer.serve"
 	// AllowAppVnc global setting key
 	AllowAppVnc GlobalSettingKey = "app.allow.vnc"
 	// EveMemoryLimitInBytes global setting key
 	EveMemoryLimitInBytes GlobalSettingKey = "memory.eve.limit.bytes"
+	// EveReservedCPUs global setting key; the number of CPUs starting
+	// with CPU 0 which are not used by app instances with CPU pinning
+	EveReservedCPUs GlobalSettingKey = "cpus.eve.reserved"
 	// IgnoreMemoryCheckForApps global setting key
 	IgnoreMemoryCheckForApps GlobalSettingKey = "memory.apps.ignore.check"
 	// IgnoreDiskCheckForApps global setting key
 	IgnoreDiskCheckForApps GlobalSettingKey = "storage.apps.ignore.disk.check"
+	// CASFsckVerify global setting key; re-hash the CAS blobs when checking
+	CASFsckVerify GlobalSettingKey = "storage.cas.fsck.verify"
+	// CASFsckRemove global setting key; remove orphaned and unused corrupt
+	// CAS content, and download corrupt content which is in use again
+	CASFsckRemove GlobalSettingKey = "storage.cas.fsck.remove"
 	// AllowLogFastupload global setting key
 	AllowLogFastupload GlobalSettingKey = "newlog.allow.fastupload"
 
@@ -200,6 +244,13 @@ const (
 	DefaultLogLevel GlobalSettingKey = "debug.default.loglevel"
 	// DefaultRemoteLogLevel global setting key
 	DefaultRemoteLogLevel GlobalSettingKey = "debug.default.remote.loglevel"
+	// LogExportTargets global setting key; space separated URLs of local
+	// collectors newlogd forwards the logs to
+	LogExportTargets GlobalSettingKey = "newlog.export.targets"
+	// DownloadPeerKey global setting key; the key shared by the devices
+	// which download blobs from each other. Peer sharing is disabled
+	// unless it is set.
+	DownloadPeerKey GlobalSettingKey = "network.download.peer.key"
 
 	// XXX Temporary flag to disable RFC 3442 classless static route usage
 	DisableDHCPAllOnesNetMask GlobalSettingKey = "debug.disable.dhcp.all-ones.netmask"
@@ -216,6 +267,9 @@ const (
 	LogLevel AgentSettingKey = "debug.loglevel"
 	// RemoteLogLevel agent setting key
 	RemoteLogLevel AgentSettingKey = "debug.remote.loglevel"
+	// LogRateLimit agent setting key; the messages per second newlogd
+	// writes for the agent, overriding LogRateLimitDevSource
+	LogRateLimit AgentSettingKey = "newlog.ratelimit.persecond"
 )
 
 const (
@@ -732,6 +786,8 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddIntItem(StaleConfigTime, 7*24*3600, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(VdiskGCTime, 3600, 60, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DeferContentDelete, 0, 0, 24*3600)
+	configItemSpecMap.AddIntItem(CASFsckInterval, 24*3600, 0, 0xFFFFFFFF)
+	configItemSpecMap.AddIntItem(ZFSScrubInterval, 30*24*3600, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadRetryTime, 600, 60, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadStalledTime, 600, 20, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DomainBootRetryTime, 600, 10, 0xFFFFFFFF)
@@ -753,14 +809,27 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 		uint32(eveMemoryLimitInBytes), 0xFFFFFFFF)
 	// LogRemainToSendMBytes - Default is 2 Gbytes, minimum is 10 Mbytes
 	configItemSpecMap.AddIntItem(LogRemainToSendMBytes, 2048, 10, 0xFFFFFFFF)
+	configItemSpecMap.AddIntItem(LogRateLimitDevSource, 0, 0, 0xFFFFFFFF)
+	configItemSpecMap.AddIntItem(LogRateLimitApp, 0, 0, 0xFFFFFFFF)
+	configItemSpecMap.AddIntItem(LogRateLimitBurst, 10, 1, 3600)
+	configItemSpecMap.AddIntItem(LogRateLimitSample, 100, 0, 0xFFFFFFFF)
 	configItemSpecMap.AddIntItem(DownloadMaxPortCost, 0, 0, 255)
+	configItemSpecMap.AddIntItem(DownloadParallelParts, 4, 1, 16)
+	configItemSpecMap.AddIntItem(ZFSSnapshotMaxCount, 16, 0, 1024)
+	configItemSpecMap.AddIntItem(ZFSSnapshotMinFreePercent, 20, 0, 100)
 
 	// Add Bool Items
 	configItemSpecMap.AddBoolItem(UsbAccess, true) // Controller likely default to false
+	configItemSpecMap.AddBoolItem(FaultInjection, false)
+	configItemSpecMap.AddBoolItem(DownloadPeerSharing, false)
+	configItemSpecMap.AddBoolItem(DownloadPeerServe, false)
 	configItemSpecMap.AddBoolItem(VgaAccess, true) // Controller likely default to false
 	configItemSpecMap.AddBoolItem(AllowAppVnc, false)
+	configItemSpecMap.AddIntItem(EveReservedCPUs, 1, 0, 0xFFFF)
 	configItemSpecMap.AddBoolItem(IgnoreMemoryCheckForApps, false)
 	configItemSpecMap.AddBoolItem(IgnoreDiskCheckForApps, false)
+	configItemSpecMap.AddBoolItem(CASFsckVerify, true)
+	configItemSpecMap.AddBoolItem(CASFsckRemove, false)
 	configItemSpecMap.AddBoolItem(AllowLogFastupload, false)
 	configItemSpecMap.AddBoolItem(DisableDHCPAllOnesNetMask, false)
 	configItemSpecMap.AddBoolItem(ProcessCloudInitMultiPart, false)
@@ -773,10 +842,13 @@ func NewConfigItemSpecMap() ConfigItemSpecMap {
 	configItemSpecMap.AddStringItem(SSHAuthorizedKeys, "", blankValidator)
 	configItemSpecMap.AddStringItem(DefaultLogLevel, "info", parseLevel)
 	configItemSpecMap.AddStringItem(DefaultRemoteLogLevel, "info", parseLevel)
+	configItemSpecMap.AddStringItem(LogExportTargets, "", parseLogExportTargets)
+	configItemSpecMap.AddStringItem(DownloadPeerKey, "", blankValidator)
 
 	// Add Agent Settings
 	configItemSpecMap.AddAgentSettingStringItem(LogLevel, "info", parseLevel)
 	configItemSpecMap.AddAgentSettingStringItem(RemoteLogLevel, "info", parseLevel)
+	configItemSpecMap.AddAgentSettingStringItem(LogRateLimit, "", parseRateLimit)
 
 	return configItemSpecMap
 }
@@ -787,6 +859,41 @@ func parseLevel(level string) error {
 	return err
 }
 
+// parseRateLimit - Accepts messages per second or blank for the default
+func parseRateLimit(s string) error {
+	if s == "" {
+		return nil
+	}
+	_, err := strconv.ParseUint(s, 10, 32)
+	return err
+}
+
+// LogExportSchemes are the URL schemes of LogExportTargets
+var LogExportSchemes = []string{"syslog+tcp", "syslog+tls", "otlp+http", "otlp+https"}
+
+// parseLogExportTargets - Checks the scheme and host of each target URL
+func parseLogExportTargets(s string) error {
+	for _, target := range strings.Fields(s) {
+		u, err := url.Parse(target)
+		if err != nil {
+			return err
+		}
+		known := false
+		for _, scheme := range LogExportSchemes {
+			if u.Scheme == scheme {
+				known = true
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown scheme %s in %s", u.Scheme, target)
+		}
+		if u.Host == "" {
+			return fmt.Errorf("no host in %s", target)
+		}
+	}
+	return nil
+}
+
 // blankValidator - A validator that accepts any string
 func blankValidator(s string) error {
 	return nil
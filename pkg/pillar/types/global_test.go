@@ -155,6 +155,8 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		StaleConfigTime,
 		VdiskGCTime,
 		DeferContentDelete,
+		CASFsckInterval,
+		ZFSScrubInterval,
 		DownloadRetryTime,
 		DownloadStalledTime,
 		DomainBootRetryTime,
@@ -171,14 +173,27 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		Dom0DiskUsageMaxBytes,
 		ForceFallbackCounter,
 		LogRemainToSendMBytes,
+		LogRateLimitDevSource,
+		LogRateLimitApp,
+		LogRateLimitBurst,
+		LogRateLimitSample,
 		DownloadMaxPortCost,
+		DownloadParallelParts,
+		ZFSSnapshotMaxCount,
+		ZFSSnapshotMinFreePercent,
 		// Bool Items
 		UsbAccess,
+		FaultInjection,
+		DownloadPeerSharing,
+		DownloadPeerServe,
 		VgaAccess,
 		AllowAppVnc,
 		EveMemoryLimitInBytes,
+		EveReservedCPUs,
 		IgnoreMemoryCheckForApps,
 		IgnoreDiskCheckForApps,
+		CASFsckVerify,
+		CASFsckRemove,
 		AllowLogFastupload,
 		// TriState Items
 		NetworkFallbackAnyEth,
@@ -187,6 +202,8 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 		SSHAuthorizedKeys,
 		DefaultLogLevel,
 		DefaultRemoteLogLevel,
+		LogExportTargets,
+		DownloadPeerKey,
 		DisableDHCPAllOnesNetMask,
 		ProcessCloudInitMultiPart,
 	}
@@ -205,6 +222,7 @@ func TestNewConfigItemSpecMap(t *testing.T) {
 	asKeys := []AgentSettingKey{
 		LogLevel,
 		RemoteLogLevel,
+		LogRateLimit,
 	}
 	if len(specMap.AgentSettings) != len(asKeys) {
 		t.Errorf("AgentSettings has more (%d) than expected keys (%d)",
@@ -547,3 +565,11 @@ func TestSetGlobalValue(t *testing.T) {
 	assert.Equal(t, TS_DISABLED, valueMap.GlobalValueTriState(FallbackIfCloudGoneTime))
 	assert.Equal(t, "hola amigo", valueMap.GlobalValueString(SSHAuthorizedKeys))
 }
+
+func TestParseLogExportTargets(t *testing.T) {
+	assert.NoError(t, parseLogExportTargets(""))
+	assert.NoError(t, parseLogExportTargets("syslog+tcp://10.1.0.5:514?level=warning "+
+		"otlp+https://collector:4318/v1/logs?logs=apps"))
+	assert.Error(t, parseLogExportTargets("syslog+udp://10.1.0.5:514"))
+	assert.Error(t, parseLogExportTargets("otlp+http:///v1/logs"))
+}
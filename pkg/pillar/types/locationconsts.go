@@ -50,6 +50,10 @@ const (
 	V2TLSBaseFile = IdentityDirname + "/v2tlsbaseroot-certificates.pem"
 	// APIV1FileName - user can statically allow for API v1
 	APIV1FileName = IdentityDirname + "/Force-API-V1"
+	// AttestVerifierURLFile - attest to the verifier at this URL
+	AttestVerifierURLFile = IdentityDirname + "/attest-verifier-url"
+	// AttestVerifierCAFile - CA certificates of the verifier at that URL
+	AttestVerifierCAFile = IdentityDirname + "/attest-verifier-ca.pem"
 
 	// ServerSigningCertFileName - filename for server signing leaf certificate
 	ServerSigningCertFileName = CertificateDirname + "/server-signing-cert.pem"
@@ -85,6 +89,16 @@ const (
 	// EveKmemUsageFile - current kernel usage
 	EveKmemUsageFile = "/hostfs/sys/fs/cgroup/memory/eve/memory.kmem.usage_in_bytes"
 
+	// PubsubJournalDir - pubsub events are recorded here if it exists
+	PubsubJournalDir = PersistDir + "/pubsub-journal"
+
+	// FaultInjectionDir - scenario for the faultinjection agent and the
+	// file used to fill /persist
+	FaultInjectionDir = PersistDir + "/faultinjection"
+	// FaultInjectionScenarioFile - faults to inject when
+	// debug.enable.fault.injection is set
+	FaultInjectionScenarioFile = FaultInjectionDir + "/scenario.json"
+
 	// ContainerdContentDir - path to containerd`s content store
 	ContainerdContentDir = PersistDir + "/containerd/io.containerd.content.v1.content"
 )
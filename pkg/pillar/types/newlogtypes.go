@@ -23,6 +23,8 @@ type logfileMetrics struct {
 	NumBytesWrite     uint64 // total log bytes write to file before gzip
 	NumGzipFileInDir  uint32 // current number of gzip files remain
 	NumInputEvent     uint64 // total event input from log source
+	NumRateLimitDrop  uint64 // total events dropped over the rate limit
+	NumRateLimitKept  uint64 // total events over the rate limit kept as samples
 	// from loguploader
 	NumGZipFileRetry      uint64    // total gzip file upload retries
 	NumGZipFileKeptLocal  uint32    // total gzip file upload 4xx failure and kept on device
@@ -65,6 +67,7 @@ type NewlogMetrics struct {
 	NumKmessages          uint64            // total input kmessages
 	NumSyslogMessages     uint64            // total input syslog message
 	DevTop10InputBytesPCT map[string]uint32 // top 10 sources device log input in percentage
+	DevTop10RateLimitDrop map[string]uint64 // top 10 sources device log events dropped over the rate limit
 
 	// upload latency
 	Latency cloudDelay
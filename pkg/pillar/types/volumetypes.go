@@ -26,6 +26,15 @@ type VolumeConfig struct {
 	Encrypted               bool
 	DisplayName             string
 	HasNoAppReferences      bool
+	// SourceVolumeID and SourceSnapshot are the snapshot to clone for
+	// VCOT_CLONE
+	SourceVolumeID uuid.UUID
+	SourceSnapshot string
+	// Snapshots are the names of the snapshots which should exist
+	Snapshots []string
+	// A change in RollbackCounter rolls back to RollbackSnapshot
+	RollbackSnapshot string
+	RollbackCounter  int64
 }
 
 // Key is volume UUID which will be unique
@@ -130,10 +139,23 @@ type VolumeStatus struct {
 	PreReboot               bool // Was volume last use prior to device reboot?
 	ReferenceName           string
 	WWN                     string
+	SourceVolumeID          uuid.UUID
+	SourceSnapshot          string
+	Snapshots               []VolumeSnapshotStatus
+	RollbackCounter         int64 // Last applied VolumeConfig.RollbackCounter
 
 	ErrorAndTimeWithSource
 }
 
+// VolumeSnapshotStatus reports a snapshot of a volume
+type VolumeSnapshotStatus struct {
+	Name            string
+	CreateTime      time.Time // Zero if the snapshot does not exist
+	UsedBytes       uint64    // Space only used by the snapshot
+	ReferencedBytes uint64
+	ErrorAndTime
+}
+
 // Key is volume UUID which will be unique
 func (status VolumeStatus) Key() string {
 	return fmt.Sprintf("%s#%d", status.VolumeID.String(), status.GenerationCounter)
@@ -60,6 +60,9 @@ type AppInstanceConfig struct {
 	IoAdapterList       []IoAdapter
 	RestartCmd          AppInstanceOpsCmd
 	PurgeCmd            AppInstanceOpsCmd
+	// SnapshotCmd and RestoreCmd are passed to domainmgr in DomainConfig
+	SnapshotCmd DomainSnapshotCmd
+	RestoreCmd  DomainSnapshotCmd
 	// XXX: to be deprecated, use CipherBlockStatus instead
 	CloudInitUserData *string `json:"pubsub-large-CloudInitUserData"`
 	RemoteConsole     bool
@@ -72,6 +75,9 @@ type AppInstanceConfig struct {
 	MetaDataType MetaDataType
 
 	ProfileList []string
+
+	HealthCheck   *HealthCheckConfig
+	RestartPolicy RestartPolicy
 }
 
 type AppInstanceOpsCmd struct {
@@ -164,6 +170,11 @@ type AppInstanceStatus struct {
 
 	EffectiveActivate bool //set here effective activate after profile check and apply
 
+	// From DomainStatus
+	Health       HealthStatus
+	RestartCount uint32
+	Snapshot     DomainSnapshotStatus
+
 	// All error strings across all steps and all StorageStatus
 	// ErrorAndTimeWithSource provides SetError, SetErrrorWithSource, etc
 	ErrorAndTimeWithSource
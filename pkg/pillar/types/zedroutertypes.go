@@ -217,6 +217,19 @@ type AppContainerStats struct {
 	// Disk stats
 	ReadBytes  uint64 // in MBytes
 	WriteBytes uint64 // in MBytes
+	ReadOps    uint64
+	WriteOps   uint64
+	// cgroup stats only reported for native containers
+	PidsLimit uint64 // 0 if unlimited
+	Hugepages []HugepageStats
+}
+
+// HugepageStats - hugetlb cgroup usage for one page size
+type HugepageStats struct {
+	PageSize string // E.g., "2MB"
+	Usage    uint64 // in Bytes
+	MaxUsage uint64 // in Bytes
+	Failcnt  uint64
 }
 
 // Key - key for AppContainerMetrics
@@ -1938,6 +1951,8 @@ type NetworkInstanceProbeStatus struct {
 	CurrIntfUP        CurrIntfStatusType   // the current picked interface can be up or down
 	TriggerCnt        uint32               // number of times Uplink change triggered
 	PInfo             map[string]ProbeInfo // per physical port eth0, eth1 probing state
+	LBUplinks         []UplinkLBPort       // healthy uplinks used with load balancing
+	ProgLBUplinks     []UplinkLBPort       // Currently programmed load balanced uplinks
 }
 
 type DhcpType uint8
@@ -1968,6 +1983,8 @@ type UnderlayNetworkConfig struct {
 	Network      uuid.UUID // Points to a NetworkInstance.
 	ACLs         []ACE
 	AccessVlanID uint32
+	TrunkVlans   []VlanRange // Used if AccessVlanID is not set
+	Shaping      BandwidthShaping
 }
 
 type UnderlayNetworkStatus struct {
@@ -2151,6 +2168,11 @@ type NetworkInstanceInfo struct {
 	VlanMap map[uint32]uint32
 	// Counts the number of trunk ports attached to this network instance
 	NumTrunkPorts uint32
+	// Maintain a map of the vlan ids of trunk ports with a list of vlans
+	// to their counts
+	TrunkVlanMap map[uint32]uint32
+	// Vlans set on the external port and on the bridge
+	UplinkVlans []uint32
 
 	// IP address on which the meta-data server listens
 	MetaDataServerIP string
@@ -2227,12 +2249,22 @@ type NetworkInstanceMetrics struct {
 	ProbeMetrics   ProbeMetrics
 	VpnMetrics     *VpnMetrics
 	VlanMetrics    VlanMetrics
+	FlowExport     FlowExportMetrics
+}
+
+// FlowExportMetrics are the counters of the export of the flow records
+// of a network instance to a local collector
+type FlowExportMetrics struct {
+	ExportedRecords uint64
+	DroppedRecords  uint64 // buffer full or send error
+	SendErrors      uint64
 }
 
 // VlanMetrics :
 type VlanMetrics struct {
-	NumTrunkPorts uint32
-	VlanCounts    map[uint32]uint32
+	NumTrunkPorts   uint32
+	VlanCounts      map[uint32]uint32
+	TrunkVlanCounts map[uint32]uint32
 }
 
 // ProbeMetrics - NI probe metrics
@@ -2256,6 +2288,9 @@ type ProbeIntfMetrics struct {
 	RemoteUPCnt     uint32 // remote probe UP count
 	RemoteDownCnt   uint32 // remote probe DOWN count
 	LatencyToRemote uint32 // probe latency to remote in msec
+	LoadBalanced    bool   // used by the uplink load balancing
+	Weight          uint32 // load balancing weight
+	FlowCount       uint32 // number of tracked flows using the uplink
 }
 
 func (metrics NetworkInstanceMetrics) Key() string {
@@ -2373,6 +2408,70 @@ type NetworkMetric struct {
 	RxAclDrops          uint64 // For implicit deny/drop at end
 	TxAclRateLimitDrops uint64 // For all rate limited rules
 	RxAclRateLimitDrops uint64 // For all rate limited rules
+	TxShapingDrops      uint64 // From the tc qdiscs of BandwidthShaping
+	RxShapingDrops      uint64
+	TxShapingOverlimits uint64
+	RxShapingOverlimits uint64
+	TxShapingBacklog    uint64 // Bytes
+	RxShapingBacklog    uint64
+}
+
+// FlowExportProtocol is the protocol of a FlowExportConfig
+type FlowExportProtocol uint8
+
+const (
+	// FlowExportProtocolIPFIX : IPFIX, RFC 7011
+	FlowExportProtocolIPFIX FlowExportProtocol = iota
+	// FlowExportProtocolNetFlowV9 : NetFlow v9, RFC 3954
+	FlowExportProtocolNetFlowV9
+)
+
+// FlowExportConfig is the export of the flow records of a network instance
+// to a local collector
+type FlowExportConfig struct {
+	Collector string // host:port, UDP; empty to disable
+	Protocol  FlowExportProtocol
+	// IPFIX Private Enterprise Number of the app UUID and DNS name
+	// information elements, they are left out if zero
+	EnterpriseNumber uint32
+}
+
+// UplinkLoadBalancing selects how a local network instance uses the ports
+// of its port label
+type UplinkLoadBalancing uint8
+
+const (
+	// UplinkLoadBalancingNone : a single uplink picked by probing, failover only
+	UplinkLoadBalancingNone UplinkLoadBalancing = iota
+	// UplinkLoadBalancingPerFlow : new flows are split across the healthy
+	// uplinks of the lowest cost according to their weights
+	UplinkLoadBalancingPerFlow
+	// UplinkLoadBalancingSticky : all the flows of an app IP use the same
+	// uplink as long as it is healthy
+	UplinkLoadBalancingSticky
+)
+
+// UplinkLBPort is an uplink used by a load balanced network instance
+type UplinkLBPort struct {
+	IfName string
+	Mark   uint8 // index of the uplink in the connection mark, 1-15
+	Weight uint32
+}
+
+// BandwidthShaping is the tc shaping of an app VIF or of a network instance.
+// Rates are in kbit/s, the rate is the guarantee and the ceil the cap.
+// Egress is the traffic sent by the app(s), ingress the traffic received.
+type BandwidthShaping struct {
+	EgressRate  uint64
+	EgressCeil  uint64
+	IngressRate uint64
+	IngressCeil uint64
+}
+
+// IsSet returns true if any direction is shaped
+func (shaping BandwidthShaping) IsSet() bool {
+	return shaping.EgressRate != 0 || shaping.EgressCeil != 0 ||
+		shaping.IngressRate != 0 || shaping.IngressCeil != 0
 }
 
 type NetworkInstanceType int32
@@ -2428,6 +2527,20 @@ type NetworkInstanceConfig struct {
 
 	// For other network services - Proxy / StrongSwan etc..
 	OpaqueConfig string
+	// VpnType selects the VPN service of a cloud network instance
+	VpnType VpnType
+	// CipherBlockStatus, for the encrypted VPN keys
+	CipherBlockStatus CipherBlockStatus
+
+	// Shaping of the traffic between the apps and the port
+	Shaping BandwidthShaping
+
+	// Export of the flow records to a local collector
+	FlowExport FlowExportConfig
+
+	// Load balancing across the ports of Logicallabel, local NI only
+	UplinkLoadBalancing UplinkLoadBalancing
+	UplinkWeights       map[string]uint32 // by port logicallabel, default 1
 
 	// Any errrors from the parser
 	// ErrorAndTime provides SetErrorNow() and ClearError()
@@ -2576,6 +2689,11 @@ type AppNetworkACLArgs struct {
 	NIType     NetworkInstanceType
 	// This is the same AppNum that comes from AppNetworkStatus
 	AppNum int32
+	// Set for a load balanced network instance with its healthy uplinks,
+	// UpLinks has all of them
+	LoadBalanced bool
+	LBUplinks    []UplinkLBPort
+	LBSticky     bool
 }
 
 // IPTablesRule : iptables rule detail
@@ -2701,6 +2819,16 @@ const (
 	AceDirEgress ACEDirection = 2
 )
 
+// MaxACERuleID is the largest RuleID of an ACE. The connmark of a flow
+// has 23 bits for the RuleID and the largest value is used by zedrouter
+// for the default drop rule.
+const MaxACERuleID = 0x7fffff - 1
+
+// MaxLBACERuleID is the largest RuleID of an ACE on a load balanced
+// network instance, which keeps the uplink of a flow in 4 of the bits
+// of the RuleID in the connmark.
+const MaxLBACERuleID = 0x7ffff - 1
+
 // Similar support as in draft-ietf-netmod-acl-model
 type ACE struct {
 	Matches []ACEMatch
@@ -2771,6 +2899,35 @@ type StrongSwanConfig struct {
 	ClientConfigList []VpnClientConfig
 }
 
+// VpnType is the VPN service of a cloud network instance
+type VpnType uint8
+
+const (
+	// VpnTypeStrongSwan : IPsec VPN, the opaque config is a StrongSwanConfig
+	VpnTypeStrongSwan VpnType = iota
+	// VpnTypeWireGuard : WireGuard VPN, the opaque config is a WireGuardConfig
+	VpnTypeWireGuard
+)
+
+// WireGuardConfig is the opaque config of a WireGuard network instance.
+// The private key and the preshared keys are not part of it, they come
+// in the EncryptionBlock of the network instance cipher block.
+type WireGuardConfig struct {
+	ListenPort uint16 // 0 picks a random port, fine if all peers have an Endpoint
+	Address    string // address/prefix of the wg interface, e.g. 10.99.0.2/24
+	Mtu        uint16 // 0 for the default
+	Peers      []WireGuardPeerConfig
+}
+
+// WireGuardPeerConfig is a peer of a WireGuard network instance
+type WireGuardPeerConfig struct {
+	Name                string
+	PublicKey           string   // base64
+	Endpoint            string   // host:port, empty for peers which connect to us
+	AllowedIPs          []string // subnets routed to the peer
+	PersistentKeepalive uint16   // seconds, 0 to disable
+}
+
 // structure for internal handling
 type VpnConfig struct {
 	VpnRole          string
@@ -2845,19 +3002,20 @@ type VpnEndPoint struct {
 }
 
 type VpnConnStatus struct {
-	Id         string   // ipsec connection id
-	Name       string   // connection name
-	State      VpnState // vpn state
-	Version    string   // ike version
-	Ikes       string   // ike parameters
-	EstTime    uint64   // established time
-	ReauthTime uint64   // reauth time
-	LInfo      VpnEndPoint
-	RInfo      VpnEndPoint
-	Links      []*VpnLinkStatus
-	StartLine  uint32
-	EndLine    uint32
-	MarkDelete bool
+	Id            string    // ipsec connection id
+	Name          string    // connection name
+	State         VpnState  // vpn state
+	Version       string    // ike version
+	Ikes          string    // ike parameters
+	EstTime       uint64    // established time
+	ReauthTime    uint64    // reauth time
+	LastHandshake time.Time // wireguard last handshake with the peer
+	LInfo         VpnEndPoint
+	RInfo         VpnEndPoint
+	Links         []*VpnLinkStatus
+	StartLine     uint32
+	EndLine       uint32
+	MarkDelete    bool
 }
 
 type VpnStatus struct {
@@ -2953,6 +3111,9 @@ type FlowRec struct {
 	TxPkts    int64
 	RxBytes   int64
 	RxPkts    int64
+	// VlanID is the VLAN of the flow on a switch network instance,
+	// zero if untagged
+	VlanID uint32
 }
 
 // DNSReq :
@@ -6,6 +6,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 const (
@@ -39,3 +40,119 @@ type ZVolStatus struct {
 func (status ZVolStatus) Key() string {
 	return status.Device
 }
+
+// ZFSState is the state of a zpool or a vdev.
+// Must match the values in the info api ZfsState
+type ZFSState uint8
+
+// ZFSState values
+const (
+	ZFSStateUnspecified ZFSState = iota
+	ZFSStateOnline
+	ZFSStateDegraded
+	ZFSStateFaulted
+	ZFSStateOffline
+	ZFSStateUnavail
+	ZFSStateRemoved
+	ZFSStateSuspended
+	ZFSStateAvail
+	ZFSStateInUse
+)
+
+// ParseZFSState returns the ZFSState for the state printed by zpool status
+func ParseZFSState(state string) ZFSState {
+	switch state {
+	case "ONLINE":
+		return ZFSStateOnline
+	case "DEGRADED":
+		return ZFSStateDegraded
+	case "FAULTED":
+		return ZFSStateFaulted
+	case "OFFLINE":
+		return ZFSStateOffline
+	case "UNAVAIL":
+		return ZFSStateUnavail
+	case "REMOVED":
+		return ZFSStateRemoved
+	case "SUSPENDED":
+		return ZFSStateSuspended
+	case "AVAIL":
+		return ZFSStateAvail
+	case "INUSE":
+		return ZFSStateInUse
+	default:
+		return ZFSStateUnspecified
+	}
+}
+
+// ZFSScrubState is the state of the last scrub of a zpool.
+// Must match the values in the info api ZfsScrubState
+type ZFSScrubState uint8
+
+// ZFSScrubState values
+const (
+	ZFSScrubStateUnspecified ZFSScrubState = iota
+	ZFSScrubStateNone
+	ZFSScrubStateInProgress
+	ZFSScrubStateFinished
+	ZFSScrubStateCanceled
+)
+
+// ZFSVdevStatus is the status of a vdev in a zpool
+type ZFSVdevStatus struct {
+	Name           string
+	Parent         string // Empty for top-level vdevs
+	State          ZFSState
+	ReadErrors     uint64
+	WriteErrors    uint64
+	ChecksumErrors uint64
+}
+
+// ZFSScrubStatus is the result of the last scrub of a zpool
+type ZFSScrubStatus struct {
+	State         ZFSScrubState
+	StartTime     time.Time
+	EndTime       time.Time
+	RepairedBytes uint64
+	Errors        uint64
+}
+
+// ZFSDiskReplaceConfig is published by zedagent to request zfsmanager
+// to replace a disk of a zpool. The replace is done when the Counter
+// changes.
+type ZFSDiskReplaceConfig struct {
+	PoolName string
+	OldDisk  string
+	NewDisk  string
+	Counter  uint32
+}
+
+// Key is fixed since there is at most one replace outstanding
+func (config ZFSDiskReplaceConfig) Key() string {
+	return "global"
+}
+
+// ZFSDiskReplaceStatus is the result of the last ZFSDiskReplaceConfig
+// applied to the zpool
+type ZFSDiskReplaceStatus struct {
+	OldDisk string
+	NewDisk string
+	Counter uint32
+	ErrorAndTime
+}
+
+// ZFSPoolStatus is published by zfsmanager for each zpool
+type ZFSPoolStatus struct {
+	PoolName    string
+	State       ZFSState
+	Status      string // Explanation from zpool status if not healthy
+	Vdevs       []ZFSVdevStatus
+	Scrub       ZFSScrubStatus
+	Resilvering bool
+	DiskReplace ZFSDiskReplaceStatus
+}
+
+// Key is the name of the zpool
+func (status ZFSPoolStatus) Key() string {
+	return status.PoolName
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/lf-edge/eve/pkg/pillar/agentlog"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/cmd/attestverifier"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/baseosmgr"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/client"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/command"
@@ -28,9 +29,11 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cmd/hardwaremodel"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/ipcmonitor"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/ledmanager"
+	"github.com/lf-edge/eve/pkg/pillar/cmd/logquery"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/loguploader"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/nim"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/nodeagent"
+	"github.com/lf-edge/eve/pkg/pillar/cmd/pubsubjournal"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/tpmmgr"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/upgradeconverter"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/vaultmgr"
@@ -43,8 +46,10 @@ import (
 	"github.com/lf-edge/eve/pkg/pillar/cmd/zedmanager"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/zedrouter"
 	"github.com/lf-edge/eve/pkg/pillar/cmd/zfsmanager"
+	"github.com/lf-edge/eve/pkg/pillar/faultinject"
 	"github.com/lf-edge/eve/pkg/pillar/pidfile"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub"
+	"github.com/lf-edge/eve/pkg/pillar/pubsub/journaldriver"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub/reverse"
 	"github.com/lf-edge/eve/pkg/pillar/pubsub/socketdriver"
 	"github.com/lf-edge/eve/pkg/pillar/types"
@@ -56,6 +61,10 @@ const (
 	agentName   = "zedbox"
 	errorTime   = 3 * time.Minute
 	warningTime = 40 * time.Second
+
+	// Limits for the pubsub journal
+	pubsubJournalFileSize = 10 * 1024 * 1024
+	pubsubJournalFiles    = 5
 )
 
 type zedboxInline uint8
@@ -74,6 +83,7 @@ type entrypoint struct {
 
 var (
 	entrypoints = map[string]entrypoint{
+		"attestverifier":   {f: attestverifier.Run, inline: inlineAlways},
 		"client":           {f: client.Run, inline: inlineAlways},
 		"command":          {f: command.Run},
 		"diag":             {f: diag.Run, inline: inlineUnlessService},
@@ -83,9 +93,11 @@ var (
 		"faultinjection":   {f: faultinjection.Run},
 		"hardwaremodel":    {f: hardwaremodel.Run, inline: inlineAlways},
 		"ledmanager":       {f: ledmanager.Run},
+		"logquery":         {f: logquery.Run, inline: inlineAlways},
 		"loguploader":      {f: loguploader.Run},
 		"nim":              {f: nim.Run},
 		"nodeagent":        {f: nodeagent.Run},
+		"pubsubjournal":    {f: pubsubjournal.Run, inline: inlineAlways},
 		"verifier":         {f: verifier.Run},
 		"volumemgr":        {f: volumemgr.Run},
 		"waitforaddr":      {f: waitforaddr.Run, inline: inlineAlways},
@@ -104,6 +116,8 @@ var (
 	}
 	logger *logrus.Logger
 	log    *base.LogObject
+	// pubsubJournal is set if types.PubsubJournalDir exists
+	pubsubJournal *journaldriver.Journal
 )
 
 func main() {
@@ -137,6 +151,17 @@ func main() {
 		if err != nil {
 			log.Fatal(err)
 		}
+		if _, err := os.Stat(types.PubsubJournalDir); err == nil {
+			pubsubJournal, err = journaldriver.NewJournal(
+				types.PubsubJournalDir, pubsubJournalFileSize,
+				pubsubJournalFiles)
+			if err != nil {
+				log.Error(err)
+			} else {
+				log.Noticef("Recording pubsub events in %s",
+					types.PubsubJournalDir)
+			}
+		}
 		retval := runService(basename, sep, inline)
 		// Not likely to ever return, but for uniformity ...
 		os.Exit(retval)
@@ -219,11 +244,7 @@ func handleService(serviceName string, cmdArgs []string) {
 
 	log.Functionf("zedbox: Received command = %s args = %v", serviceName, cmdArgs)
 	srvLogger, srvLog := agentlog.Init(serviceName)
-	srvPs := pubsub.New(
-		&socketdriver.SocketDriver{
-			Logger: srvLogger,
-			Log:    srvLog,
-		},
+	srvPs := pubsub.New(newPubsubDriver(srvLogger, srvLog),
 		srvLogger, srvLog)
 	sep, ok := entrypoints[serviceName]
 	if !ok {
@@ -251,3 +272,25 @@ func startAgentAndDone(sep entrypoint, agentName string, srvPs *pubsub.PubSub,
 		log.Fatalf("Error write done file: %v", err)
 	}
 }
+
+// newPubsubDriver returns the pubsub driver for a service started by zedbox.
+// The events are recorded into pubsubJournal if enabled, and the faults
+// from an active faultinject scenario are applied before that.
+func newPubsubDriver(srvLogger *logrus.Logger, srvLog *base.LogObject) pubsub.Driver {
+	var driver pubsub.Driver = &socketdriver.SocketDriver{
+		Logger: srvLogger,
+		Log:    srvLog,
+	}
+	if pubsubJournal != nil {
+		driver = &journaldriver.JournalDriver{
+			Driver:  driver,
+			Journal: pubsubJournal,
+			Log:     srvLog,
+			Topics:  journaldriver.DefaultTopics,
+		}
+	}
+	return &faultinject.Driver{
+		Driver: driver,
+		Log:    srvLog,
+	}
+}
@@ -33,6 +33,8 @@ type deferredItem struct {
 	size          int64
 	url           string
 	bailOnHTTPErr bool // Return 4xx and 5xx without trying other interfaces
+	seq           uint64 // Order of addition, used by persist
+	persistedSize int64  // Size on disk; zero if not persisted
 }
 
 const maxTimeToHandleDeferred = time.Minute
@@ -48,6 +50,7 @@ type DeferredContext struct {
 	sentHandler            *SentHandlerFunction
 	zedcloudCtx            *ZedCloudContext
 	iteration              int
+	persist                *deferredPersist // Set by EnableDeferredPersist
 }
 
 //TypePriorityCheckFunction returns true in case of find type with high priority
@@ -178,6 +181,8 @@ func (ctx *DeferredContext) handleDeferred(log *base.LogObject, event time.Time,
 		for _, el := range ctx.deferredItems {
 			if el.buf != nil {
 				newDeferredItems = append(newDeferredItems, el)
+			} else {
+				ctx.unpersistItem(log, el)
 			}
 		}
 		ctx.deferredItems = newDeferredItems
@@ -231,11 +236,29 @@ func (ctx *DeferredContext) setDeferred(zedcloudCtx *ZedCloudContext,
 	}
 	if found {
 		log.Tracef("Replacing key %s", key)
+		// Keep the position in the queue
+		item.seq = itemList.seq
+		ctx.unpersistItem(log, itemList)
 		ctx.deferredItems[ind] = &item
 	} else {
 		log.Tracef("Adding key %s", key)
+		if ctx.persist != nil {
+			item.seq = ctx.persist.seq
+			ctx.persist.seq++
+		}
 		ctx.deferredItems = append(ctx.deferredItems, &item)
 	}
+	ctx.persistItem(log, &item)
+}
+
+// findDeferred returns the item for the key or nil. Called with lock held.
+func (ctx *DeferredContext) findDeferred(key string) *deferredItem {
+	for _, item := range ctx.deferredItems {
+		if item.key == key {
+			return item
+		}
+	}
+	return nil
 }
 
 // Try every minute backoff to every 15 minutes
@@ -0,0 +1,289 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// Optional on-disk backing store for the deferred queue so that deferred
+// items survive a reboot of the device while it is offline.
+// Each item is kept in a separate file named after the hash of its key.
+// The total size of the persisted payloads is bounded; when the limit is
+// reached the lowest priority items (per TypePriorityCheckFunction ordering)
+// are dropped from the disk first. Items which do not fit are kept in
+// memory only.
+
+package zedcloud
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	fileutils "github.com/lf-edge/eve/pkg/pillar/utils/file"
+)
+
+const persistedItemSuffix = ".json"
+
+// DeferredPersistOptions configures the on-disk backing store
+type DeferredPersistOptions struct {
+	// Dirname is where the deferred items are stored, e.g. under /persist
+	Dirname string
+	// MaxSize is the limit for the total size of persisted payloads in bytes
+	MaxSize int64
+	// EncodeItemType returns a string representation of the itemType
+	// passed to SetDeferred. Items for which false is returned are
+	// not persisted.
+	EncodeItemType func(itemType interface{}) (string, bool)
+	// DecodeItemType is the reverse of EncodeItemType
+	DecodeItemType func(encoded string) (interface{}, error)
+}
+
+// persistedItem is the on-disk representation of a deferredItem
+type persistedItem struct {
+	Key           string
+	ItemType      string
+	URL           string
+	Size          int64
+	BailOnHTTPErr bool
+	Seq           uint64
+	Data          []byte
+}
+
+type deferredPersist struct {
+	DeferredPersistOptions
+	totalSize int64
+	seq       uint64
+}
+
+// EnableDeferredPersist enables the on-disk backing store for the deferred
+// queue. Items found in opts.Dirname (left there before a reboot) are
+// added to the queue and will be sent when the timer fires.
+// Must be called after GetDeferredChan.
+func EnableDeferredPersist(zedcloudCtx *ZedCloudContext,
+	opts DeferredPersistOptions) error {
+
+	return zedcloudCtx.deferredCtx.enablePersist(zedcloudCtx.log, opts)
+}
+
+func (ctx *DeferredContext) enablePersist(log *base.LogObject,
+	opts DeferredPersistOptions) error {
+
+	if opts.EncodeItemType == nil || opts.DecodeItemType == nil {
+		return fmt.Errorf("enablePersist: missing itemType encoding functions")
+	}
+	if err := os.MkdirAll(opts.Dirname, 0700); err != nil {
+		return fmt.Errorf("enablePersist: %v", err)
+	}
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	persist := &deferredPersist{DeferredPersistOptions: opts}
+	ctx.persist = persist
+	loaded, err := persist.load(log)
+	if err != nil {
+		return err
+	}
+	wasEmpty := len(ctx.deferredItems) == 0
+	// Items which were deferred before we were enabled
+	unpersisted := ctx.deferredItems
+	for _, item := range loaded {
+		if ctx.findDeferred(item.key) != nil {
+			// Already replaced by a newer item
+			persist.totalSize += item.persistedSize
+			ctx.unpersistItem(log, item)
+			continue
+		}
+		persist.totalSize += item.persistedSize
+		ctx.deferredItems = append(ctx.deferredItems, item)
+	}
+	// The limit may have been lowered since the items were written
+	for persist.totalSize > persist.MaxSize {
+		victim := ctx.persistVictim(nil, 0)
+		if victim == nil {
+			break
+		}
+		log.Warnf("enablePersist: dropping %s from disk", victim.key)
+		ctx.unpersistItem(log, victim)
+	}
+	// Persist items which were deferred before we were enabled, but not
+	// the loaded ones which were dropped from disk above
+	for _, item := range unpersisted {
+		item.seq = persist.seq
+		persist.seq++
+		ctx.persistItem(log, item)
+	}
+	log.Noticef("enablePersist: loaded %d items (%d bytes) from %s",
+		len(loaded), persist.totalSize, opts.Dirname)
+	if wasEmpty && len(ctx.deferredItems) != 0 {
+		startTimer(log, ctx)
+	}
+	return nil
+}
+
+// load reads all persisted items ordered by their sequence numbers.
+// Files which can not be parsed are removed.
+func (p *deferredPersist) load(log *base.LogObject) ([]*deferredItem, error) {
+	files, err := ioutil.ReadDir(p.Dirname)
+	if err != nil {
+		return nil, fmt.Errorf("load: %v", err)
+	}
+	var items []*deferredItem
+	for _, file := range files {
+		filename := filepath.Join(p.Dirname, file.Name())
+		if !strings.HasSuffix(file.Name(), persistedItemSuffix) {
+			// Leftover from interrupted WriteRename
+			log.Noticef("load: removing %s", filename)
+			os.Remove(filename)
+			continue
+		}
+		item, err := p.loadItem(filename)
+		if err != nil {
+			log.Errorf("load: removing %s: %v", filename, err)
+			os.Remove(filename)
+			continue
+		}
+		if item.seq >= p.seq {
+			p.seq = item.seq + 1
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].seq < items[j].seq
+	})
+	return items, nil
+}
+
+func (p *deferredPersist) loadItem(filename string) (*deferredItem, error) {
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var pi persistedItem
+	if err := json.Unmarshal(b, &pi); err != nil {
+		return nil, err
+	}
+	if p.itemFilename(pi.Key) != filename {
+		return nil, fmt.Errorf("key %s does not match filename", pi.Key)
+	}
+	itemType, err := p.DecodeItemType(pi.ItemType)
+	if err != nil {
+		return nil, err
+	}
+	return &deferredItem{
+		itemType:      itemType,
+		key:           pi.Key,
+		buf:           bytes.NewBuffer(pi.Data),
+		size:          pi.Size,
+		url:           pi.URL,
+		bailOnHTTPErr: pi.BailOnHTTPErr,
+		seq:           pi.Seq,
+		persistedSize: int64(len(pi.Data)),
+	}, nil
+}
+
+func (p *deferredPersist) itemFilename(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(p.Dirname, hex.EncodeToString(h[:])+persistedItemSuffix)
+}
+
+// persistItem writes the item to disk making room by dropping lower
+// priority items if needed. Called with lock held.
+func (ctx *DeferredContext) persistItem(log *base.LogObject, item *deferredItem) {
+	p := ctx.persist
+	if p == nil || item.buf == nil {
+		return
+	}
+	encoded, ok := p.EncodeItemType(item.itemType)
+	if !ok {
+		return
+	}
+	size := int64(item.buf.Len())
+	if size > p.MaxSize {
+		log.Warnf("persistItem: %s too large (%d bytes)", item.key, size)
+		return
+	}
+	prio := ctx.priority(item.itemType)
+	for p.totalSize+size > p.MaxSize {
+		victim := ctx.persistVictim(item, prio)
+		if victim == nil {
+			log.Warnf("persistItem: no room for %s (%d bytes)",
+				item.key, size)
+			return
+		}
+		log.Warnf("persistItem: dropping %s from disk to make room for %s",
+			victim.key, item.key)
+		ctx.unpersistItem(log, victim)
+	}
+	pi := persistedItem{
+		Key:           item.key,
+		ItemType:      encoded,
+		URL:           item.url,
+		Size:          item.size,
+		BailOnHTTPErr: item.bailOnHTTPErr,
+		Seq:           item.seq,
+		Data:          item.buf.Bytes(),
+	}
+	b, err := json.Marshal(pi)
+	if err != nil {
+		log.Errorf("persistItem: %s: %v", item.key, err)
+		return
+	}
+	if err := fileutils.WriteRename(p.itemFilename(item.key), b); err != nil {
+		log.Errorf("persistItem: %v", err)
+		return
+	}
+	item.persistedSize = size
+	p.totalSize += size
+}
+
+// unpersistItem removes the item from disk. Called with lock held.
+func (ctx *DeferredContext) unpersistItem(log *base.LogObject, item *deferredItem) {
+	p := ctx.persist
+	if p == nil || item.persistedSize == 0 {
+		return
+	}
+	filename := p.itemFilename(item.key)
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		log.Errorf("unpersistItem: %v", err)
+	}
+	p.totalSize -= item.persistedSize
+	item.persistedSize = 0
+}
+
+// persistVictim returns the persisted item with the lowest priority
+// (the oldest one among those with the same priority) which is not more
+// important than minPrio. Returns nil if there is no such item.
+func (ctx *DeferredContext) persistVictim(exclude *deferredItem, minPrio int) *deferredItem {
+	var victim *deferredItem
+	victimPrio := -1
+	for _, item := range ctx.deferredItems {
+		if item == exclude || item.persistedSize == 0 {
+			continue
+		}
+		prio := ctx.priority(item.itemType)
+		if prio < minPrio {
+			continue
+		}
+		if prio > victimPrio || (prio == victimPrio && item.seq < victim.seq) {
+			victim = item
+			victimPrio = prio
+		}
+	}
+	return victim
+}
+
+// priority returns the index of the first TypePriorityCheckFunction
+// matching the itemType; lower is more important.
+func (ctx *DeferredContext) priority(itemType interface{}) int {
+	for i, f := range ctx.priorityCheckFunctions {
+		if f(itemType) {
+			return i
+		}
+	}
+	return len(ctx.priorityCheckFunctions)
+}
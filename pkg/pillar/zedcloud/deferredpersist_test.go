@@ -0,0 +1,184 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zedcloud
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/sirupsen/logrus"
+)
+
+// Item types of the tests are strings, "high" ones go first
+func testPersistOptions(dirname string, maxSize int64) DeferredPersistOptions {
+	return DeferredPersistOptions{
+		Dirname: dirname,
+		MaxSize: maxSize,
+		EncodeItemType: func(itemType interface{}) (string, bool) {
+			s, ok := itemType.(string)
+			return s, ok && s != "volatile"
+		},
+		DecodeItemType: func(encoded string) (interface{}, error) {
+			if encoded != "high" && encoded != "low" {
+				return nil, fmt.Errorf("unknown item type %s", encoded)
+			}
+			return encoded, nil
+		},
+	}
+}
+
+func testDeferredCtx(t *testing.T, dirname string, maxSize int64) *ZedCloudContext {
+	logger := logrus.StandardLogger()
+	log := base.NewSourceLogObject(logger, "zedcloud_test", 0)
+	zedcloudCtx := NewContext(log, ContextOptions{
+		DevNetworkStatus: &types.DeviceNetworkStatus{},
+		AgentName:        "zedcloud_test",
+	})
+	GetDeferredChan(&zedcloudCtx, nil, func(itemType interface{}) bool {
+		return itemType == "high"
+	})
+	if err := EnableDeferredPersist(&zedcloudCtx,
+		testPersistOptions(dirname, maxSize)); err != nil {
+		t.Fatalf("EnableDeferredPersist failed: %v", err)
+	}
+	return &zedcloudCtx
+}
+
+func testSetDeferred(zedcloudCtx *ZedCloudContext, key, itemType string) {
+	buf := bytes.NewBufferString(fmt.Sprintf("%-10s", key))
+	SetDeferred(zedcloudCtx, key, buf, int64(buf.Len()),
+		"https://controller/api/v2/edgedevice/"+key, false, itemType)
+}
+
+// testQueue returns the keys of the queue, with a "*" for persisted items
+func testQueue(zedcloudCtx *ZedCloudContext) []string {
+	ctx := &zedcloudCtx.deferredCtx
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	var keys []string
+	for _, item := range ctx.deferredItems {
+		key := item.key
+		if item.persistedSize != 0 {
+			key += "*"
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func testFileCount(t *testing.T, dirname string) int {
+	files, err := ioutil.ReadDir(dirname)
+	if err != nil {
+		t.Fatalf("ReadDir failed: %v", err)
+	}
+	return len(files)
+}
+
+func checkQueue(t *testing.T, zedcloudCtx *ZedCloudContext, expected ...string) {
+	t.Helper()
+	keys := testQueue(zedcloudCtx)
+	if fmt.Sprint(keys) != fmt.Sprint(expected) {
+		t.Errorf("got queue %v, expected %v", keys, expected)
+	}
+}
+
+func TestDeferredPersistReplay(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "deferred_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dirname)
+
+	zedcloudCtx := testDeferredCtx(t, dirname, 1000)
+	testSetDeferred(zedcloudCtx, "info1", "low")
+	testSetDeferred(zedcloudCtx, "metrics", "volatile")
+	testSetDeferred(zedcloudCtx, "info2", "high")
+	// A replaced item keeps its position in the queue
+	testSetDeferred(zedcloudCtx, "info1", "low")
+	checkQueue(t, zedcloudCtx, "info1*", "metrics", "info2*")
+	if count := testFileCount(t, dirname); count != 2 {
+		t.Errorf("got %d files, expected 2", count)
+	}
+
+	// Files which can not be parsed are dropped when loaded
+	if err := ioutil.WriteFile(dirname+"/garbage.json", []byte("{"),
+		0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	// Restart; the persisted items are loaded in their order
+	zedcloudCtx = testDeferredCtx(t, dirname, 1000)
+	checkQueue(t, zedcloudCtx, "info1*", "info2*")
+	if count := testFileCount(t, dirname); count != 2 {
+		t.Errorf("got %d files, expected 2", count)
+	}
+	item := zedcloudCtx.deferredCtx.findDeferred("info2")
+	if item == nil || item.itemType != "high" ||
+		item.buf.String() != fmt.Sprintf("%-10s", "info2") ||
+		item.size != 10 ||
+		item.url != "https://controller/api/v2/edgedevice/info2" {
+		t.Errorf("unexpected replayed item %+v", item)
+	}
+
+	// Replay without any port fails and keeps the items on disk
+	if HandleDeferred(zedcloudCtx, time.Now(), 0, false) {
+		t.Errorf("HandleDeferred succeeded without any port")
+	}
+	checkQueue(t, zedcloudCtx, "info1*", "info2*")
+
+	// New items go after the replayed ones
+	testSetDeferred(zedcloudCtx, "info3", "low")
+	zedcloudCtx = testDeferredCtx(t, dirname, 1000)
+	checkQueue(t, zedcloudCtx, "info1*", "info2*", "info3*")
+}
+
+func TestDeferredPersistEviction(t *testing.T) {
+	dirname, err := ioutil.TempDir("", "deferred_test")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dirname)
+
+	// Room for 3 items of 10 bytes
+	zedcloudCtx := testDeferredCtx(t, dirname, 35)
+	testSetDeferred(zedcloudCtx, "low1", "low")
+	testSetDeferred(zedcloudCtx, "low2", "low")
+	testSetDeferred(zedcloudCtx, "high1", "high")
+	checkQueue(t, zedcloudCtx, "low1*", "low2*", "high1*")
+
+	// The oldest of the lowest priority goes first
+	testSetDeferred(zedcloudCtx, "high2", "high")
+	checkQueue(t, zedcloudCtx, "low1", "low2*", "high1*", "high2*")
+	testSetDeferred(zedcloudCtx, "high3", "high")
+	checkQueue(t, zedcloudCtx, "low1", "low2", "high1*", "high2*", "high3*")
+
+	// A lower priority item does not evict higher priority ones
+	testSetDeferred(zedcloudCtx, "low3", "low")
+	checkQueue(t, zedcloudCtx, "low1", "low2", "high1*", "high2*", "high3*",
+		"low3")
+	if count := testFileCount(t, dirname); count != 3 {
+		t.Errorf("got %d files, expected 3", count)
+	}
+
+	// Items larger than the limit are never persisted
+	buf := bytes.NewBuffer(make([]byte, 40))
+	SetDeferred(zedcloudCtx, "large", buf, int64(buf.Len()), "url", false,
+		"high")
+	checkQueue(t, zedcloudCtx, "low1", "low2", "high1*", "high2*", "high3*",
+		"low3", "large")
+
+	// A lower limit after a restart drops the oldest items from disk,
+	// they are still sent
+	zedcloudCtx = testDeferredCtx(t, dirname, 25)
+	checkQueue(t, zedcloudCtx, "high1", "high2*", "high3*")
+	if count := testFileCount(t, dirname); count != 2 {
+		t.Errorf("got %d files, expected 2", count)
+	}
+}
@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/faultinject"
 	"github.com/lf-edge/eve/pkg/pillar/types"
 	"github.com/lf-edge/eve/pkg/pillar/utils"
 	logutils "github.com/lf-edge/eve/pkg/pillar/utils/logging"
@@ -297,6 +298,17 @@ func SendOnIntf(ctx *ZedCloudContext, destURL string, intf string, reqlen int64,
 		isGet = true
 	}
 
+	if statusCode := faultinject.ZedcloudFaultFor(ctx.AgentName, reqUrl); statusCode != 0 {
+		errStr := fmt.Sprintf("SendOnIntf to %s reqlen %d statuscode %d %s (injected)",
+			reqUrl, reqlen, statusCode, http.StatusText(statusCode))
+		log.Warnln(errStr)
+		resp := &http.Response{
+			StatusCode: statusCode,
+			Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		}
+		return resp, nil, types.SenderStatusNone, errors.New(errStr)
+	}
+
 	addrCount, err := types.CountLocalAddrAnyNoLinkLocalIf(*ctx.DeviceNetworkStatus, intf)
 	if err != nil {
 		return nil, nil, senderStatus, err
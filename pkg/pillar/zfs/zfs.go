@@ -182,3 +182,156 @@ func GetZFSVolumeInfo(log *base.LogObject, device string) (*types.ImgInfo, error
 func alignUpToBlockSize(size uint64) uint64 {
 	return (size + volBlockSize - 1) & ^(volBlockSize - 1)
 }
+
+//CreateSnapshot creates a snapshot of the dataset with the provided name
+func CreateSnapshot(log *base.LogObject, dataset string, snapshot string) (string, error) {
+	args := append(zfsPath, "snapshot", snapshotName(dataset, snapshot))
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//DestroySnapshot removes the snapshot of the dataset
+//it fails if there are clones of the snapshot
+func DestroySnapshot(log *base.LogObject, dataset string, snapshot string) (string, error) {
+	args := append(zfsPath, "destroy", snapshotName(dataset, snapshot))
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//RollbackSnapshot reverts the dataset to the snapshot
+//it destroys the snapshots taken after the provided one
+func RollbackSnapshot(log *base.LogObject, dataset string, snapshot string) (string, error) {
+	args := append(zfsPath, "rollback", "-r", snapshotName(dataset, snapshot))
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//GetSnapshotClones returns the datasets which are clones of the snapshot
+func GetSnapshotClones(log *base.LogObject, dataset string, snapshot string) ([]string, error) {
+	value, err := GetDatasetOption(log, snapshotName(dataset, snapshot), "clones")
+	if err != nil {
+		return nil, fmt.Errorf("GetSnapshotClones: output=%s error=%s", value, err)
+	}
+	return parseClones(value), nil
+}
+
+func parseClones(value string) []string {
+	if value == "" || value == "-" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+//CloneSnapshot creates the target dataset as a clone of the snapshot
+func CloneSnapshot(log *base.LogObject, dataset string, snapshot string, target string) (string, error) {
+	args := append(zfsPath, "clone", "-p", snapshotName(dataset, snapshot), target)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+//SnapshotInfo describes a snapshot of a dataset
+type SnapshotInfo struct {
+	Name       string // without the dataset@ prefix
+	CreateTime time.Time
+	Used       uint64
+	Referenced uint64
+}
+
+//ListSnapshots returns the snapshots of the dataset ordered by creation time
+func ListSnapshots(log *base.LogObject, dataset string) ([]SnapshotInfo, error) {
+	args := append(zfsPath, "list", "-Hp",
+		"-o", "name,creation,used,referenced",
+		"-t", "snapshot",
+		"-s", "creation",
+		"-d", "1",
+		dataset)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("ListSnapshots: output=%s error=%s", stdoutStderr, err)
+	}
+	return parseSnapshotList(string(stdoutStderr))
+}
+
+func parseSnapshotList(output string) ([]SnapshotInfo, error) {
+	var snapshots []SnapshotInfo
+	sc := bufio.NewScanner(strings.NewReader(output))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		split := strings.Split(line, "\t")
+		if len(split) != 4 {
+			return nil, fmt.Errorf("cannot process line %s: not in format <name>\\t<creation>\\t<used>\\t<referenced>", line)
+		}
+		at := strings.Index(split[0], "@")
+		if at < 0 {
+			return nil, fmt.Errorf("cannot process line %s: %s is not a snapshot", line, split[0])
+		}
+		creation, err := strconv.ParseInt(split[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot process line %s: %v", line, err)
+		}
+		used, err := strconv.ParseUint(split[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot process line %s: %v", line, err)
+		}
+		referenced, err := strconv.ParseUint(split[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("cannot process line %s: %v", line, err)
+		}
+		snapshots = append(snapshots, SnapshotInfo{
+			Name:       split[0][at+1:],
+			CreateTime: time.Unix(creation, 0),
+			Used:       used,
+			Referenced: referenced,
+		})
+	}
+	return snapshots, nil
+}
+
+//ValidSnapshotName checks that the name can be used for a snapshot
+func ValidSnapshotName(snapshot string) bool {
+	if snapshot == "" || len(snapshot) > 200 {
+		return false
+	}
+	for _, c := range snapshot {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+//GetPoolFreePercent returns the percentage of the pool of the dataset
+//which is available
+func GetPoolFreePercent(log *base.LogObject, dataset string) (uint64, error) {
+	pool := strings.Split(dataset, "/")[0]
+	available, err := GetDatasetOption(log, pool, "available")
+	if err != nil {
+		return 0, fmt.Errorf("GetPoolFreePercent: %s %s", available, err)
+	}
+	availableBytes, err := strconv.ParseUint(available, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("GetPoolFreePercent: failed to parse available: %s", err)
+	}
+	used, err := GetDatasetOption(log, pool, "used")
+	if err != nil {
+		return 0, fmt.Errorf("GetPoolFreePercent: %s %s", used, err)
+	}
+	usedBytes, err := strconv.ParseUint(used, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("GetPoolFreePercent: failed to parse used: %s", err)
+	}
+	if availableBytes+usedBytes == 0 {
+		return 0, fmt.Errorf("GetPoolFreePercent: empty pool %s", pool)
+	}
+	return availableBytes * 100 / (availableBytes + usedBytes), nil
+}
+
+func snapshotName(dataset string, snapshot string) string {
+	return fmt.Sprintf("%s@%s", dataset, snapshot)
+}
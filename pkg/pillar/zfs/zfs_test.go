@@ -0,0 +1,77 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseSnapshotList(t *testing.T) {
+	output := "persist/vault/volumes/v1@first\t1650000000\t8192\t1048576\n" +
+		"persist/vault/volumes/v1@second\t1650000100\t0\t2097152\n"
+	snapshots, err := parseSnapshotList(output)
+	if err != nil {
+		t.Fatalf("parseSnapshotList failed: %v", err)
+	}
+	expected := []SnapshotInfo{
+		{Name: "first", CreateTime: time.Unix(1650000000, 0), Used: 8192, Referenced: 1048576},
+		{Name: "second", CreateTime: time.Unix(1650000100, 0), Used: 0, Referenced: 2097152},
+	}
+	if len(snapshots) != len(expected) {
+		t.Fatalf("expected %d snapshots, got %d", len(expected), len(snapshots))
+	}
+	for i := range expected {
+		if snapshots[i] != expected[i] {
+			t.Errorf("snapshot %d: expected %+v, got %+v", i, expected[i], snapshots[i])
+		}
+	}
+
+	if snapshots, err := parseSnapshotList(""); err != nil || len(snapshots) != 0 {
+		t.Errorf("expected no snapshots for empty output, got %v, %v", snapshots, err)
+	}
+	if _, err := parseSnapshotList("persist/vault/volumes/v1\t1\t2\t3\n"); err == nil {
+		t.Error("expected error for dataset without snapshot")
+	}
+	if _, err := parseSnapshotList("persist/vault/volumes/v1@first\t1\t2\n"); err == nil {
+		t.Error("expected error for missing column")
+	}
+}
+
+func TestValidSnapshotName(t *testing.T) {
+	testMatrix := map[string]bool{
+		"daily-2022.04.15": true,
+		"before_upgrade:1": true,
+		"":                 false,
+		"with space":       false,
+		"pool@snap":        false,
+		"dir/snap":         false,
+	}
+	for name, valid := range testMatrix {
+		if ValidSnapshotName(name) != valid {
+			t.Errorf("ValidSnapshotName(%q): expected %t", name, valid)
+		}
+	}
+}
+
+func TestParseClones(t *testing.T) {
+	testMatrix := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"none": {value: "", expected: nil},
+		"dash": {value: "-", expected: nil},
+		"one":  {value: "persist/clones/a", expected: []string{"persist/clones/a"}},
+		"several": {value: "persist/clones/a,persist/clones/b",
+			expected: []string{"persist/clones/a", "persist/clones/b"}},
+	}
+	for testname, test := range testMatrix {
+		t.Logf("Running test case %s", testname)
+		clones := parseClones(test.value)
+		if !reflect.DeepEqual(clones, test.expected) {
+			t.Errorf("got %v, expected %v", clones, test.expected)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfs
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/base"
+	"github.com/lf-edge/eve/pkg/pillar/types"
+	"github.com/lf-edge/eve/pkg/pillar/vault"
+)
+
+// zpool status prints times in ctime format
+const zpoolTimeLayout = "Mon Jan _2 15:04:05 2006"
+
+var (
+	zpoolPath = []string{"/hostfs", "zpool"}
+
+	// Section keys are right aligned with spaces while the continuation
+	// lines and the config start with a tab
+	zpoolSectionRe = regexp.MustCompile(`^ *([a-z]+):(?: (.*))?$`)
+)
+
+// GetZpools returns the names of the imported zpools
+func GetZpools(log *base.LogObject) ([]string, error) {
+	args := append(zpoolPath, "list", "-H", "-o", "name")
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("GetZpools: output=%s error=%s", stdoutStderr, err)
+	}
+	var pools []string
+	sc := bufio.NewScanner(bytes.NewReader(stdoutStderr))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line != "" {
+			pools = append(pools, line)
+		}
+	}
+	return pools, nil
+}
+
+// GetZpoolStatus returns the state of the zpool and its vdevs
+func GetZpoolStatus(log *base.LogObject, pool string) (*types.ZFSPoolStatus, error) {
+	args := append(zpoolPath, "status", "-p", pool)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("GetZpoolStatus: output=%s error=%s", stdoutStderr, err)
+	}
+	return parseZpoolStatus(string(stdoutStderr))
+}
+
+// StartScrub starts a scrub of the zpool
+func StartScrub(log *base.LogObject, pool string) (string, error) {
+	args := append(zpoolPath, "scrub", pool)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+// ReplaceDisk replaces oldDisk of the zpool with newDisk
+// the zpool resilvers onto newDisk in the background
+func ReplaceDisk(log *base.LogObject, pool string, oldDisk string, newDisk string) (string, error) {
+	if !validDiskName(oldDisk) || !validDiskName(newDisk) {
+		return "", fmt.Errorf("invalid disk name %q or %q", oldDisk, newDisk)
+	}
+	args := append(zpoolPath, "replace", pool, oldDisk, newDisk)
+	stdoutStderr, err := base.Exec(log, vault.ZfsPath, args...).CombinedOutput()
+	return string(stdoutStderr), err
+}
+
+// validDiskName rejects names which zpool would parse as options
+func validDiskName(disk string) bool {
+	return disk != "" && !strings.HasPrefix(disk, "-") &&
+		!strings.ContainsAny(disk, " \t\n")
+}
+
+// parseZpoolStatus parses the output of zpool status -p for one zpool.
+// Scrub.State is ZFSScrubStateUnspecified if the last scan of the pool
+// was not a scrub.
+func parseZpoolStatus(output string) (*types.ZFSPoolStatus, error) {
+	status := &types.ZFSPoolStatus{}
+	var section string
+	var statusLines []string
+	var parents []string
+	sc := bufio.NewScanner(strings.NewReader(output))
+	for sc.Scan() {
+		line := sc.Text()
+		if match := zpoolSectionRe.FindStringSubmatch(line); match != nil {
+			section = match[1]
+			value := strings.TrimSpace(match[2])
+			switch section {
+			case "pool":
+				status.PoolName = value
+			case "state":
+				status.State = types.ParseZFSState(value)
+			case "status":
+				statusLines = append(statusLines, value)
+			case "scan":
+				parseZpoolScan(status, value)
+			}
+			continue
+		}
+		if !strings.HasPrefix(line, "\t") {
+			continue
+		}
+		line = line[1:]
+		switch section {
+		case "status":
+			statusLines = append(statusLines, strings.TrimSpace(line))
+		case "config":
+			fields := strings.Fields(line)
+			if len(fields) == 0 || fields[0] == "NAME" {
+				continue
+			}
+			depth := (len(line) - len(strings.TrimLeft(line, " "))) / 2
+			name := fields[0]
+			if depth >= len(parents) {
+				parents = append(parents, make([]string, depth+1-len(parents))...)
+			}
+			parents[depth] = name
+			if depth == 0 {
+				// The pool itself, or logs, cache, spares etc
+				continue
+			}
+			vdev := types.ZFSVdevStatus{Name: name}
+			if depth > 1 || parents[0] != status.PoolName {
+				vdev.Parent = parents[depth-1]
+			}
+			if len(fields) > 1 {
+				vdev.State = types.ParseZFSState(fields[1])
+			}
+			if len(fields) > 4 {
+				vdev.ReadErrors, _ = strconv.ParseUint(fields[2], 10, 64)
+				vdev.WriteErrors, _ = strconv.ParseUint(fields[3], 10, 64)
+				vdev.ChecksumErrors, _ = strconv.ParseUint(fields[4], 10, 64)
+			}
+			status.Vdevs = append(status.Vdevs, vdev)
+		}
+	}
+	if status.PoolName == "" {
+		return nil, fmt.Errorf("no pool in zpool status output: %s", output)
+	}
+	status.Status = strings.TrimSpace(strings.Join(statusLines, " "))
+	return status, nil
+}
+
+// parseZpoolScan parses the first line of the scan section e.g.,
+// "scrub repaired 0 in 00:00:01 with 0 errors on Sun Oct 16 10:00:01 2022"
+func parseZpoolScan(status *types.ZFSPoolStatus, scan string) {
+	switch {
+	case scan == "none requested":
+		status.Scrub.State = types.ZFSScrubStateNone
+	case strings.HasPrefix(scan, "scrub in progress since "):
+		status.Scrub.State = types.ZFSScrubStateInProgress
+		status.Scrub.StartTime = parseZpoolTime(strings.TrimPrefix(scan, "scrub in progress since "))
+	case strings.HasPrefix(scan, "scrub canceled on "):
+		status.Scrub.State = types.ZFSScrubStateCanceled
+		status.Scrub.EndTime = parseZpoolTime(strings.TrimPrefix(scan, "scrub canceled on "))
+	case strings.HasPrefix(scan, "scrub repaired "):
+		status.Scrub.State = types.ZFSScrubStateFinished
+		fields := strings.Fields(scan)
+		// scrub repaired <bytes> in <duration> with <errors> errors on <time>
+		if len(fields) > 2 {
+			status.Scrub.RepairedBytes, _ = strconv.ParseUint(strings.TrimSuffix(fields[2], "B"), 10, 64)
+		}
+		for i := range fields {
+			if fields[i] == "with" && i+1 < len(fields) {
+				status.Scrub.Errors, _ = strconv.ParseUint(fields[i+1], 10, 64)
+			}
+		}
+		if i := strings.Index(scan, " errors on "); i >= 0 {
+			status.Scrub.EndTime = parseZpoolTime(scan[i+len(" errors on "):])
+		}
+		if i := strings.Index(scan, " in "); i >= 0 && !status.Scrub.EndTime.IsZero() {
+			duration := scan[i+len(" in "):]
+			if j := strings.Index(duration, " with "); j >= 0 {
+				duration = duration[:j]
+			}
+			if d, ok := parseZpoolDuration(duration); ok {
+				status.Scrub.StartTime = status.Scrub.EndTime.Add(-d)
+			}
+		}
+	case strings.HasPrefix(scan, "resilver in progress since "):
+		status.Resilvering = true
+	}
+}
+
+func parseZpoolTime(value string) time.Time {
+	t, err := time.ParseInLocation(zpoolTimeLayout, strings.TrimSpace(value), time.Local)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// parseZpoolDuration parses "hh:mm:ss" or "<n> days hh:mm:ss"
+func parseZpoolDuration(value string) (time.Duration, bool) {
+	var days, hours, minutes, seconds int
+	if _, err := fmt.Sscanf(value, "%d days %d:%d:%d", &days, &hours, &minutes, &seconds); err != nil {
+		days = 0
+		if _, err := fmt.Sscanf(value, "%d:%d:%d", &hours, &minutes, &seconds); err != nil {
+			return 0, false
+		}
+	}
+	return time.Duration(days)*24*time.Hour + time.Duration(hours)*time.Hour +
+		time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second, true
+}
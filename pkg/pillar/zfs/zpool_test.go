@@ -0,0 +1,119 @@
+// Copyright (c) 2022 Zededa, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package zfs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lf-edge/eve/pkg/pillar/types"
+)
+
+const zpoolStatusDegraded = `  pool: persist
+ state: DEGRADED
+status: One or more devices could not be used because the label is missing or
+	invalid.  Sufficient replicas exist for the pool to continue
+	functioning in a degraded state.
+action: Replace the device using 'zpool replace'.
+   see: https://openzfs.github.io/openzfs-docs/msg/ZFS-8000-4J
+  scan: scrub repaired 4096 in 01:02:03 with 2 errors on Sun Oct 16 10:00:01 2022
+config:
+
+	NAME        STATE     READ WRITE CKSUM
+	persist     DEGRADED     0     0     0
+	  mirror-0  DEGRADED     0     0     0
+	    sdb     ONLINE       0     0     3
+	    sdc     UNAVAIL      1     2     0  corrupted data
+	logs
+	  sdd       ONLINE       0     0     0
+	spares
+	  sde       AVAIL
+
+errors: No known data errors
+`
+
+const zpoolStatusResilver = `  pool: persist
+ state: ONLINE
+  scan: resilver in progress since Mon Oct 17 09:00:00 2022
+	1234 scanned at 100/s, 567 issued at 50/s, 9999 total
+	567 resilvered, 5.67% done, 00:10:00 to go
+config:
+
+	NAME        STATE     READ WRITE CKSUM
+	persist     ONLINE       0     0     0
+	  sdb       ONLINE       0     0     0
+
+errors: No known data errors
+`
+
+func TestParseZpoolStatus(t *testing.T) {
+	status, err := parseZpoolStatus(zpoolStatusDegraded)
+	if err != nil {
+		t.Fatalf("parseZpoolStatus failed: %v", err)
+	}
+	endTime := time.Date(2022, time.October, 16, 10, 0, 1, 0, time.Local)
+	expected := &types.ZFSPoolStatus{
+		PoolName: "persist",
+		State:    types.ZFSStateDegraded,
+		Status: "One or more devices could not be used because the label is missing or " +
+			"invalid.  Sufficient replicas exist for the pool to continue " +
+			"functioning in a degraded state.",
+		Vdevs: []types.ZFSVdevStatus{
+			{Name: "mirror-0", State: types.ZFSStateDegraded},
+			{Name: "sdb", Parent: "mirror-0", State: types.ZFSStateOnline, ChecksumErrors: 3},
+			{Name: "sdc", Parent: "mirror-0", State: types.ZFSStateUnavail, ReadErrors: 1, WriteErrors: 2},
+			{Name: "sdd", Parent: "logs", State: types.ZFSStateOnline},
+			{Name: "sde", Parent: "spares", State: types.ZFSStateAvail},
+		},
+		Scrub: types.ZFSScrubStatus{
+			State:         types.ZFSScrubStateFinished,
+			StartTime:     endTime.Add(-(time.Hour + 2*time.Minute + 3*time.Second)),
+			EndTime:       endTime,
+			RepairedBytes: 4096,
+			Errors:        2,
+		},
+	}
+	if !reflect.DeepEqual(status, expected) {
+		t.Errorf("parseZpoolStatus: expected %+v, got %+v", expected, status)
+	}
+
+	status, err = parseZpoolStatus(zpoolStatusResilver)
+	if err != nil {
+		t.Fatalf("parseZpoolStatus failed: %v", err)
+	}
+	if !status.Resilvering {
+		t.Error("expected resilvering")
+	}
+	if status.Scrub.State != types.ZFSScrubStateUnspecified {
+		t.Errorf("expected no scrub, got %+v", status.Scrub)
+	}
+	if len(status.Vdevs) != 1 || status.Vdevs[0].Parent != "" {
+		t.Errorf("unexpected vdevs %+v", status.Vdevs)
+	}
+
+	if _, err := parseZpoolStatus("no pools available\n"); err == nil {
+		t.Error("expected error for output without pool")
+	}
+}
+
+func TestParseZpoolScan(t *testing.T) {
+	testMatrix := map[string]types.ZFSScrubState{
+		"none requested": types.ZFSScrubStateNone,
+		"scrub in progress since Sun Oct 16 10:00:01 2022":                      types.ZFSScrubStateInProgress,
+		"scrub canceled on Sun Oct  2 10:00:01 2022":                            types.ZFSScrubStateCanceled,
+		"resilvered 1024 in 00:00:05 with 0 errors on Sun Oct 16 10:00:01 2022": types.ZFSScrubStateUnspecified,
+	}
+	for scan, state := range testMatrix {
+		status := &types.ZFSPoolStatus{}
+		parseZpoolScan(status, scan)
+		if status.Scrub.State != state {
+			t.Errorf("parseZpoolScan(%q): expected state %d, got %d",
+				scan, state, status.Scrub.State)
+		}
+		if state == types.ZFSScrubStateCanceled && status.Scrub.EndTime.IsZero() {
+			t.Errorf("parseZpoolScan(%q): expected end time", scan)
+		}
+	}
+}